@@ -42,3 +42,23 @@ func Convert_config_NodeResourceTopologyMatchArgs_To_v1_NodeResourceTopologyMatc
 	out.ScoringStrategy = (*ScoringStrategy)(unsafe.Pointer(&in.ScoringStrategy))
 	return nil
 }
+
+func Convert_v1_FlavourClusterWideArgs_To_config_FlavourClusterWideArgs(in *FlavourClusterWideArgs, out *config.FlavourClusterWideArgs, s conversion.Scope) error {
+	if err := autoConvert_v1_FlavourClusterWideArgs_To_config_FlavourClusterWideArgs(in, out, s); err != nil {
+		return err
+	}
+	// Manual conversions.
+	out.Weights = config.FlavourScoreWeights{}
+	if in.Weights != nil {
+		return Convert_v1_FlavourScoreWeights_To_config_FlavourScoreWeights(in.Weights, &out.Weights, s)
+	}
+	return nil
+}
+
+func Convert_config_FlavourClusterWideArgs_To_v1_FlavourClusterWideArgs(in *config.FlavourClusterWideArgs, out *FlavourClusterWideArgs, s conversion.Scope) error {
+	if err := autoConvert_config_FlavourClusterWideArgs_To_v1_FlavourClusterWideArgs(in, out, s); err != nil {
+		return err
+	}
+	out.Weights = &FlavourScoreWeights{}
+	return Convert_config_FlavourScoreWeights_To_v1_FlavourScoreWeights(&in.Weights, out.Weights, s)
+}
@@ -32,6 +32,17 @@ var (
 
 	defaultNodeResourcesAllocatableMode = Least
 
+	// defaultFlavourScoringStrategy is the default strategy scoring nodes against the flavour distribution
+	defaultFlavourScoringStrategy = FlavourScoringSpread
+	// defaultFlavourUnknownNodeScoring is the default scoring of the nodes missing from the cache
+	defaultFlavourUnknownNodeScoring = FlavourUnknownNodeEmpty
+	// defaultFlavourScoreCombiner is the default combiner of the balance score terms
+	defaultFlavourScoreCombiner = FlavourCombinerWeightedSum
+	// defaultFlavourCountMode is the default of what the cache counts per node and flavour
+	defaultFlavourCountMode = FlavourCountPods
+	// defaultFlavourPostBindOverflowPolicy is the default policy of PostBind when its queue is full
+	defaultFlavourPostBindOverflowPolicy = FlavourPostBindDropAndReconcile
+
 	// defaultResourcesToWeightMap is used to set the default resourceToWeight map for CPU and memory
 	// used by the NodeResourcesAllocatable scoring plugin.
 	// The base unit for CPU is millicore, while the base using for memory is a byte.
@@ -100,6 +111,119 @@ var (
 	// Defaults for FlavourClusterWide
 	// DefaultLabelName is the default label key to use for identifying pod flavours
 	DefaultLabelName = "flavour"
+	// DefaultNodeLifecycleLabel is the default node label key holding the node lifecycle
+	DefaultNodeLifecycleLabel = "node.kubernetes.io/lifecycle"
+	// DefaultBatchLookahead is the default number of pending pods planned together, 0 disables the lookahead
+	DefaultBatchLookahead int32 = 0
+	// DefaultRecentPlacementWindowSeconds is the default age under which a pod counts as recently placed, 0 disables it
+	DefaultRecentPlacementWindowSeconds int64 = 0
+	// DefaultRecentPlacementWeightPercent is the default weight of a recently placed pod, relative to 100
+	DefaultRecentPlacementWeightPercent int32 = 150
+	// DefaultFairnessWindowSeconds is the default duration admissions are accounted for by the fairness arbiter
+	DefaultFairnessWindowSeconds int64 = 600
+	// DefaultNodeGroupLabel is the default node label key grouping nodes for the fairness arbiter
+	DefaultNodeGroupLabel = v1.LabelTopologyZone
+	// DefaultAnnotateNodeClass is the default for annotating bound pods with the capacity class of their node
+	DefaultAnnotateNodeClass = false
+	// DefaultOverheadBudgetMilliseconds is the default budget of the plugin per scheduling cycle, 0 disables it
+	DefaultOverheadBudgetMilliseconds int64 = 0
+	// DefaultShadowMode is the default shadow mode of the plugin, disabled
+	DefaultShadowMode = false
+	// DefaultCloudEventsSink is the default CloudEvents sink of the plugin, none
+	DefaultCloudEventsSink = ""
+	// DefaultInPlaceRebuildThreshold is the default cache size above which the cache is rebuilt in place
+	DefaultInPlaceRebuildThreshold int32 = 50000
+	// DefaultNodeReadinessSelector is the default readiness selector of worker nodes, selecting every node
+	DefaultNodeReadinessSelector = ""
+	// DefaultNodeBalanceWeight is the default weight of the node balance term of the balance score
+	DefaultNodeBalanceWeight int32 = 1
+	// DefaultZoneBalanceWeight is the default weight of the zone balance term of the balance score
+	DefaultZoneBalanceWeight int32 = 0
+	// DefaultTieBreakerWeight is the default weight of the tie-breaker term of the balance score
+	DefaultTieBreakerWeight int32 = 0
+	// DefaultLogCacheContents is the default logging of the full cache, disabled in favour of a summary
+	DefaultLogCacheContents = false
+	// DefaultScaleDownWindowSeconds is the default strict spreading window after a node drain, 0 disables it
+	DefaultScaleDownWindowSeconds int64 = 0
+	// DefaultDecisionSamplePercent is the default percentage of recorded bind decisions, all of them
+	DefaultDecisionSamplePercent int32 = 100
+	// DefaultIgnoreOtherSchedulers is the default counting of the pods of other schedulers, which are counted
+	DefaultIgnoreOtherSchedulers = false
+	// DefaultForecastHorizonSeconds is the default horizon of the flavour demand forecast, 0 disables it
+	DefaultForecastHorizonSeconds int64 = 0
+	// DefaultVerifyInformerCache is the default verification of the cache against the informers, disabled
+	DefaultVerifyInformerCache = false
+	// DefaultInformerCache is the default source of the cache, the API server rather than the informers
+	DefaultInformerCache = false
+	// DefaultCacheTTLSeconds is the default time the cache is used before it is refreshed
+	DefaultCacheTTLSeconds int64 = 60
+	// DefaultAdminAddress is the default address of the admin service, none
+	DefaultAdminAddress = ""
+	// DefaultAdminTokenFile is the default token file of the admin service, none
+	DefaultAdminTokenFile = ""
+	// DefaultAdminTLSCertFile is the default certificate file of the admin service, none
+	DefaultAdminTLSCertFile = ""
+	// DefaultAdminTLSKeyFile is the default private key file of the admin service, none
+	DefaultAdminTLSKeyFile = ""
+	// DefaultExcludedPodPhases is the default phases of the pods left out of the node totals, those whose
+	// containers have all terminated
+	DefaultExcludedPodPhases = []v1.PodPhase{v1.PodSucceeded, v1.PodFailed}
+	// DefaultPostBindQueueSize is the default size of the PostBind queue, 0 updates the cache synchronously
+	DefaultPostBindQueueSize int32 = 0
+	// DefaultMaxPodsPerFlavourPerNode is the default number of pods of a flavour per node, 0 does not limit them
+	DefaultMaxPodsPerFlavourPerNode int32 = 0
+	// DefaultTopologyKey is the default topology the flavours are balanced across, the nodes
+	DefaultTopologyKey = ""
+	// DefaultSnapshotGossipConfigMap is the default ConfigMap of the snapshot gossip, "" disables it
+	DefaultSnapshotGossipConfigMap = ""
+	// DefaultNodeLabelSelector is the default selector of the nodes the flavours are balanced across, the worker nodes
+	DefaultNodeLabelSelector = "node-role.kubernetes.io/worker"
+	// DefaultAnnotatePlacementQuality is the default for annotating bound pods with the quality of their placement
+	DefaultAnnotatePlacementQuality = false
+	// DefaultCapTopologyKey is the default node label key of the topology domains of maxPodsPerTopologyDomain, the zones
+	DefaultCapTopologyKey = v1.LabelTopologyZone
+	// DefaultMetricsLabelCap is the default number of values of the node and flavour labels of the metrics
+	DefaultMetricsLabelCap int32 = 20
+	// DefaultRecordScoringEvents is the default for recording Events explaining the scoring of bound pods
+	DefaultRecordScoringEvents = false
+	// DefaultAuditDriver is the default database/sql driver the PostgreSQL audit store is opened with
+	DefaultAuditDriver = "pgx"
+	// DefaultAuditTable is the default table of the PostgreSQL audit store
+	DefaultAuditTable = "flavour_audit"
+	// DefaultRemoteCacheKeyPrefix is the default prefix of the keys of the remote cache
+	DefaultRemoteCacheKeyPrefix = "flavourclusterwide"
+	// DefaultRemoteCacheTTLSeconds is the default time a listing is served from the remote cache
+	DefaultRemoteCacheTTLSeconds int32 = 10
+	// DefaultEnforceFlavourQuotas is the default for enforcing the FlavourQuota objects in the Permit extension point
+	DefaultEnforceFlavourQuotas = false
+	// DefaultQuotaWaitSeconds is the default time a pod exceeding the quota of its flavour waits in the Permit extension point
+	DefaultQuotaWaitSeconds int32 = 60
+	// DefaultFlavourPolicy is the default FlavourPolicy object retuning the plugin, "" disables it
+	DefaultFlavourPolicy = ""
+
+	// flavourPresets are the arguments the FlavourClusterWide presets expand into
+	flavourPresets = map[FlavourPreset]flavourPresetArgs{
+		FlavourPresetSpread: {
+			scoringStrategy: FlavourScoringSpread,
+			nodeBalance:     1,
+		},
+		FlavourPresetCostOptimized: {
+			scoringStrategy:   FlavourScoringProportional,
+			nodeBalance:       1,
+			annotateNodeClass: true,
+		},
+		FlavourPresetHA: {
+			scoringStrategy:        FlavourScoringSpread,
+			nodeBalance:            1,
+			zoneBalance:            2,
+			nodeGroupLabel:         v1.LabelTopologyZone,
+			scaleDownWindowSeconds: 300,
+		},
+		FlavourPresetConsolidate: {
+			scoringStrategy: FlavourScoringBinPack,
+			nodeBalance:     1,
+		},
+	}
 
 	// Defaults for SySched
 	// DefaultSySchedProfileNamespace is the namesapce of the default syscall profile CR for SySched plugin
@@ -244,11 +368,210 @@ func SetDefaults_NetworkOverheadArgs(obj *NetworkOverheadArgs) {
 	}
 }
 
+// flavourPresetArgs holds the arguments a FlavourClusterWide preset expands into.
+type flavourPresetArgs struct {
+	scoringStrategy        FlavourScoringStrategy
+	nodeBalance            int32
+	zoneBalance            int32
+	tieBreaker             int32
+	nodeGroupLabel         string
+	annotateNodeClass      bool
+	scaleDownWindowSeconds int64
+}
+
+// applyFlavourPreset sets the arguments of the preset of obj that are not set explicitly, before the
+// other arguments are defaulted. Unknown presets are left to validation.
+func applyFlavourPreset(obj *FlavourClusterWideArgs) {
+	preset, ok := flavourPresets[obj.Preset]
+	if !ok {
+		return
+	}
+	if obj.ScoringStrategy == "" {
+		obj.ScoringStrategy = preset.scoringStrategy
+	}
+	if obj.Weights == nil {
+		obj.Weights = &FlavourScoreWeights{}
+	}
+	if obj.Weights.NodeBalance == nil {
+		obj.Weights.NodeBalance = &preset.nodeBalance
+	}
+	if obj.Weights.ZoneBalance == nil {
+		obj.Weights.ZoneBalance = &preset.zoneBalance
+	}
+	if obj.Weights.TieBreaker == nil {
+		obj.Weights.TieBreaker = &preset.tieBreaker
+	}
+	if obj.NodeGroupLabel == nil && preset.nodeGroupLabel != "" {
+		obj.NodeGroupLabel = &preset.nodeGroupLabel
+	}
+	if obj.AnnotateNodeClass == nil {
+		obj.AnnotateNodeClass = &preset.annotateNodeClass
+	}
+	if obj.ScaleDownWindowSeconds == nil {
+		obj.ScaleDownWindowSeconds = &preset.scaleDownWindowSeconds
+	}
+}
+
 // SetDefaults_FlavourClusterWideArgs sets the default parameters for FlavourClusterWideArgs plugin.
 func SetDefaults_FlavourClusterWideArgs(obj *FlavourClusterWideArgs) {
+	applyFlavourPreset(obj)
 	if obj.LabelName == nil {
 		obj.LabelName = &DefaultLabelName
 	}
+	if obj.NodeLifecycleLabel == nil {
+		obj.NodeLifecycleLabel = &DefaultNodeLifecycleLabel
+	}
+	if obj.BatchLookahead == nil {
+		obj.BatchLookahead = &DefaultBatchLookahead
+	}
+	if obj.ScoringStrategy == "" {
+		obj.ScoringStrategy = defaultFlavourScoringStrategy
+	}
+	if obj.RecentPlacementWindowSeconds == nil {
+		obj.RecentPlacementWindowSeconds = &DefaultRecentPlacementWindowSeconds
+	}
+	if obj.RecentPlacementWeightPercent == nil {
+		obj.RecentPlacementWeightPercent = &DefaultRecentPlacementWeightPercent
+	}
+	if obj.FairnessWindowSeconds == nil {
+		obj.FairnessWindowSeconds = &DefaultFairnessWindowSeconds
+	}
+	if obj.NodeGroupLabel == nil {
+		obj.NodeGroupLabel = &DefaultNodeGroupLabel
+	}
+	if obj.AnnotateNodeClass == nil {
+		obj.AnnotateNodeClass = &DefaultAnnotateNodeClass
+	}
+	if obj.OverheadBudgetMilliseconds == nil {
+		obj.OverheadBudgetMilliseconds = &DefaultOverheadBudgetMilliseconds
+	}
+	if obj.ShadowMode == nil {
+		obj.ShadowMode = &DefaultShadowMode
+	}
+	if obj.CloudEventsSink == nil {
+		obj.CloudEventsSink = &DefaultCloudEventsSink
+	}
+	if obj.InPlaceRebuildThreshold == nil {
+		obj.InPlaceRebuildThreshold = &DefaultInPlaceRebuildThreshold
+	}
+	if obj.NodeReadinessSelector == nil {
+		obj.NodeReadinessSelector = &DefaultNodeReadinessSelector
+	}
+	if obj.Weights == nil {
+		obj.Weights = &FlavourScoreWeights{}
+	}
+	if obj.Weights.NodeBalance == nil {
+		obj.Weights.NodeBalance = &DefaultNodeBalanceWeight
+	}
+	if obj.Weights.ZoneBalance == nil {
+		obj.Weights.ZoneBalance = &DefaultZoneBalanceWeight
+	}
+	if obj.Weights.TieBreaker == nil {
+		obj.Weights.TieBreaker = &DefaultTieBreakerWeight
+	}
+	if obj.LogCacheContents == nil {
+		obj.LogCacheContents = &DefaultLogCacheContents
+	}
+	if obj.ScaleDownWindowSeconds == nil {
+		obj.ScaleDownWindowSeconds = &DefaultScaleDownWindowSeconds
+	}
+	if obj.DecisionSamplePercent == nil {
+		obj.DecisionSamplePercent = &DefaultDecisionSamplePercent
+	}
+	if obj.IgnoreOtherSchedulers == nil {
+		obj.IgnoreOtherSchedulers = &DefaultIgnoreOtherSchedulers
+	}
+	if obj.ForecastHorizonSeconds == nil {
+		obj.ForecastHorizonSeconds = &DefaultForecastHorizonSeconds
+	}
+	if obj.VerifyInformerCache == nil {
+		obj.VerifyInformerCache = &DefaultVerifyInformerCache
+	}
+	if obj.UnknownNodeScoring == "" {
+		obj.UnknownNodeScoring = defaultFlavourUnknownNodeScoring
+	}
+	if obj.InformerCache == nil {
+		obj.InformerCache = &DefaultInformerCache
+	}
+	if obj.CacheTTLSeconds == nil {
+		obj.CacheTTLSeconds = &DefaultCacheTTLSeconds
+	}
+	if obj.AdminAddress == nil {
+		obj.AdminAddress = &DefaultAdminAddress
+	}
+	if obj.AdminTokenFile == nil {
+		obj.AdminTokenFile = &DefaultAdminTokenFile
+	}
+	if obj.AdminTLSCertFile == nil {
+		obj.AdminTLSCertFile = &DefaultAdminTLSCertFile
+	}
+	if obj.AdminTLSKeyFile == nil {
+		obj.AdminTLSKeyFile = &DefaultAdminTLSKeyFile
+	}
+	if obj.ExcludedPodPhases == nil {
+		obj.ExcludedPodPhases = append([]v1.PodPhase(nil), DefaultExcludedPodPhases...)
+	}
+	if obj.PostBindQueueSize == nil {
+		obj.PostBindQueueSize = &DefaultPostBindQueueSize
+	}
+	if obj.PostBindOverflowPolicy == "" {
+		obj.PostBindOverflowPolicy = defaultFlavourPostBindOverflowPolicy
+	}
+	if obj.MaxPodsPerFlavourPerNode == nil {
+		obj.MaxPodsPerFlavourPerNode = &DefaultMaxPodsPerFlavourPerNode
+	}
+	if obj.TopologyKey == nil {
+		obj.TopologyKey = &DefaultTopologyKey
+	}
+	if obj.SnapshotGossipConfigMap == nil {
+		obj.SnapshotGossipConfigMap = &DefaultSnapshotGossipConfigMap
+	}
+	if obj.NodeLabelSelector == nil {
+		obj.NodeLabelSelector = &DefaultNodeLabelSelector
+	}
+	if obj.ScoreCombiner == "" {
+		obj.ScoreCombiner = defaultFlavourScoreCombiner
+	}
+	if obj.AnnotatePlacementQuality == nil {
+		obj.AnnotatePlacementQuality = &DefaultAnnotatePlacementQuality
+	}
+	if obj.CountMode == "" {
+		obj.CountMode = defaultFlavourCountMode
+	}
+	if obj.CapTopologyKey == nil {
+		obj.CapTopologyKey = &DefaultCapTopologyKey
+	}
+	if obj.MetricsLabelCap == nil {
+		obj.MetricsLabelCap = &DefaultMetricsLabelCap
+	}
+	if obj.RecordScoringEvents == nil {
+		obj.RecordScoringEvents = &DefaultRecordScoringEvents
+	}
+	if obj.AuditStore != nil && obj.AuditStore.Type == FlavourAuditStorePostgreSQL {
+		if obj.AuditStore.Driver == nil {
+			obj.AuditStore.Driver = &DefaultAuditDriver
+		}
+		if obj.AuditStore.Table == nil {
+			obj.AuditStore.Table = &DefaultAuditTable
+		}
+	}
+	if obj.RemoteCache != nil && obj.RemoteCache.Type == FlavourRemoteCacheRedis {
+		if obj.RemoteCache.KeyPrefix == nil {
+			obj.RemoteCache.KeyPrefix = &DefaultRemoteCacheKeyPrefix
+		}
+		if obj.RemoteCache.TTLSeconds == nil {
+			obj.RemoteCache.TTLSeconds = &DefaultRemoteCacheTTLSeconds
+		}
+	}
+	if obj.EnforceFlavourQuotas == nil {
+		obj.EnforceFlavourQuotas = &DefaultEnforceFlavourQuotas
+	}
+	if obj.QuotaWaitSeconds == nil {
+		obj.QuotaWaitSeconds = &DefaultQuotaWaitSeconds
+	}
+	if obj.FlavourPolicy == nil {
+		obj.FlavourPolicy = &DefaultFlavourPolicy
+	}
 }
 
 // SetDefaults_SySchedArgs sets the default parameters for SySchedArgs plugin.
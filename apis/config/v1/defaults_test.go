@@ -274,6 +274,336 @@ func TestSchedulingDefaults(t *testing.T) {
 				DefaultProfileName:      pointer.StringPtr("all-syscalls"),
 			},
 		},
+		{
+			name:   "empty config FlavourClusterWideArgs",
+			config: &FlavourClusterWideArgs{},
+			expect: &FlavourClusterWideArgs{
+				LabelName:                    pointer.StringPtr("flavour"),
+				NodeLifecycleLabel:           pointer.StringPtr("node.kubernetes.io/lifecycle"),
+				BatchLookahead:               pointer.Int32Ptr(0),
+				ScoringStrategy:              FlavourScoringSpread,
+				RecentPlacementWindowSeconds: pointer.Int64Ptr(0),
+				RecentPlacementWeightPercent: pointer.Int32Ptr(150),
+				FairnessWindowSeconds:        pointer.Int64Ptr(600),
+				NodeGroupLabel:               pointer.StringPtr("topology.kubernetes.io/zone"),
+				AnnotateNodeClass:            pointer.BoolPtr(false),
+				OverheadBudgetMilliseconds:   pointer.Int64Ptr(0),
+				ShadowMode:                   pointer.BoolPtr(false),
+				CloudEventsSink:              pointer.StringPtr(""),
+				InPlaceRebuildThreshold:      pointer.Int32Ptr(50000),
+				NodeReadinessSelector:        pointer.StringPtr(""),
+				Weights: &FlavourScoreWeights{
+					NodeBalance: pointer.Int32Ptr(1),
+					ZoneBalance: pointer.Int32Ptr(0),
+					TieBreaker:  pointer.Int32Ptr(0),
+				},
+				LogCacheContents:         pointer.BoolPtr(false),
+				ScaleDownWindowSeconds:   pointer.Int64Ptr(0),
+				DecisionSamplePercent:    pointer.Int32Ptr(100),
+				IgnoreOtherSchedulers:    pointer.BoolPtr(false),
+				ForecastHorizonSeconds:   pointer.Int64Ptr(0),
+				VerifyInformerCache:      pointer.BoolPtr(false),
+				UnknownNodeScoring:       FlavourUnknownNodeEmpty,
+				InformerCache:            pointer.BoolPtr(false),
+				CacheTTLSeconds:          pointer.Int64Ptr(60),
+				AdminAddress:             pointer.StringPtr(""),
+				AdminTokenFile:           pointer.StringPtr(""),
+				AdminTLSCertFile:         pointer.StringPtr(""),
+				AdminTLSKeyFile:          pointer.StringPtr(""),
+				ExcludedPodPhases:        []v1.PodPhase{v1.PodSucceeded, v1.PodFailed},
+				PostBindQueueSize:        pointer.Int32Ptr(0),
+				PostBindOverflowPolicy:   FlavourPostBindDropAndReconcile,
+				MaxPodsPerFlavourPerNode: pointer.Int32Ptr(0),
+				TopologyKey:              pointer.StringPtr(""),
+				SnapshotGossipConfigMap:  pointer.StringPtr(""),
+				NodeLabelSelector:        pointer.StringPtr("node-role.kubernetes.io/worker"),
+				ScoreCombiner:            FlavourCombinerWeightedSum,
+				AnnotatePlacementQuality: pointer.BoolPtr(false),
+				CountMode:                FlavourCountPods,
+				CapTopologyKey:           pointer.StringPtr("topology.kubernetes.io/zone"),
+				MetricsLabelCap:          pointer.Int32Ptr(20),
+				RecordScoringEvents:      pointer.BoolPtr(false),
+				EnforceFlavourQuotas:     pointer.BoolPtr(false),
+				QuotaWaitSeconds:         pointer.Int32Ptr(60),
+				FlavourPolicy:            pointer.StringPtr(""),
+			},
+		},
+		{
+			name: "FlavourClusterWideArgs with a Redis remote cache",
+			config: &FlavourClusterWideArgs{
+				RemoteCache: &FlavourRemoteCache{Type: FlavourRemoteCacheRedis, Address: "redis.scheduler:6379"},
+			},
+			expect: &FlavourClusterWideArgs{
+				LabelName:                    pointer.StringPtr("flavour"),
+				NodeLifecycleLabel:           pointer.StringPtr("node.kubernetes.io/lifecycle"),
+				BatchLookahead:               pointer.Int32Ptr(0),
+				ScoringStrategy:              FlavourScoringSpread,
+				RecentPlacementWindowSeconds: pointer.Int64Ptr(0),
+				RecentPlacementWeightPercent: pointer.Int32Ptr(150),
+				FairnessWindowSeconds:        pointer.Int64Ptr(600),
+				NodeGroupLabel:               pointer.StringPtr("topology.kubernetes.io/zone"),
+				AnnotateNodeClass:            pointer.BoolPtr(false),
+				OverheadBudgetMilliseconds:   pointer.Int64Ptr(0),
+				ShadowMode:                   pointer.BoolPtr(false),
+				CloudEventsSink:              pointer.StringPtr(""),
+				InPlaceRebuildThreshold:      pointer.Int32Ptr(50000),
+				NodeReadinessSelector:        pointer.StringPtr(""),
+				Weights: &FlavourScoreWeights{
+					NodeBalance: pointer.Int32Ptr(1),
+					ZoneBalance: pointer.Int32Ptr(0),
+					TieBreaker:  pointer.Int32Ptr(0),
+				},
+				LogCacheContents:         pointer.BoolPtr(false),
+				ScaleDownWindowSeconds:   pointer.Int64Ptr(0),
+				DecisionSamplePercent:    pointer.Int32Ptr(100),
+				IgnoreOtherSchedulers:    pointer.BoolPtr(false),
+				ForecastHorizonSeconds:   pointer.Int64Ptr(0),
+				VerifyInformerCache:      pointer.BoolPtr(false),
+				UnknownNodeScoring:       FlavourUnknownNodeEmpty,
+				InformerCache:            pointer.BoolPtr(false),
+				CacheTTLSeconds:          pointer.Int64Ptr(60),
+				RemoteCache:              &FlavourRemoteCache{Type: FlavourRemoteCacheRedis, Address: "redis.scheduler:6379", KeyPrefix: pointer.StringPtr("flavourclusterwide"), TTLSeconds: pointer.Int32Ptr(10)},
+				AdminAddress:             pointer.StringPtr(""),
+				AdminTokenFile:           pointer.StringPtr(""),
+				AdminTLSCertFile:         pointer.StringPtr(""),
+				AdminTLSKeyFile:          pointer.StringPtr(""),
+				ExcludedPodPhases:        []v1.PodPhase{v1.PodSucceeded, v1.PodFailed},
+				PostBindQueueSize:        pointer.Int32Ptr(0),
+				PostBindOverflowPolicy:   FlavourPostBindDropAndReconcile,
+				MaxPodsPerFlavourPerNode: pointer.Int32Ptr(0),
+				TopologyKey:              pointer.StringPtr(""),
+				SnapshotGossipConfigMap:  pointer.StringPtr(""),
+				NodeLabelSelector:        pointer.StringPtr("node-role.kubernetes.io/worker"),
+				ScoreCombiner:            FlavourCombinerWeightedSum,
+				AnnotatePlacementQuality: pointer.BoolPtr(false),
+				CountMode:                FlavourCountPods,
+				CapTopologyKey:           pointer.StringPtr("topology.kubernetes.io/zone"),
+				MetricsLabelCap:          pointer.Int32Ptr(20),
+				RecordScoringEvents:      pointer.BoolPtr(false),
+				EnforceFlavourQuotas:     pointer.BoolPtr(false),
+				QuotaWaitSeconds:         pointer.Int32Ptr(60),
+				FlavourPolicy:            pointer.StringPtr(""),
+			},
+		},
+		{
+			name: "set non default FlavourClusterWideArgs",
+			config: &FlavourClusterWideArgs{
+				LabelName:                    pointer.StringPtr("tier"),
+				NodeLifecycleLabel:           pointer.StringPtr("karpenter.sh/capacity-type"),
+				BatchLookahead:               pointer.Int32Ptr(50),
+				ScoringStrategy:              FlavourScoringVarianceReduction,
+				RecentPlacementWindowSeconds: pointer.Int64Ptr(300),
+				RecentPlacementWeightPercent: pointer.Int32Ptr(120),
+				FairnessWindowSeconds:        pointer.Int64Ptr(60),
+				NodeGroupLabel:               pointer.StringPtr("node.kubernetes.io/instance-type"),
+				AnnotateNodeClass:            pointer.BoolPtr(true),
+				OverheadBudgetMilliseconds:   pointer.Int64Ptr(5),
+				ShadowMode:                   pointer.BoolPtr(true),
+				ComparisonStrategy:           FlavourScoringSpread,
+				CloudEventsSink:              pointer.StringPtr("http://event-display.default.svc"),
+				InPlaceRebuildThreshold:      pointer.Int32Ptr(0),
+				NodeReadinessSelector:        pointer.StringPtr("example.com/gpu-driver-ready=true"),
+				NodeReadinessConditions:      []string{"NetworkReady"},
+				Weights:                      &FlavourScoreWeights{ZoneBalance: pointer.Int32Ptr(3)},
+				LogCacheContents:             pointer.BoolPtr(true),
+				ScaleDownWindowSeconds:       pointer.Int64Ptr(120),
+				DecisionSamplePercent:        pointer.Int32Ptr(5),
+				IgnoreOtherSchedulers:        pointer.BoolPtr(true),
+				ForecastHorizonSeconds:       pointer.Int64Ptr(60),
+				VerifyInformerCache:          pointer.BoolPtr(true),
+				UnknownNodeScoring:           FlavourUnknownNodeNeutral,
+				InformerCache:                pointer.BoolPtr(true),
+				CacheTTLSeconds:              pointer.Int64Ptr(300),
+				AdminAddress:                 pointer.StringPtr("127.0.0.1:10270"),
+				AdminTokenFile:               pointer.StringPtr("/etc/flavour-admin/token"),
+				AdminTLSCertFile:             pointer.StringPtr("/etc/flavour-admin/tls.crt"),
+				AdminTLSKeyFile:              pointer.StringPtr("/etc/flavour-admin/tls.key"),
+				ExcludedPodPhases:            []v1.PodPhase{v1.PodFailed},
+				PostBindQueueSize:            pointer.Int32Ptr(1000),
+				PostBindOverflowPolicy:       FlavourPostBindBlock,
+				MaxPodsPerFlavourPerNode:     pointer.Int32Ptr(3),
+				TopologyKey:                  pointer.StringPtr("topology.kubernetes.io/zone"),
+				SnapshotGossipConfigMap:      pointer.StringPtr("kube-system/flavour-snapshot"),
+				NodeLabelSelector:            pointer.StringPtr("node-role.kubernetes.io/compute"),
+				ScoreCombiner:                FlavourCombinerLexicographic,
+				AnnotatePlacementQuality:     pointer.BoolPtr(true),
+				CountMode:                    FlavourCountPods,
+				CapTopologyKey:               pointer.StringPtr("topology.kubernetes.io/zone"),
+				MetricsLabelCap:              pointer.Int32Ptr(20),
+				RecordScoringEvents:          pointer.BoolPtr(false),
+				AuditStore:                   &FlavourAuditStore{Type: FlavourAuditStorePostgreSQL, ConnectionStringFile: "/etc/flavour-audit/dsn"},
+				EnforceFlavourQuotas:         pointer.BoolPtr(true),
+				QuotaWaitSeconds:             pointer.Int32Ptr(120),
+				FlavourPolicy:                pointer.StringPtr("kube-system/flavours"),
+			},
+			expect: &FlavourClusterWideArgs{
+				LabelName:                    pointer.StringPtr("tier"),
+				NodeLifecycleLabel:           pointer.StringPtr("karpenter.sh/capacity-type"),
+				BatchLookahead:               pointer.Int32Ptr(50),
+				ScoringStrategy:              FlavourScoringVarianceReduction,
+				RecentPlacementWindowSeconds: pointer.Int64Ptr(300),
+				RecentPlacementWeightPercent: pointer.Int32Ptr(120),
+				FairnessWindowSeconds:        pointer.Int64Ptr(60),
+				NodeGroupLabel:               pointer.StringPtr("node.kubernetes.io/instance-type"),
+				AnnotateNodeClass:            pointer.BoolPtr(true),
+				OverheadBudgetMilliseconds:   pointer.Int64Ptr(5),
+				ShadowMode:                   pointer.BoolPtr(true),
+				ComparisonStrategy:           FlavourScoringSpread,
+				CloudEventsSink:              pointer.StringPtr("http://event-display.default.svc"),
+				InPlaceRebuildThreshold:      pointer.Int32Ptr(0),
+				NodeReadinessSelector:        pointer.StringPtr("example.com/gpu-driver-ready=true"),
+				NodeReadinessConditions:      []string{"NetworkReady"},
+				Weights: &FlavourScoreWeights{
+					NodeBalance: pointer.Int32Ptr(1),
+					ZoneBalance: pointer.Int32Ptr(3),
+					TieBreaker:  pointer.Int32Ptr(0),
+				},
+				LogCacheContents:         pointer.BoolPtr(true),
+				ScaleDownWindowSeconds:   pointer.Int64Ptr(120),
+				DecisionSamplePercent:    pointer.Int32Ptr(5),
+				IgnoreOtherSchedulers:    pointer.BoolPtr(true),
+				ForecastHorizonSeconds:   pointer.Int64Ptr(60),
+				VerifyInformerCache:      pointer.BoolPtr(true),
+				UnknownNodeScoring:       FlavourUnknownNodeNeutral,
+				InformerCache:            pointer.BoolPtr(true),
+				CacheTTLSeconds:          pointer.Int64Ptr(300),
+				AdminAddress:             pointer.StringPtr("127.0.0.1:10270"),
+				AdminTokenFile:           pointer.StringPtr("/etc/flavour-admin/token"),
+				AdminTLSCertFile:         pointer.StringPtr("/etc/flavour-admin/tls.crt"),
+				AdminTLSKeyFile:          pointer.StringPtr("/etc/flavour-admin/tls.key"),
+				ExcludedPodPhases:        []v1.PodPhase{v1.PodFailed},
+				PostBindQueueSize:        pointer.Int32Ptr(1000),
+				PostBindOverflowPolicy:   FlavourPostBindBlock,
+				MaxPodsPerFlavourPerNode: pointer.Int32Ptr(3),
+				TopologyKey:              pointer.StringPtr("topology.kubernetes.io/zone"),
+				SnapshotGossipConfigMap:  pointer.StringPtr("kube-system/flavour-snapshot"),
+				NodeLabelSelector:        pointer.StringPtr("node-role.kubernetes.io/compute"),
+				ScoreCombiner:            FlavourCombinerLexicographic,
+				AnnotatePlacementQuality: pointer.BoolPtr(true),
+				CountMode:                FlavourCountPods,
+				CapTopologyKey:           pointer.StringPtr("topology.kubernetes.io/zone"),
+				MetricsLabelCap:          pointer.Int32Ptr(20),
+				RecordScoringEvents:      pointer.BoolPtr(false),
+				AuditStore:               &FlavourAuditStore{Type: FlavourAuditStorePostgreSQL, ConnectionStringFile: "/etc/flavour-audit/dsn", Driver: pointer.StringPtr("pgx"), Table: pointer.StringPtr("flavour_audit")},
+				EnforceFlavourQuotas:     pointer.BoolPtr(true),
+				QuotaWaitSeconds:         pointer.Int32Ptr(120),
+				FlavourPolicy:            pointer.StringPtr("kube-system/flavours"),
+			},
+		},
+		{
+			name: "HA preset FlavourClusterWideArgs with overrides",
+			config: &FlavourClusterWideArgs{
+				Preset:                 FlavourPresetHA,
+				ScaleDownWindowSeconds: pointer.Int64Ptr(60),
+				Weights:                &FlavourScoreWeights{TieBreaker: pointer.Int32Ptr(1)},
+			},
+			expect: &FlavourClusterWideArgs{
+				LabelName:                    pointer.StringPtr("flavour"),
+				NodeLifecycleLabel:           pointer.StringPtr("node.kubernetes.io/lifecycle"),
+				BatchLookahead:               pointer.Int32Ptr(0),
+				ScoringStrategy:              FlavourScoringSpread,
+				RecentPlacementWindowSeconds: pointer.Int64Ptr(0),
+				RecentPlacementWeightPercent: pointer.Int32Ptr(150),
+				FairnessWindowSeconds:        pointer.Int64Ptr(600),
+				NodeGroupLabel:               pointer.StringPtr("topology.kubernetes.io/zone"),
+				AnnotateNodeClass:            pointer.BoolPtr(false),
+				OverheadBudgetMilliseconds:   pointer.Int64Ptr(0),
+				ShadowMode:                   pointer.BoolPtr(false),
+				CloudEventsSink:              pointer.StringPtr(""),
+				InPlaceRebuildThreshold:      pointer.Int32Ptr(50000),
+				NodeReadinessSelector:        pointer.StringPtr(""),
+				Weights: &FlavourScoreWeights{
+					NodeBalance: pointer.Int32Ptr(1),
+					ZoneBalance: pointer.Int32Ptr(2),
+					TieBreaker:  pointer.Int32Ptr(1),
+				},
+				LogCacheContents:         pointer.BoolPtr(false),
+				ScaleDownWindowSeconds:   pointer.Int64Ptr(60),
+				DecisionSamplePercent:    pointer.Int32Ptr(100),
+				IgnoreOtherSchedulers:    pointer.BoolPtr(false),
+				ForecastHorizonSeconds:   pointer.Int64Ptr(0),
+				VerifyInformerCache:      pointer.BoolPtr(false),
+				UnknownNodeScoring:       FlavourUnknownNodeEmpty,
+				InformerCache:            pointer.BoolPtr(false),
+				CacheTTLSeconds:          pointer.Int64Ptr(60),
+				AdminAddress:             pointer.StringPtr(""),
+				AdminTokenFile:           pointer.StringPtr(""),
+				AdminTLSCertFile:         pointer.StringPtr(""),
+				AdminTLSKeyFile:          pointer.StringPtr(""),
+				ExcludedPodPhases:        []v1.PodPhase{v1.PodSucceeded, v1.PodFailed},
+				PostBindQueueSize:        pointer.Int32Ptr(0),
+				PostBindOverflowPolicy:   FlavourPostBindDropAndReconcile,
+				MaxPodsPerFlavourPerNode: pointer.Int32Ptr(0),
+				TopologyKey:              pointer.StringPtr(""),
+				SnapshotGossipConfigMap:  pointer.StringPtr(""),
+				NodeLabelSelector:        pointer.StringPtr("node-role.kubernetes.io/worker"),
+				ScoreCombiner:            FlavourCombinerWeightedSum,
+				AnnotatePlacementQuality: pointer.BoolPtr(false),
+				CountMode:                FlavourCountPods,
+				CapTopologyKey:           pointer.StringPtr("topology.kubernetes.io/zone"),
+				MetricsLabelCap:          pointer.Int32Ptr(20),
+				RecordScoringEvents:      pointer.BoolPtr(false),
+				EnforceFlavourQuotas:     pointer.BoolPtr(false),
+				QuotaWaitSeconds:         pointer.Int32Ptr(60),
+				FlavourPolicy:            pointer.StringPtr(""),
+				Preset:                   FlavourPresetHA,
+			},
+		},
+		{
+			name:   "Consolidate preset FlavourClusterWideArgs",
+			config: &FlavourClusterWideArgs{Preset: FlavourPresetConsolidate},
+			expect: &FlavourClusterWideArgs{
+				LabelName:                    pointer.StringPtr("flavour"),
+				NodeLifecycleLabel:           pointer.StringPtr("node.kubernetes.io/lifecycle"),
+				BatchLookahead:               pointer.Int32Ptr(0),
+				ScoringStrategy:              FlavourScoringBinPack,
+				RecentPlacementWindowSeconds: pointer.Int64Ptr(0),
+				RecentPlacementWeightPercent: pointer.Int32Ptr(150),
+				FairnessWindowSeconds:        pointer.Int64Ptr(600),
+				NodeGroupLabel:               pointer.StringPtr("topology.kubernetes.io/zone"),
+				AnnotateNodeClass:            pointer.BoolPtr(false),
+				OverheadBudgetMilliseconds:   pointer.Int64Ptr(0),
+				ShadowMode:                   pointer.BoolPtr(false),
+				CloudEventsSink:              pointer.StringPtr(""),
+				InPlaceRebuildThreshold:      pointer.Int32Ptr(50000),
+				NodeReadinessSelector:        pointer.StringPtr(""),
+				Weights: &FlavourScoreWeights{
+					NodeBalance: pointer.Int32Ptr(1),
+					ZoneBalance: pointer.Int32Ptr(0),
+					TieBreaker:  pointer.Int32Ptr(0),
+				},
+				LogCacheContents:         pointer.BoolPtr(false),
+				ScaleDownWindowSeconds:   pointer.Int64Ptr(0),
+				DecisionSamplePercent:    pointer.Int32Ptr(100),
+				IgnoreOtherSchedulers:    pointer.BoolPtr(false),
+				ForecastHorizonSeconds:   pointer.Int64Ptr(0),
+				VerifyInformerCache:      pointer.BoolPtr(false),
+				UnknownNodeScoring:       FlavourUnknownNodeEmpty,
+				InformerCache:            pointer.BoolPtr(false),
+				CacheTTLSeconds:          pointer.Int64Ptr(60),
+				AdminAddress:             pointer.StringPtr(""),
+				AdminTokenFile:           pointer.StringPtr(""),
+				AdminTLSCertFile:         pointer.StringPtr(""),
+				AdminTLSKeyFile:          pointer.StringPtr(""),
+				ExcludedPodPhases:        []v1.PodPhase{v1.PodSucceeded, v1.PodFailed},
+				PostBindQueueSize:        pointer.Int32Ptr(0),
+				PostBindOverflowPolicy:   FlavourPostBindDropAndReconcile,
+				MaxPodsPerFlavourPerNode: pointer.Int32Ptr(0),
+				TopologyKey:              pointer.StringPtr(""),
+				SnapshotGossipConfigMap:  pointer.StringPtr(""),
+				NodeLabelSelector:        pointer.StringPtr("node-role.kubernetes.io/worker"),
+				ScoreCombiner:            FlavourCombinerWeightedSum,
+				AnnotatePlacementQuality: pointer.BoolPtr(false),
+				CountMode:                FlavourCountPods,
+				CapTopologyKey:           pointer.StringPtr("topology.kubernetes.io/zone"),
+				MetricsLabelCap:          pointer.Int32Ptr(20),
+				RecordScoringEvents:      pointer.BoolPtr(false),
+				EnforceFlavourQuotas:     pointer.BoolPtr(false),
+				QuotaWaitSeconds:         pointer.Int32Ptr(60),
+				FlavourPolicy:            pointer.StringPtr(""),
+				Preset:                   FlavourPresetConsolidate,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestFlavourClusterWideArgsSchema makes sure the embedded schema documents exactly the
+// fields of FlavourClusterWideArgs; update schemas/flavourclusterwideargs.json when it fails.
+func TestFlavourClusterWideArgsSchema(t *testing.T) {
+	var schema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(FlavourClusterWideArgsSchema, &schema); err != nil {
+		t.Fatalf("schema is not valid JSON: %v", err)
+	}
+
+	want := []string{"apiVersion", "kind"}
+	argsType := reflect.TypeOf(FlavourClusterWideArgs{})
+	for i := 0; i < argsType.NumField(); i++ {
+		tag := argsType.Field(i).Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		want = append(want, name)
+	}
+
+	var got []string
+	for name := range schema.Properties {
+		got = append(got, name)
+	}
+	sort.Strings(want)
+	sort.Strings(got)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("schema properties out of sync with FlavourClusterWideArgs (-want,+got):\n%s", diff)
+	}
+}
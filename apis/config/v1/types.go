@@ -298,6 +298,186 @@ type PowerModel struct {
 	// Idle power of node will be K0 + K1
 }
 
+// FlavourScoringStrategy is a "string" type.
+type FlavourScoringStrategy string
+
+const (
+	// FlavourScoringSpread scores the nodes hosting the fewest pods of the flavour.
+	FlavourScoringSpread FlavourScoringStrategy = "Spread"
+	// FlavourScoringVarianceReduction scores nodes inversely to the variance of the flavour's
+	// distribution once the pod is placed on them.
+	FlavourScoringVarianceReduction FlavourScoringStrategy = "VarianceReduction"
+	// FlavourScoringProportional scores nodes linearly between the least loaded node of the flavour,
+	// which gets the maximum score, and the most loaded one, which gets 0.
+	FlavourScoringProportional FlavourScoringStrategy = "Proportional"
+	// FlavourScoringBinPack scores nodes linearly between the most loaded node of the flavour, which
+	// gets the maximum score, and the least loaded one, which gets 0, packing the flavour onto as few
+	// nodes as possible.
+	FlavourScoringBinPack FlavourScoringStrategy = "BinPack"
+)
+
+// FlavourUnknownNodeScoring is a "string" type.
+type FlavourUnknownNodeScoring string
+
+const (
+	// FlavourUnknownNodeEmpty scores the nodes missing from the cache as if they hosted no pods.
+	FlavourUnknownNodeEmpty FlavourUnknownNodeScoring = "Empty"
+	// FlavourUnknownNodeNeutral gives the nodes missing from the cache half of the maximum score.
+	FlavourUnknownNodeNeutral FlavourUnknownNodeScoring = "Neutral"
+)
+
+// FlavourPostBindOverflowPolicy is a "string" type.
+type FlavourPostBindOverflowPolicy string
+
+const (
+	// FlavourPostBindDropAndReconcile drops the cache update of a bind when the PostBind queue is full,
+	// and rebuilds the cache on the next scheduling cycle rather than once it expires.
+	FlavourPostBindDropAndReconcile FlavourPostBindOverflowPolicy = "DropAndReconcile"
+	// FlavourPostBindBlock blocks PostBind until the PostBind queue has room for the cache update.
+	FlavourPostBindBlock FlavourPostBindOverflowPolicy = "Block"
+)
+
+// FlavourPreset is a "string" type.
+type FlavourPreset string
+
+const (
+	// FlavourPresetSpread balances every flavour across the nodes with the Spread strategy.
+	FlavourPresetSpread FlavourPreset = "Spread"
+	// FlavourPresetCostOptimized balances the flavours loosely with the Proportional strategy, leaving
+	// room to the resource packing plugins, and annotates the bound pods with the class of their node
+	// for the autoscaler.
+	FlavourPresetCostOptimized FlavourPreset = "CostOptimized"
+	// FlavourPresetHA spreads every flavour across the zones first and then across their nodes, and
+	// spreads strictly the pods rescheduling after a node drain.
+	FlavourPresetHA FlavourPreset = "HA"
+	// FlavourPresetConsolidate packs every flavour onto as few nodes as possible with the BinPack strategy.
+	FlavourPresetConsolidate FlavourPreset = "Consolidate"
+)
+
+// FlavourScoreCombiner is a "string" type.
+type FlavourScoreCombiner string
+
+const (
+	// FlavourCombinerWeightedSum averages the terms of the balance score, weighted by their weights.
+	FlavourCombinerWeightedSum FlavourScoreCombiner = "WeightedSum"
+	// FlavourCombinerLexicographic orders the nodes by the term of the largest weight, and breaks ties
+	// with the terms of smaller weights in turn.
+	FlavourCombinerLexicographic FlavourScoreCombiner = "Lexicographic"
+	// FlavourCombinerMaxMin scores the nodes with their lowest weighted term, so that the node whose
+	// worst term is the best wins.
+	FlavourCombinerMaxMin FlavourScoreCombiner = "MaxMin"
+)
+
+// FlavourCountMode is a "string" type.
+type FlavourCountMode string
+
+const (
+	// FlavourCountPods counts the pods of every flavour.
+	FlavourCountPods FlavourCountMode = "Pods"
+	// FlavourCountCPURequests counts the CPU requested by the pods of every flavour, in millicores.
+	FlavourCountCPURequests FlavourCountMode = "CPURequests"
+	// FlavourCountMemoryRequests counts the memory requested by the pods of every flavour, in MiB.
+	FlavourCountMemoryRequests FlavourCountMode = "MemoryRequests"
+	// FlavourCountResourceWeighted counts the CPU and memory requested by the pods of every flavour,
+	// a CPU weighing as much as 4GiB.
+	FlavourCountResourceWeighted FlavourCountMode = "ResourceWeighted"
+)
+
+// FlavourNamespaces selects the namespaces whose pods are accounted for in the distribution.
+type FlavourNamespaces struct {
+	// Include lists the namespaces whose pods are accounted for. Empty includes every namespace.
+	Include []string `json:"include,omitempty"`
+	// Exclude lists the namespaces whose pods are not accounted for, even when Include lists them.
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// FlavourScoreWeights weighs the terms combined into the balance score of a node.
+type FlavourScoreWeights struct {
+	// NodeBalance weighs the balance of the flavour across the nodes, scored with the scoring strategy.
+	NodeBalance *int32 `json:"nodeBalance,omitempty"`
+	// ZoneBalance weighs the balance of the flavour across the node groups of NodeGroupLabel, scored
+	// with the scoring strategy.
+	ZoneBalance *int32 `json:"zoneBalance,omitempty"`
+	// TieBreaker weighs the preference for nodes hosting fewer flavoured pods of any flavour.
+	TieBreaker *int32 `json:"tieBreaker,omitempty"`
+}
+
+// FlavourTopologyTier is a tier of the topology the flavours are balanced across, with its tolerance.
+type FlavourTopologyTier struct {
+	// TopologyKey is the node label key of the groups of the tier, such as topology.kubernetes.io/region,
+	// or kubernetes.io/hostname for the tolerance of the nodes, as the last tier.
+	TopologyKey string `json:"topologyKey"`
+	// Tolerance is the number of pods of a flavour a group of the tier may host above the least loaded
+	// group of the tier before it is considered imbalanced.
+	Tolerance int32 `json:"tolerance,omitempty"`
+}
+
+// FlavourLabelKey is a further label key the pods are classified by, with the weight of its spread score.
+type FlavourLabelKey struct {
+	// LabelKey is the pod label key, such as tier.
+	LabelKey string `json:"labelKey"`
+	// Weight weighs the spread score of the pods sharing the value of LabelKey against the balance score
+	// of the flavour and the other label keys.
+	Weight int32 `json:"weight"`
+}
+
+// FlavourAuditStoreType is a "string" type.
+type FlavourAuditStoreType string
+
+const (
+	// FlavourAuditStoreLoki pushes the audit records to the push API of Loki.
+	FlavourAuditStoreLoki FlavourAuditStoreType = "Loki"
+	// FlavourAuditStorePostgreSQL inserts the audit records into a table of PostgreSQL.
+	FlavourAuditStorePostgreSQL FlavourAuditStoreType = "PostgreSQL"
+)
+
+// FlavourAuditStore is the external store the bind decisions are written to, so that they are kept
+// longer than the scheduler keeps them in memory.
+type FlavourAuditStore struct {
+	// Type is the type of the store, Loki or PostgreSQL.
+	Type FlavourAuditStoreType `json:"type"`
+	// URL is the push API endpoint of Loki, such as http://loki:3100/loki/api/v1/push. Required with Loki.
+	URL string `json:"url,omitempty"`
+	// TenantID is sent as the X-Scope-OrgID header to a multi-tenant Loki.
+	TenantID string `json:"tenantID,omitempty"`
+	// ConnectionStringFile is the file holding the connection string of PostgreSQL, such as a mounted
+	// Secret. Required with PostgreSQL.
+	ConnectionStringFile string `json:"connectionStringFile,omitempty"`
+	// Driver is the name of the database/sql driver PostgreSQL is opened with, which the scheduler build
+	// must register. The scheduler of this repository registers "pgx". Defaults to "pgx".
+	Driver *string `json:"driver,omitempty"`
+	// Table is the table of PostgreSQL the audit records are inserted into. It is created when missing.
+	// Defaults to "flavour_audit".
+	Table *string `json:"table,omitempty"`
+}
+
+// FlavourRemoteCacheType is a "string" type.
+type FlavourRemoteCacheType string
+
+const (
+	// FlavourRemoteCacheRedis shares the listings through Redis.
+	FlavourRemoteCacheRedis FlavourRemoteCacheType = "Redis"
+)
+
+// FlavourRemoteCache is the remote cache the replicas of the scheduler share the listings of the nodes and
+// flavoured pods through, so that the API server is listed once per TTL between them rather than once per
+// replica.
+type FlavourRemoteCache struct {
+	// Type is the type of the remote cache, Redis.
+	Type FlavourRemoteCacheType `json:"type"`
+	// Address is the host:port of Redis, such as redis.scheduler:6379. Required.
+	Address string `json:"address"`
+	// PasswordFile is the file holding the password of Redis, such as a mounted Secret. Defaults to "",
+	// for a Redis without authentication.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// KeyPrefix prefixes the keys of the listings, so that several schedulers can share a Redis.
+	// Defaults to "flavourclusterwide".
+	KeyPrefix *string `json:"keyPrefix,omitempty"`
+	// TTLSeconds is how long a listing is served from the remote cache before a replica lists the API
+	// server again. It must be shorter than CacheTTLSeconds. Defaults to 10.
+	TTLSeconds *int32 `json:"ttlSeconds,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +k8s:defaulter-gen=true
 
@@ -308,4 +488,336 @@ type FlavourClusterWideArgs struct {
 	// LabelName is the label key to use for identifying pod flavours.
 	// Defaults to "flavour" if not specified.
 	LabelName *string `json:"labelName,omitempty"`
+
+	// NodeLifecycleLabel is the node label key holding the node lifecycle (e.g. on-demand, spot).
+	// Defaults to "node.kubernetes.io/lifecycle" if not specified.
+	NodeLifecycleLabel *string `json:"nodeLifecycleLabel,omitempty"`
+
+	// LifecyclePreferences maps a flavour to the node lifecycles its pods prefer, most preferred first.
+	// Nodes are scored by the position of their lifecycle in the list, falling back to the next entry
+	// when no node of a preferred lifecycle is feasible; nodes whose lifecycle is not listed score lowest.
+	// Flavours without an entry are balanced over all nodes.
+	LifecyclePreferences map[string][]string `json:"lifecyclePreferences,omitempty"`
+
+	// BatchLookahead is the maximum number of pending pods of the same flavour that are planned
+	// together with the pod being scheduled. Nodes are scored as if the batch were spread over
+	// the least loaded nodes round-robin, instead of only favouring the current minimum.
+	// Defaults to 0, which disables the lookahead.
+	BatchLookahead *int32 `json:"batchLookahead,omitempty"`
+
+	// ScoringStrategy selects how nodes are scored against the flavour's distribution.
+	// Defaults to "Spread" if not specified. The batch lookahead only applies to "Spread".
+	ScoringStrategy FlavourScoringStrategy `json:"scoringStrategy,omitempty"`
+
+	// RecentPlacementWindowSeconds is the age under which a pod counts as recently placed.
+	// Defaults to 0, which disables age-weighted counting.
+	RecentPlacementWindowSeconds *int64 `json:"recentPlacementWindowSeconds,omitempty"`
+
+	// RecentPlacementWeightPercent is the weight of a recently placed pod in the per-node counts,
+	// relative to 100 for the pods placed before the window. Weighing recent placements more damps
+	// oscillations when pods are moved around after large topology changes.
+	// Defaults to 150.
+	RecentPlacementWeightPercent *int32 `json:"recentPlacementWeightPercent,omitempty"`
+
+	// FairnessShares maps a flavour to its share of the pods admitted on a node group. When a flavour
+	// was admitted more than its share within the fairness window, its score on the nodes of the group
+	// is reduced in proportion, so that competing flavours are not starved of the last slots.
+	// Flavours without a share are not biased. Empty disables the fairness arbiter.
+	FairnessShares map[string]int32 `json:"fairnessShares,omitempty"`
+
+	// FairnessWindowSeconds is how long admissions are accounted for by the fairness arbiter.
+	// Defaults to 600.
+	FairnessWindowSeconds *int64 `json:"fairnessWindowSeconds,omitempty"`
+
+	// NodeGroupLabel is the node label key grouping the nodes the fairness arbiter accounts
+	// admissions for. Defaults to "topology.kubernetes.io/zone" if not specified.
+	NodeGroupLabel *string `json:"nodeGroupLabel,omitempty"`
+
+	// AnnotateNodeClass makes the plugin annotate every bound flavoured pod with the capacity class
+	// of its node, so that recommenders such as the Vertical Pod Autoscaler can segment their
+	// recommendations per flavour and node class. Defaults to false.
+	AnnotateNodeClass *bool `json:"annotateNodeClass,omitempty"`
+
+	// OverheadBudgetMilliseconds is the budget for the time the plugin spends in a scheduling cycle
+	// (PreScore, Score on every node and NormalizeScore). A warning is logged when the 99th percentile
+	// over the recent cycles exceeds it, and again when it recovers.
+	// Defaults to 0, which disables the instrumentation.
+	OverheadBudgetMilliseconds *int64 `json:"overheadBudgetMilliseconds,omitempty"`
+
+	// ShadowMode makes the plugin compute and log its scores while returning the same neutral score for
+	// every node, to evaluate it on a cluster before giving it influence over placements.
+	// Defaults to false.
+	ShadowMode *bool `json:"shadowMode,omitempty"`
+
+	// ComparisonStrategy is a second scoring strategy computed alongside ScoringStrategy without influencing
+	// the scores. How often it would pick a different node, and the resulting skew, are exported as metrics.
+	// Defaults to "", which disables the comparison.
+	ComparisonStrategy FlavourScoringStrategy `json:"comparisonStrategy,omitempty"`
+
+	// CloudEventsSink is the HTTP endpoint to which bind decisions and fairness share violations are
+	// published as CloudEvents.
+	// Defaults to "", which disables publishing.
+	CloudEventsSink *string `json:"cloudEventsSink,omitempty"`
+
+	// InPlaceRebuildThreshold is the estimated number of node and flavour entries of the cache above
+	// which it is rebuilt by reconciling the current cache in place, instead of building a new one
+	// next to it, to bound the peak memory of the rebuild. 0 always reconciles in place.
+	// Defaults to 50000.
+	InPlaceRebuildThreshold *int32 `json:"inPlaceRebuildThreshold,omitempty"`
+
+	// NodeReadinessSelector is a label selector that worker nodes must match to be part of the flavour
+	// distribution, such as a label set once GPU drivers are installed. Nodes are gated out until then.
+	// Defaults to "", which selects every node.
+	NodeReadinessSelector *string `json:"nodeReadinessSelector,omitempty"`
+
+	// NodeReadinessConditions are node condition types that must be True for worker nodes to be part of
+	// the flavour distribution, in addition to the selector.
+	NodeReadinessConditions []string `json:"nodeReadinessConditions,omitempty"`
+
+	// Weights weighs the node balance, zone balance and tie-breaker terms of the balance score, on top
+	// of the weight of the plugin in the scheduler profile.
+	// Defaults to a node balance weight of 1 and no zone balance or tie-breaker.
+	Weights *FlavourScoreWeights `json:"weights,omitempty"`
+
+	// LogCacheContents logs the full cache on every update instead of a summary of the flavour totals
+	// and the most loaded nodes. The full cache grows with the number of nodes and flavours.
+	// Defaults to false.
+	LogCacheContents *bool `json:"logCacheContents,omitempty"`
+
+	// ScaleDownWindowSeconds is how long pods of the flavours hosted on a node being drained, cordoned or
+	// tainted by cluster-autoscaler for deletion, are spread strictly when they reschedule.
+	// Defaults to 0, which disables the scale-down coordination.
+	ScaleDownWindowSeconds *int64 `json:"scaleDownWindowSeconds,omitempty"`
+
+	// DecisionSamplePercent is the percentage of bind decisions recorded by the CloudEvents and node class
+	// annotation subsystems, sampled by pod UID. Anomalous decisions, binds to a node that was not among the
+	// least loaded for the flavour and fairness violations, are always recorded. 0 records only them.
+	// Defaults to 100.
+	DecisionSamplePercent *int32 `json:"decisionSamplePercent,omitempty"`
+
+	// IgnoreOtherSchedulers leaves the flavoured pods of other schedulers, whose spec.schedulerName differs
+	// from the scheduler profile of the plugin, out of the node totals.
+	// Defaults to false, which counts the pods of every scheduler.
+	IgnoreOtherSchedulers *bool `json:"ignoreOtherSchedulers,omitempty"`
+
+	// ForecastHorizonSeconds is the horizon over which the arrivals of every flavour are forecast, by an
+	// exponentially weighted moving average of the binds unless the scheduler build provides its own
+	// forecaster. The forecast arrivals are planned together with the pod, as with the batch lookahead.
+	// Defaults to 0, which disables forecasting.
+	ForecastHorizonSeconds *int64 `json:"forecastHorizonSeconds,omitempty"`
+
+	// VerifyInformerCache builds a second snapshot from the scheduler's informers on every cache rebuild
+	// and logs and counts its discrepancies with the cache polled from the API server.
+	// Defaults to false.
+	VerifyInformerCache *bool `json:"verifyInformerCache,omitempty"`
+
+	// UnknownNodeScoring selects how the nodes missing from the cache, such as nodes added since the
+	// last rebuild or not matching the worker selector, are scored.
+	// Defaults to "Empty", which scores them as nodes without pods.
+	UnknownNodeScoring FlavourUnknownNodeScoring `json:"unknownNodeScoring,omitempty"`
+
+	// InformerCache builds the cache from the scheduler's shared informers instead of listing nodes and
+	// pods from the API server on every refresh, and keeps its counts current with the informer events.
+	// Defaults to false.
+	InformerCache *bool `json:"informerCache,omitempty"`
+
+	// CacheTTLSeconds is how long the cache is scored with before it is refreshed. Shorter TTLs keep
+	// the cache fresher at the cost of more API calls. Defaults to 60; 0 also selects the default.
+	CacheTTLSeconds *int64 `json:"cacheTTLSeconds,omitempty"`
+
+	// RemoteCache shares the listings the cache is rebuilt from between the replicas of the scheduler
+	// through Redis, for large deployments of many replicas. A listing served from the remote cache is up
+	// to its TTL old. It cannot be set with InformerCache, whose listings never reach the API server.
+	// Defaults to none, which lists the API server on every rebuild.
+	RemoteCache *FlavourRemoteCache `json:"remoteCache,omitempty"`
+
+	// AdminAddress is the address on which the gRPC admin service of the plugin listens, such as
+	// "127.0.0.1:10270". It refreshes the cache, pauses the scoring of flavours, caps them temporarily
+	// and dumps the cache without restarting the scheduler. The bearer token of the calls would travel in
+	// clear text without TLS, so the address must be a loopback address unless adminTLSCertFile and
+	// adminTLSKeyFile are set. The instances of the plugin with the same address share the service, which
+	// routes every call to the instance it selects.
+	// Defaults to "", which disables the admin service.
+	AdminAddress *string `json:"adminAddress,omitempty"`
+
+	// AdminTokenFile is the file holding the bearer token the calls to the admin service must present.
+	// Required with adminAddress.
+	AdminTokenFile *string `json:"adminTokenFile,omitempty"`
+
+	// AdminTLSCertFile and AdminTLSKeyFile are the files holding the PEM certificate and private key the
+	// admin service is served with over TLS, such as a mounted kubernetes.io/tls Secret. They are set
+	// together. Default to "", which serves the admin service in plain text, on a loopback address only.
+	AdminTLSCertFile *string `json:"adminTLSCertFile,omitempty"`
+	AdminTLSKeyFile  *string `json:"adminTLSKeyFile,omitempty"`
+
+	// ExcludedPodPhases are the phases of the flavoured pods left out of the node totals, in addition to
+	// the terminating pods, such as the pods of completed Jobs. An empty list counts the pods of every phase.
+	// Defaults to Succeeded and Failed.
+	ExcludedPodPhases []v1.PodPhase `json:"excludedPodPhases,omitempty"`
+
+	// PostBindQueueSize is the number of cache updates of bound pods queued for a background worker, so
+	// that PostBind does not wait on the cache lock while the cache is rebuilt.
+	// Defaults to 0, which updates the cache synchronously in PostBind.
+	PostBindQueueSize *int32 `json:"postBindQueueSize,omitempty"`
+
+	// PostBindOverflowPolicy selects what PostBind does when the PostBind queue is full.
+	// Defaults to "DropAndReconcile".
+	PostBindOverflowPolicy FlavourPostBindOverflowPolicy `json:"postBindOverflowPolicy,omitempty"`
+
+	// MaxPodsPerFlavourPerNode is the number of pods of a flavour a node can host. The Filter extension
+	// rejects the nodes already hosting that many pods of the flavour of the pod being scheduled.
+	// Defaults to 0, which does not limit the pods per node.
+	MaxPodsPerFlavourPerNode *int32 `json:"maxPodsPerFlavourPerNode,omitempty"`
+
+	// Preset expands into the scoring strategy, weights and related arguments of a tested archetype.
+	// The arguments set explicitly take precedence over those of the preset.
+	// Defaults to "", which applies the default of every argument.
+	Preset FlavourPreset `json:"preset,omitempty"`
+
+	// TopologyKey is the node label key of the topology domains every flavour is balanced across, such as
+	// topology.kubernetes.io/zone, rather than across the nodes. Pods override it with the
+	// scheduling.x-k8s.io/flavour-topology-key annotation.
+	// Defaults to "", which balances the flavours across the nodes.
+	TopologyKey *string `json:"topologyKey,omitempty"`
+
+	// LabelKeys are further label keys the pods are classified by, such as tier. The node score is the
+	// average of the balance score of the flavour and of the spread scores of the label keys of the pod,
+	// weighted by their weights. An entry for LabelName sets the weight of the flavour, which is 1 otherwise.
+	// Defaults to none, which scores the flavour alone.
+	LabelKeys []FlavourLabelKey `json:"labelKeys,omitempty"`
+
+	// SnapshotGossipConfigMap is the namespace/name of the ConfigMap through which the replica running the
+	// scheduling cycles publishes the changes of its cache, and the standby replicas replace theirs with it,
+	// so that a replica taking over after a leader election scores with a current cache right away.
+	// Defaults to "", which disables the gossip.
+	SnapshotGossipConfigMap *string `json:"snapshotGossipConfigMap,omitempty"`
+
+	// NodeLabelSelector is the label selector of the nodes the flavours are balanced across. An empty
+	// selector selects every node, for clusters whose nodes carry no worker role label. Cordoned nodes
+	// are left out whatever the selector.
+	// Defaults to "node-role.kubernetes.io/worker".
+	NodeLabelSelector *string `json:"nodeLabelSelector,omitempty"`
+
+	// ScoreCombiner merges the node balance, zone balance and tie-breaker terms weighed by Weights into
+	// the balance score: WeightedSum, Lexicographic or MaxMin.
+	// Defaults to "WeightedSum".
+	ScoreCombiner FlavourScoreCombiner `json:"scoreCombiner,omitempty"`
+
+	// Namespaces restricts the pods that are counted in the cache and scored to the namespaces it
+	// selects, for instance to balance the flavours of tenants without the pods of system namespaces.
+	// The pods of other namespaces are treated as pods without the flavour label.
+	// Defaults to every namespace.
+	Namespaces FlavourNamespaces `json:"namespaces,omitempty"`
+
+	// TopologyTiers balances every flavour across tiers of node groups, from the widest to the narrowest,
+	// such as regions and then zones, and then across the nodes, each tier with its own tolerance. A node
+	// whose group is beyond the tolerance of a tier always scores below the nodes whose group is within
+	// it, whatever the tiers below, so that an imbalance across regions is fixed before one across zones.
+	// A last kubernetes.io/hostname tier sets the tolerance of the nodes. The weights are not used with
+	// tiers, and pods with a topology key are balanced across the groups of that key alone.
+	// Defaults to none, which balances the flavours with the weights.
+	TopologyTiers []FlavourTopologyTier `json:"topologyTiers,omitempty"`
+
+	// TargetRatios maps a flavour to its target proportion of the pods of every node, such as gold: 1,
+	// silver: 2 and bronze: 4. The pods of a listed flavour are scored by how close the mix of the listed
+	// flavours on the node would be to the target proportions once the pod is placed there, rather than by
+	// the balance of their flavour. Flavours without a ratio are balanced as usual.
+	// Defaults to none, which balances every flavour.
+	TargetRatios map[string]int32 `json:"targetRatios,omitempty"`
+
+	// AnnotatePlacementQuality makes the plugin annotate every bound flavoured pod with the quality of its
+	// placement, the score of its node relative to the best node of the cycle, from 0 to 100, which is
+	// also recorded in the placement quality histogram. Defaults to false.
+	AnnotatePlacementQuality *bool `json:"annotatePlacementQuality,omitempty"`
+
+	// CountMode is what the cache counts per node and flavour: Pods, or the CPURequests, MemoryRequests
+	// or ResourceWeighted requests of the pods, so that the flavours are balanced by the capacity their
+	// pods take rather than by their number. Every pod counts at least 1, and the tolerances of
+	// TopologyTiers and the caps of the admin service are in the units of the mode, while
+	// MaxPodsPerFlavourPerNode still counts pods. It cannot be combined with recentPlacementWindowSeconds.
+	// Defaults to "Pods".
+	CountMode FlavourCountMode `json:"countMode,omitempty"`
+
+	// MaxPodsPerTopologyDomain maps a flavour to the number of its pods a topology domain of
+	// CapTopologyKey, such as a zone, can host, in the units of CountMode. The Filter extension rejects
+	// the nodes of the domains already hosting that many pods of the flavour of the pod being scheduled,
+	// as counted in the cache, which limits the blast radius of the loss of a domain for critical flavours.
+	// Defaults to none, which does not limit the pods per domain.
+	MaxPodsPerTopologyDomain map[string]int32 `json:"maxPodsPerTopologyDomain,omitempty"`
+
+	// CapTopologyKey is the node label key whose values are the topology domains of
+	// MaxPodsPerTopologyDomain. Nodes without the label form one domain.
+	// Defaults to "topology.kubernetes.io/zone".
+	CapTopologyKey *string `json:"capTopologyKey,omitempty"`
+
+	// MetricsFlavours are the flavours reported under their own name in the flavour label of the metrics
+	// of the plugin. The pods of other flavours are reported under "other".
+	// Defaults to none, which reports the first MetricsLabelCap flavours seen under their own name.
+	MetricsFlavours []string `json:"metricsFlavours,omitempty"`
+
+	// MetricsLabelCap bounds the number of values of the node label of the metrics of the plugin, the
+	// nodes hosting the most flavoured pods, and of the flavour label without MetricsFlavours. The other
+	// nodes and flavours are aggregated under "other", so that dynamic flavours or a large cluster cannot
+	// explode the number of series. 0 aggregates every node, and every flavour without MetricsFlavours.
+	// Defaults to 20.
+	MetricsLabelCap *int32 `json:"metricsLabelCap,omitempty"`
+
+	// SidecarContainers are glob patterns, as in filepath.Match, of the names of the containers and init
+	// containers left out of the requests counted by the CPURequests, MemoryRequests and ResourceWeighted
+	// count modes, such as "istio-proxy" or "*-sidecar", so that the service mesh and logging sidecars,
+	// which request about the same for every pod, do not hide the load of the applications. A pod whose
+	// containers are all sidecars still counts 1. It cannot be set with the Pods count mode.
+	// Defaults to none.
+	SidecarContainers []string `json:"sidecarContainers,omitempty"`
+
+	// RecordScoringEvents makes the plugin record a FlavourScored Event on every bound flavoured pod,
+	// explaining the flavour balance behind the choice of its node, such as the node hosting the fewest
+	// pods of the flavour, for the teams that cannot read the scheduler logs. The events are sampled by
+	// DecisionSamplePercent. Defaults to false.
+	RecordScoringEvents *bool `json:"recordScoringEvents,omitempty"`
+
+	// NodeUpdateAnnotations are the annotations node update orchestrators set on the nodes they are
+	// updating, by key, with the value marking an update in progress, or "" for any value, such as
+	// "weave.works/kured-reboot-in-progress": "" for kured or "machineconfiguration.openshift.io/state":
+	// "Working" for the OpenShift Machine Config Operator. Updating nodes are left out of the flavour
+	// distribution like the nodes failing the readiness gate: they never win the flavour score and do not
+	// count towards the minimum, and the pods waiting for them are queued when the update completes.
+	// Defaults to none.
+	NodeUpdateAnnotations map[string]string `json:"nodeUpdateAnnotations,omitempty"`
+
+	// NodeUpdateConditions are node condition types that are True while the node is being updated, in
+	// addition to NodeUpdateAnnotations.
+	NodeUpdateConditions []string `json:"nodeUpdateConditions,omitempty"`
+
+	// AuditStore is the external store every bind decision of a flavoured pod is written to, such as
+	// Loki or PostgreSQL, for the organizations that keep the decisions for longer than the scheduler can.
+	// The records are written in the background, in batches, whatever DecisionSamplePercent.
+	// Defaults to none.
+	AuditStore *FlavourAuditStore `json:"auditStore,omitempty"`
+
+	// EnforceFlavourQuotas makes the plugin enforce the FlavourQuota objects of the cluster in its Permit
+	// extension point: a pod that would take its flavour above the pods or resources of one of the quotas
+	// of the flavour waits until the pods of the flavour leave or the quota is raised, for up to
+	// QuotaWaitSeconds, and is then rejected. It requires the FlavourQuota CRD, and the Permit extension
+	// point of the plugin to be enabled. Defaults to false.
+	EnforceFlavourQuotas *bool `json:"enforceFlavourQuotas,omitempty"`
+
+	// QuotaWaitSeconds is how long a pod exceeding the quota of its flavour waits in the Permit extension
+	// point before it is rejected and retried, up to 900, the longest the scheduler lets a pod wait. 0
+	// rejects the pod right away. Defaults to 60.
+	QuotaWaitSeconds *int32 `json:"quotaWaitSeconds,omitempty"`
+
+	// FlavourPolicy is the namespace/name of the FlavourPolicy object the plugin watches to retune the
+	// flavour balancing at runtime, without a restart of the scheduler: while the object exists, its target
+	// ratios, caps and topology keys replace the ones of these args. It requires the FlavourPolicy CRD.
+	// Defaults to "", which tunes the plugin with these args alone.
+	FlavourPolicy *string `json:"flavourPolicy,omitempty"`
+
+	// FlavourPriorities orders the flavours from the most to the least important, such as gold, silver
+	// and bronze, for the PostFilter extension point of the plugin: a pod that fits no node preempts pods
+	// of the flavours listed after its own, on the node where the fewest and least important of them make
+	// room for it. The pods of the flavours not listed neither preempt nor are preempted, and pods of a
+	// higher priority than the preemptor are never preempted. It requires the PostFilter extension point
+	// of the plugin to be enabled. Defaults to none, which disables the preemption.
+	FlavourPriorities []string `json:"flavourPriorities,omitempty"`
 }
@@ -50,6 +50,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FlavourAuditStore)(nil), (*config.FlavourAuditStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FlavourAuditStore_To_config_FlavourAuditStore(a.(*FlavourAuditStore), b.(*config.FlavourAuditStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FlavourAuditStore)(nil), (*FlavourAuditStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FlavourAuditStore_To_v1_FlavourAuditStore(a.(*config.FlavourAuditStore), b.(*FlavourAuditStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlavourLabelKey)(nil), (*config.FlavourLabelKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FlavourLabelKey_To_config_FlavourLabelKey(a.(*FlavourLabelKey), b.(*config.FlavourLabelKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FlavourLabelKey)(nil), (*FlavourLabelKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FlavourLabelKey_To_v1_FlavourLabelKey(a.(*config.FlavourLabelKey), b.(*FlavourLabelKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlavourNamespaces)(nil), (*config.FlavourNamespaces)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FlavourNamespaces_To_config_FlavourNamespaces(a.(*FlavourNamespaces), b.(*config.FlavourNamespaces), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FlavourNamespaces)(nil), (*FlavourNamespaces)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FlavourNamespaces_To_v1_FlavourNamespaces(a.(*config.FlavourNamespaces), b.(*FlavourNamespaces), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlavourRemoteCache)(nil), (*config.FlavourRemoteCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FlavourRemoteCache_To_config_FlavourRemoteCache(a.(*FlavourRemoteCache), b.(*config.FlavourRemoteCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FlavourRemoteCache)(nil), (*FlavourRemoteCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FlavourRemoteCache_To_v1_FlavourRemoteCache(a.(*config.FlavourRemoteCache), b.(*FlavourRemoteCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlavourScoreWeights)(nil), (*config.FlavourScoreWeights)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FlavourScoreWeights_To_config_FlavourScoreWeights(a.(*FlavourScoreWeights), b.(*config.FlavourScoreWeights), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FlavourScoreWeights)(nil), (*FlavourScoreWeights)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FlavourScoreWeights_To_v1_FlavourScoreWeights(a.(*config.FlavourScoreWeights), b.(*FlavourScoreWeights), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlavourTopologyTier)(nil), (*config.FlavourTopologyTier)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FlavourTopologyTier_To_config_FlavourTopologyTier(a.(*FlavourTopologyTier), b.(*config.FlavourTopologyTier), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FlavourTopologyTier)(nil), (*FlavourTopologyTier)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FlavourTopologyTier_To_v1_FlavourTopologyTier(a.(*config.FlavourTopologyTier), b.(*FlavourTopologyTier), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadVariationRiskBalancingArgs)(nil), (*config.LoadVariationRiskBalancingArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_LoadVariationRiskBalancingArgs_To_config_LoadVariationRiskBalancingArgs(a.(*LoadVariationRiskBalancingArgs), b.(*config.LoadVariationRiskBalancingArgs), scope)
 	}); err != nil {
@@ -190,23 +250,23 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*config.NodeResourceTopologyMatchArgs)(nil), (*NodeResourceTopologyMatchArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_config_NodeResourceTopologyMatchArgs_To_v1_NodeResourceTopologyMatchArgs(a.(*config.NodeResourceTopologyMatchArgs), b.(*NodeResourceTopologyMatchArgs), scope)
+	if err := s.AddConversionFunc((*config.FlavourClusterWideArgs)(nil), (*FlavourClusterWideArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FlavourClusterWideArgs_To_v1_FlavourClusterWideArgs(a.(*config.FlavourClusterWideArgs), b.(*FlavourClusterWideArgs), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*NodeResourceTopologyMatchArgs)(nil), (*config.NodeResourceTopologyMatchArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_NodeResourceTopologyMatchArgs_To_config_NodeResourceTopologyMatchArgs(a.(*NodeResourceTopologyMatchArgs), b.(*config.NodeResourceTopologyMatchArgs), scope)
+	if err := s.AddConversionFunc((*config.NodeResourceTopologyMatchArgs)(nil), (*NodeResourceTopologyMatchArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NodeResourceTopologyMatchArgs_To_v1_NodeResourceTopologyMatchArgs(a.(*config.NodeResourceTopologyMatchArgs), b.(*NodeResourceTopologyMatchArgs), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*FlavourClusterWideArgs)(nil), (*config.FlavourClusterWideArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+	if err := s.AddConversionFunc((*FlavourClusterWideArgs)(nil), (*config.FlavourClusterWideArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_FlavourClusterWideArgs_To_config_FlavourClusterWideArgs(a.(*FlavourClusterWideArgs), b.(*config.FlavourClusterWideArgs), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*config.FlavourClusterWideArgs)(nil), (*FlavourClusterWideArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_config_FlavourClusterWideArgs_To_v1_FlavourClusterWideArgs(a.(*config.FlavourClusterWideArgs), b.(*FlavourClusterWideArgs), scope)
+	if err := s.AddConversionFunc((*NodeResourceTopologyMatchArgs)(nil), (*config.NodeResourceTopologyMatchArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NodeResourceTopologyMatchArgs_To_config_NodeResourceTopologyMatchArgs(a.(*NodeResourceTopologyMatchArgs), b.(*config.NodeResourceTopologyMatchArgs), scope)
 	}); err != nil {
 		return err
 	}
@@ -243,6 +303,496 @@ func Convert_config_CoschedulingArgs_To_v1_CoschedulingArgs(in *config.Coschedul
 	return autoConvert_config_CoschedulingArgs_To_v1_CoschedulingArgs(in, out, s)
 }
 
+func autoConvert_v1_FlavourAuditStore_To_config_FlavourAuditStore(in *FlavourAuditStore, out *config.FlavourAuditStore, s conversion.Scope) error {
+	out.Type = config.FlavourAuditStoreType(in.Type)
+	out.URL = in.URL
+	out.TenantID = in.TenantID
+	out.ConnectionStringFile = in.ConnectionStringFile
+	if err := metav1.Convert_Pointer_string_To_string(&in.Driver, &out.Driver, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.Table, &out.Table, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_FlavourAuditStore_To_config_FlavourAuditStore is an autogenerated conversion function.
+func Convert_v1_FlavourAuditStore_To_config_FlavourAuditStore(in *FlavourAuditStore, out *config.FlavourAuditStore, s conversion.Scope) error {
+	return autoConvert_v1_FlavourAuditStore_To_config_FlavourAuditStore(in, out, s)
+}
+
+func autoConvert_config_FlavourAuditStore_To_v1_FlavourAuditStore(in *config.FlavourAuditStore, out *FlavourAuditStore, s conversion.Scope) error {
+	out.Type = FlavourAuditStoreType(in.Type)
+	out.URL = in.URL
+	out.TenantID = in.TenantID
+	out.ConnectionStringFile = in.ConnectionStringFile
+	if err := metav1.Convert_string_To_Pointer_string(&in.Driver, &out.Driver, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.Table, &out.Table, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_FlavourAuditStore_To_v1_FlavourAuditStore is an autogenerated conversion function.
+func Convert_config_FlavourAuditStore_To_v1_FlavourAuditStore(in *config.FlavourAuditStore, out *FlavourAuditStore, s conversion.Scope) error {
+	return autoConvert_config_FlavourAuditStore_To_v1_FlavourAuditStore(in, out, s)
+}
+
+func autoConvert_v1_FlavourClusterWideArgs_To_config_FlavourClusterWideArgs(in *FlavourClusterWideArgs, out *config.FlavourClusterWideArgs, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_string_To_string(&in.LabelName, &out.LabelName, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.NodeLifecycleLabel, &out.NodeLifecycleLabel, s); err != nil {
+		return err
+	}
+	out.LifecyclePreferences = *(*map[string][]string)(unsafe.Pointer(&in.LifecyclePreferences))
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.BatchLookahead, &out.BatchLookahead, s); err != nil {
+		return err
+	}
+	out.ScoringStrategy = config.FlavourScoringStrategy(in.ScoringStrategy)
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.RecentPlacementWindowSeconds, &out.RecentPlacementWindowSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.RecentPlacementWeightPercent, &out.RecentPlacementWeightPercent, s); err != nil {
+		return err
+	}
+	out.FairnessShares = *(*map[string]int32)(unsafe.Pointer(&in.FairnessShares))
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.FairnessWindowSeconds, &out.FairnessWindowSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.NodeGroupLabel, &out.NodeGroupLabel, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.AnnotateNodeClass, &out.AnnotateNodeClass, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.OverheadBudgetMilliseconds, &out.OverheadBudgetMilliseconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.ShadowMode, &out.ShadowMode, s); err != nil {
+		return err
+	}
+	out.ComparisonStrategy = config.FlavourScoringStrategy(in.ComparisonStrategy)
+	if err := metav1.Convert_Pointer_string_To_string(&in.CloudEventsSink, &out.CloudEventsSink, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.InPlaceRebuildThreshold, &out.InPlaceRebuildThreshold, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.NodeReadinessSelector, &out.NodeReadinessSelector, s); err != nil {
+		return err
+	}
+	out.NodeReadinessConditions = *(*[]string)(unsafe.Pointer(&in.NodeReadinessConditions))
+	// WARNING: in.Weights requires manual conversion: inconvertible types (*sigs.k8s.io/scheduler-plugins/apis/config/v1.FlavourScoreWeights vs sigs.k8s.io/scheduler-plugins/apis/config.FlavourScoreWeights)
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.LogCacheContents, &out.LogCacheContents, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.ScaleDownWindowSeconds, &out.ScaleDownWindowSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.DecisionSamplePercent, &out.DecisionSamplePercent, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.IgnoreOtherSchedulers, &out.IgnoreOtherSchedulers, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.ForecastHorizonSeconds, &out.ForecastHorizonSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.VerifyInformerCache, &out.VerifyInformerCache, s); err != nil {
+		return err
+	}
+	out.UnknownNodeScoring = config.FlavourUnknownNodeScoring(in.UnknownNodeScoring)
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.InformerCache, &out.InformerCache, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.CacheTTLSeconds, &out.CacheTTLSeconds, s); err != nil {
+		return err
+	}
+	if in.RemoteCache != nil {
+		in, out := &in.RemoteCache, &out.RemoteCache
+		*out = new(config.FlavourRemoteCache)
+		if err := Convert_v1_FlavourRemoteCache_To_config_FlavourRemoteCache(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RemoteCache = nil
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.AdminAddress, &out.AdminAddress, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.AdminTokenFile, &out.AdminTokenFile, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.AdminTLSCertFile, &out.AdminTLSCertFile, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.AdminTLSKeyFile, &out.AdminTLSKeyFile, s); err != nil {
+		return err
+	}
+	out.ExcludedPodPhases = *(*[]corev1.PodPhase)(unsafe.Pointer(&in.ExcludedPodPhases))
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.PostBindQueueSize, &out.PostBindQueueSize, s); err != nil {
+		return err
+	}
+	out.PostBindOverflowPolicy = config.FlavourPostBindOverflowPolicy(in.PostBindOverflowPolicy)
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.MaxPodsPerFlavourPerNode, &out.MaxPodsPerFlavourPerNode, s); err != nil {
+		return err
+	}
+	out.Preset = config.FlavourPreset(in.Preset)
+	if err := metav1.Convert_Pointer_string_To_string(&in.TopologyKey, &out.TopologyKey, s); err != nil {
+		return err
+	}
+	out.LabelKeys = *(*[]config.FlavourLabelKey)(unsafe.Pointer(&in.LabelKeys))
+	if err := metav1.Convert_Pointer_string_To_string(&in.SnapshotGossipConfigMap, &out.SnapshotGossipConfigMap, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.NodeLabelSelector, &out.NodeLabelSelector, s); err != nil {
+		return err
+	}
+	out.ScoreCombiner = config.FlavourScoreCombiner(in.ScoreCombiner)
+	if err := Convert_v1_FlavourNamespaces_To_config_FlavourNamespaces(&in.Namespaces, &out.Namespaces, s); err != nil {
+		return err
+	}
+	out.TopologyTiers = *(*[]config.FlavourTopologyTier)(unsafe.Pointer(&in.TopologyTiers))
+	out.TargetRatios = *(*map[string]int32)(unsafe.Pointer(&in.TargetRatios))
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.AnnotatePlacementQuality, &out.AnnotatePlacementQuality, s); err != nil {
+		return err
+	}
+	out.CountMode = config.FlavourCountMode(in.CountMode)
+	out.MaxPodsPerTopologyDomain = *(*map[string]int32)(unsafe.Pointer(&in.MaxPodsPerTopologyDomain))
+	if err := metav1.Convert_Pointer_string_To_string(&in.CapTopologyKey, &out.CapTopologyKey, s); err != nil {
+		return err
+	}
+	out.MetricsFlavours = *(*[]string)(unsafe.Pointer(&in.MetricsFlavours))
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.MetricsLabelCap, &out.MetricsLabelCap, s); err != nil {
+		return err
+	}
+	out.SidecarContainers = *(*[]string)(unsafe.Pointer(&in.SidecarContainers))
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.RecordScoringEvents, &out.RecordScoringEvents, s); err != nil {
+		return err
+	}
+	out.NodeUpdateAnnotations = *(*map[string]string)(unsafe.Pointer(&in.NodeUpdateAnnotations))
+	out.NodeUpdateConditions = *(*[]string)(unsafe.Pointer(&in.NodeUpdateConditions))
+	if in.AuditStore != nil {
+		in, out := &in.AuditStore, &out.AuditStore
+		*out = new(config.FlavourAuditStore)
+		if err := Convert_v1_FlavourAuditStore_To_config_FlavourAuditStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuditStore = nil
+	}
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.EnforceFlavourQuotas, &out.EnforceFlavourQuotas, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.QuotaWaitSeconds, &out.QuotaWaitSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.FlavourPolicy, &out.FlavourPolicy, s); err != nil {
+		return err
+	}
+	out.FlavourPriorities = *(*[]string)(unsafe.Pointer(&in.FlavourPriorities))
+	return nil
+}
+
+func autoConvert_config_FlavourClusterWideArgs_To_v1_FlavourClusterWideArgs(in *config.FlavourClusterWideArgs, out *FlavourClusterWideArgs, s conversion.Scope) error {
+	if err := metav1.Convert_string_To_Pointer_string(&in.LabelName, &out.LabelName, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.NodeLifecycleLabel, &out.NodeLifecycleLabel, s); err != nil {
+		return err
+	}
+	out.LifecyclePreferences = *(*map[string][]string)(unsafe.Pointer(&in.LifecyclePreferences))
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.BatchLookahead, &out.BatchLookahead, s); err != nil {
+		return err
+	}
+	out.ScoringStrategy = FlavourScoringStrategy(in.ScoringStrategy)
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.RecentPlacementWindowSeconds, &out.RecentPlacementWindowSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.RecentPlacementWeightPercent, &out.RecentPlacementWeightPercent, s); err != nil {
+		return err
+	}
+	out.FairnessShares = *(*map[string]int32)(unsafe.Pointer(&in.FairnessShares))
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.FairnessWindowSeconds, &out.FairnessWindowSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.NodeGroupLabel, &out.NodeGroupLabel, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.AnnotateNodeClass, &out.AnnotateNodeClass, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.OverheadBudgetMilliseconds, &out.OverheadBudgetMilliseconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.ShadowMode, &out.ShadowMode, s); err != nil {
+		return err
+	}
+	out.ComparisonStrategy = FlavourScoringStrategy(in.ComparisonStrategy)
+	if err := metav1.Convert_string_To_Pointer_string(&in.CloudEventsSink, &out.CloudEventsSink, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.InPlaceRebuildThreshold, &out.InPlaceRebuildThreshold, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.NodeReadinessSelector, &out.NodeReadinessSelector, s); err != nil {
+		return err
+	}
+	out.NodeReadinessConditions = *(*[]string)(unsafe.Pointer(&in.NodeReadinessConditions))
+	// WARNING: in.Weights requires manual conversion: inconvertible types (sigs.k8s.io/scheduler-plugins/apis/config.FlavourScoreWeights vs *sigs.k8s.io/scheduler-plugins/apis/config/v1.FlavourScoreWeights)
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.LogCacheContents, &out.LogCacheContents, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.ScaleDownWindowSeconds, &out.ScaleDownWindowSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.DecisionSamplePercent, &out.DecisionSamplePercent, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.IgnoreOtherSchedulers, &out.IgnoreOtherSchedulers, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.ForecastHorizonSeconds, &out.ForecastHorizonSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.VerifyInformerCache, &out.VerifyInformerCache, s); err != nil {
+		return err
+	}
+	out.UnknownNodeScoring = FlavourUnknownNodeScoring(in.UnknownNodeScoring)
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.InformerCache, &out.InformerCache, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.CacheTTLSeconds, &out.CacheTTLSeconds, s); err != nil {
+		return err
+	}
+	if in.RemoteCache != nil {
+		in, out := &in.RemoteCache, &out.RemoteCache
+		*out = new(FlavourRemoteCache)
+		if err := Convert_config_FlavourRemoteCache_To_v1_FlavourRemoteCache(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RemoteCache = nil
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.AdminAddress, &out.AdminAddress, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.AdminTokenFile, &out.AdminTokenFile, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.AdminTLSCertFile, &out.AdminTLSCertFile, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.AdminTLSKeyFile, &out.AdminTLSKeyFile, s); err != nil {
+		return err
+	}
+	out.ExcludedPodPhases = *(*[]corev1.PodPhase)(unsafe.Pointer(&in.ExcludedPodPhases))
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.PostBindQueueSize, &out.PostBindQueueSize, s); err != nil {
+		return err
+	}
+	out.PostBindOverflowPolicy = FlavourPostBindOverflowPolicy(in.PostBindOverflowPolicy)
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.MaxPodsPerFlavourPerNode, &out.MaxPodsPerFlavourPerNode, s); err != nil {
+		return err
+	}
+	out.Preset = FlavourPreset(in.Preset)
+	if err := metav1.Convert_string_To_Pointer_string(&in.TopologyKey, &out.TopologyKey, s); err != nil {
+		return err
+	}
+	out.LabelKeys = *(*[]FlavourLabelKey)(unsafe.Pointer(&in.LabelKeys))
+	if err := metav1.Convert_string_To_Pointer_string(&in.SnapshotGossipConfigMap, &out.SnapshotGossipConfigMap, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.NodeLabelSelector, &out.NodeLabelSelector, s); err != nil {
+		return err
+	}
+	out.ScoreCombiner = FlavourScoreCombiner(in.ScoreCombiner)
+	if err := Convert_config_FlavourNamespaces_To_v1_FlavourNamespaces(&in.Namespaces, &out.Namespaces, s); err != nil {
+		return err
+	}
+	out.TopologyTiers = *(*[]FlavourTopologyTier)(unsafe.Pointer(&in.TopologyTiers))
+	out.TargetRatios = *(*map[string]int32)(unsafe.Pointer(&in.TargetRatios))
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.AnnotatePlacementQuality, &out.AnnotatePlacementQuality, s); err != nil {
+		return err
+	}
+	out.CountMode = FlavourCountMode(in.CountMode)
+	out.MaxPodsPerTopologyDomain = *(*map[string]int32)(unsafe.Pointer(&in.MaxPodsPerTopologyDomain))
+	if err := metav1.Convert_string_To_Pointer_string(&in.CapTopologyKey, &out.CapTopologyKey, s); err != nil {
+		return err
+	}
+	out.MetricsFlavours = *(*[]string)(unsafe.Pointer(&in.MetricsFlavours))
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.MetricsLabelCap, &out.MetricsLabelCap, s); err != nil {
+		return err
+	}
+	out.SidecarContainers = *(*[]string)(unsafe.Pointer(&in.SidecarContainers))
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.RecordScoringEvents, &out.RecordScoringEvents, s); err != nil {
+		return err
+	}
+	out.NodeUpdateAnnotations = *(*map[string]string)(unsafe.Pointer(&in.NodeUpdateAnnotations))
+	out.NodeUpdateConditions = *(*[]string)(unsafe.Pointer(&in.NodeUpdateConditions))
+	if in.AuditStore != nil {
+		in, out := &in.AuditStore, &out.AuditStore
+		*out = new(FlavourAuditStore)
+		if err := Convert_config_FlavourAuditStore_To_v1_FlavourAuditStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuditStore = nil
+	}
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.EnforceFlavourQuotas, &out.EnforceFlavourQuotas, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.QuotaWaitSeconds, &out.QuotaWaitSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.FlavourPolicy, &out.FlavourPolicy, s); err != nil {
+		return err
+	}
+	out.FlavourPriorities = *(*[]string)(unsafe.Pointer(&in.FlavourPriorities))
+	return nil
+}
+
+func autoConvert_v1_FlavourLabelKey_To_config_FlavourLabelKey(in *FlavourLabelKey, out *config.FlavourLabelKey, s conversion.Scope) error {
+	out.LabelKey = in.LabelKey
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1_FlavourLabelKey_To_config_FlavourLabelKey is an autogenerated conversion function.
+func Convert_v1_FlavourLabelKey_To_config_FlavourLabelKey(in *FlavourLabelKey, out *config.FlavourLabelKey, s conversion.Scope) error {
+	return autoConvert_v1_FlavourLabelKey_To_config_FlavourLabelKey(in, out, s)
+}
+
+func autoConvert_config_FlavourLabelKey_To_v1_FlavourLabelKey(in *config.FlavourLabelKey, out *FlavourLabelKey, s conversion.Scope) error {
+	out.LabelKey = in.LabelKey
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_config_FlavourLabelKey_To_v1_FlavourLabelKey is an autogenerated conversion function.
+func Convert_config_FlavourLabelKey_To_v1_FlavourLabelKey(in *config.FlavourLabelKey, out *FlavourLabelKey, s conversion.Scope) error {
+	return autoConvert_config_FlavourLabelKey_To_v1_FlavourLabelKey(in, out, s)
+}
+
+func autoConvert_v1_FlavourNamespaces_To_config_FlavourNamespaces(in *FlavourNamespaces, out *config.FlavourNamespaces, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
+	return nil
+}
+
+// Convert_v1_FlavourNamespaces_To_config_FlavourNamespaces is an autogenerated conversion function.
+func Convert_v1_FlavourNamespaces_To_config_FlavourNamespaces(in *FlavourNamespaces, out *config.FlavourNamespaces, s conversion.Scope) error {
+	return autoConvert_v1_FlavourNamespaces_To_config_FlavourNamespaces(in, out, s)
+}
+
+func autoConvert_config_FlavourNamespaces_To_v1_FlavourNamespaces(in *config.FlavourNamespaces, out *FlavourNamespaces, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
+	return nil
+}
+
+// Convert_config_FlavourNamespaces_To_v1_FlavourNamespaces is an autogenerated conversion function.
+func Convert_config_FlavourNamespaces_To_v1_FlavourNamespaces(in *config.FlavourNamespaces, out *FlavourNamespaces, s conversion.Scope) error {
+	return autoConvert_config_FlavourNamespaces_To_v1_FlavourNamespaces(in, out, s)
+}
+
+func autoConvert_v1_FlavourRemoteCache_To_config_FlavourRemoteCache(in *FlavourRemoteCache, out *config.FlavourRemoteCache, s conversion.Scope) error {
+	out.Type = config.FlavourRemoteCacheType(in.Type)
+	out.Address = in.Address
+	out.PasswordFile = in.PasswordFile
+	if err := metav1.Convert_Pointer_string_To_string(&in.KeyPrefix, &out.KeyPrefix, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.TTLSeconds, &out.TTLSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_FlavourRemoteCache_To_config_FlavourRemoteCache is an autogenerated conversion function.
+func Convert_v1_FlavourRemoteCache_To_config_FlavourRemoteCache(in *FlavourRemoteCache, out *config.FlavourRemoteCache, s conversion.Scope) error {
+	return autoConvert_v1_FlavourRemoteCache_To_config_FlavourRemoteCache(in, out, s)
+}
+
+func autoConvert_config_FlavourRemoteCache_To_v1_FlavourRemoteCache(in *config.FlavourRemoteCache, out *FlavourRemoteCache, s conversion.Scope) error {
+	out.Type = FlavourRemoteCacheType(in.Type)
+	out.Address = in.Address
+	out.PasswordFile = in.PasswordFile
+	if err := metav1.Convert_string_To_Pointer_string(&in.KeyPrefix, &out.KeyPrefix, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.TTLSeconds, &out.TTLSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_FlavourRemoteCache_To_v1_FlavourRemoteCache is an autogenerated conversion function.
+func Convert_config_FlavourRemoteCache_To_v1_FlavourRemoteCache(in *config.FlavourRemoteCache, out *FlavourRemoteCache, s conversion.Scope) error {
+	return autoConvert_config_FlavourRemoteCache_To_v1_FlavourRemoteCache(in, out, s)
+}
+
+func autoConvert_v1_FlavourScoreWeights_To_config_FlavourScoreWeights(in *FlavourScoreWeights, out *config.FlavourScoreWeights, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.NodeBalance, &out.NodeBalance, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.ZoneBalance, &out.ZoneBalance, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int32_To_int32(&in.TieBreaker, &out.TieBreaker, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_FlavourScoreWeights_To_config_FlavourScoreWeights is an autogenerated conversion function.
+func Convert_v1_FlavourScoreWeights_To_config_FlavourScoreWeights(in *FlavourScoreWeights, out *config.FlavourScoreWeights, s conversion.Scope) error {
+	return autoConvert_v1_FlavourScoreWeights_To_config_FlavourScoreWeights(in, out, s)
+}
+
+func autoConvert_config_FlavourScoreWeights_To_v1_FlavourScoreWeights(in *config.FlavourScoreWeights, out *FlavourScoreWeights, s conversion.Scope) error {
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.NodeBalance, &out.NodeBalance, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.ZoneBalance, &out.ZoneBalance, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int32_To_Pointer_int32(&in.TieBreaker, &out.TieBreaker, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_FlavourScoreWeights_To_v1_FlavourScoreWeights is an autogenerated conversion function.
+func Convert_config_FlavourScoreWeights_To_v1_FlavourScoreWeights(in *config.FlavourScoreWeights, out *FlavourScoreWeights, s conversion.Scope) error {
+	return autoConvert_config_FlavourScoreWeights_To_v1_FlavourScoreWeights(in, out, s)
+}
+
+func autoConvert_v1_FlavourTopologyTier_To_config_FlavourTopologyTier(in *FlavourTopologyTier, out *config.FlavourTopologyTier, s conversion.Scope) error {
+	out.TopologyKey = in.TopologyKey
+	out.Tolerance = in.Tolerance
+	return nil
+}
+
+// Convert_v1_FlavourTopologyTier_To_config_FlavourTopologyTier is an autogenerated conversion function.
+func Convert_v1_FlavourTopologyTier_To_config_FlavourTopologyTier(in *FlavourTopologyTier, out *config.FlavourTopologyTier, s conversion.Scope) error {
+	return autoConvert_v1_FlavourTopologyTier_To_config_FlavourTopologyTier(in, out, s)
+}
+
+func autoConvert_config_FlavourTopologyTier_To_v1_FlavourTopologyTier(in *config.FlavourTopologyTier, out *FlavourTopologyTier, s conversion.Scope) error {
+	out.TopologyKey = in.TopologyKey
+	out.Tolerance = in.Tolerance
+	return nil
+}
+
+// Convert_config_FlavourTopologyTier_To_v1_FlavourTopologyTier is an autogenerated conversion function.
+func Convert_config_FlavourTopologyTier_To_v1_FlavourTopologyTier(in *config.FlavourTopologyTier, out *FlavourTopologyTier, s conversion.Scope) error {
+	return autoConvert_config_FlavourTopologyTier_To_v1_FlavourTopologyTier(in, out, s)
+}
+
 func autoConvert_v1_LoadVariationRiskBalancingArgs_To_config_LoadVariationRiskBalancingArgs(in *LoadVariationRiskBalancingArgs, out *config.LoadVariationRiskBalancingArgs, s conversion.Scope) error {
 	if err := Convert_v1_TrimaranSpec_To_config_TrimaranSpec(&in.TrimaranSpec, &out.TrimaranSpec, s); err != nil {
 		return err
@@ -664,29 +1214,3 @@ func autoConvert_config_TrimaranSpec_To_v1_TrimaranSpec(in *config.TrimaranSpec,
 func Convert_config_TrimaranSpec_To_v1_TrimaranSpec(in *config.TrimaranSpec, out *TrimaranSpec, s conversion.Scope) error {
 	return autoConvert_config_TrimaranSpec_To_v1_TrimaranSpec(in, out, s)
 }
-
-func autoConvert_v1_FlavourClusterWideArgs_To_config_FlavourClusterWideArgs(in *FlavourClusterWideArgs, out *config.FlavourClusterWideArgs, s conversion.Scope) error {
-	out.TypeMeta = in.TypeMeta
-	if err := metav1.Convert_Pointer_string_To_string(&in.LabelName, &out.LabelName, s); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Convert_v1_FlavourClusterWideArgs_To_config_FlavourClusterWideArgs is an autogenerated conversion function.
-func Convert_v1_FlavourClusterWideArgs_To_config_FlavourClusterWideArgs(in *FlavourClusterWideArgs, out *config.FlavourClusterWideArgs, s conversion.Scope) error {
-	return autoConvert_v1_FlavourClusterWideArgs_To_config_FlavourClusterWideArgs(in, out, s)
-}
-
-func autoConvert_config_FlavourClusterWideArgs_To_v1_FlavourClusterWideArgs(in *config.FlavourClusterWideArgs, out *FlavourClusterWideArgs, s conversion.Scope) error {
-	out.TypeMeta = in.TypeMeta
-	if err := metav1.Convert_string_To_Pointer_string(&in.LabelName, &out.LabelName, s); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Convert_config_FlavourClusterWideArgs_To_v1_FlavourClusterWideArgs is an autogenerated conversion function.
-func Convert_config_FlavourClusterWideArgs_To_v1_FlavourClusterWideArgs(in *config.FlavourClusterWideArgs, out *FlavourClusterWideArgs, s conversion.Scope) error {
-	return autoConvert_config_FlavourClusterWideArgs_To_v1_FlavourClusterWideArgs(in, out, s)
-}
@@ -62,6 +62,456 @@ func (in *CoschedulingArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourAuditStore) DeepCopyInto(out *FlavourAuditStore) {
+	*out = *in
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(string)
+		**out = **in
+	}
+	if in.Table != nil {
+		in, out := &in.Table, &out.Table
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourAuditStore.
+func (in *FlavourAuditStore) DeepCopy() *FlavourAuditStore {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourAuditStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourClusterWideArgs) DeepCopyInto(out *FlavourClusterWideArgs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.LabelName != nil {
+		in, out := &in.LabelName, &out.LabelName
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeLifecycleLabel != nil {
+		in, out := &in.NodeLifecycleLabel, &out.NodeLifecycleLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.LifecyclePreferences != nil {
+		in, out := &in.LifecyclePreferences, &out.LifecyclePreferences
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.BatchLookahead != nil {
+		in, out := &in.BatchLookahead, &out.BatchLookahead
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RecentPlacementWindowSeconds != nil {
+		in, out := &in.RecentPlacementWindowSeconds, &out.RecentPlacementWindowSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RecentPlacementWeightPercent != nil {
+		in, out := &in.RecentPlacementWeightPercent, &out.RecentPlacementWeightPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FairnessShares != nil {
+		in, out := &in.FairnessShares, &out.FairnessShares
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FairnessWindowSeconds != nil {
+		in, out := &in.FairnessWindowSeconds, &out.FairnessWindowSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NodeGroupLabel != nil {
+		in, out := &in.NodeGroupLabel, &out.NodeGroupLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.AnnotateNodeClass != nil {
+		in, out := &in.AnnotateNodeClass, &out.AnnotateNodeClass
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OverheadBudgetMilliseconds != nil {
+		in, out := &in.OverheadBudgetMilliseconds, &out.OverheadBudgetMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ShadowMode != nil {
+		in, out := &in.ShadowMode, &out.ShadowMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CloudEventsSink != nil {
+		in, out := &in.CloudEventsSink, &out.CloudEventsSink
+		*out = new(string)
+		**out = **in
+	}
+	if in.InPlaceRebuildThreshold != nil {
+		in, out := &in.InPlaceRebuildThreshold, &out.InPlaceRebuildThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeReadinessSelector != nil {
+		in, out := &in.NodeReadinessSelector, &out.NodeReadinessSelector
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeReadinessConditions != nil {
+		in, out := &in.NodeReadinessConditions, &out.NodeReadinessConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = new(FlavourScoreWeights)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogCacheContents != nil {
+		in, out := &in.LogCacheContents, &out.LogCacheContents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScaleDownWindowSeconds != nil {
+		in, out := &in.ScaleDownWindowSeconds, &out.ScaleDownWindowSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DecisionSamplePercent != nil {
+		in, out := &in.DecisionSamplePercent, &out.DecisionSamplePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IgnoreOtherSchedulers != nil {
+		in, out := &in.IgnoreOtherSchedulers, &out.IgnoreOtherSchedulers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForecastHorizonSeconds != nil {
+		in, out := &in.ForecastHorizonSeconds, &out.ForecastHorizonSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VerifyInformerCache != nil {
+		in, out := &in.VerifyInformerCache, &out.VerifyInformerCache
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InformerCache != nil {
+		in, out := &in.InformerCache, &out.InformerCache
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheTTLSeconds != nil {
+		in, out := &in.CacheTTLSeconds, &out.CacheTTLSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RemoteCache != nil {
+		in, out := &in.RemoteCache, &out.RemoteCache
+		*out = new(FlavourRemoteCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdminAddress != nil {
+		in, out := &in.AdminAddress, &out.AdminAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdminTokenFile != nil {
+		in, out := &in.AdminTokenFile, &out.AdminTokenFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdminTLSCertFile != nil {
+		in, out := &in.AdminTLSCertFile, &out.AdminTLSCertFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdminTLSKeyFile != nil {
+		in, out := &in.AdminTLSKeyFile, &out.AdminTLSKeyFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExcludedPodPhases != nil {
+		in, out := &in.ExcludedPodPhases, &out.ExcludedPodPhases
+		*out = make([]corev1.PodPhase, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostBindQueueSize != nil {
+		in, out := &in.PostBindQueueSize, &out.PostBindQueueSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPodsPerFlavourPerNode != nil {
+		in, out := &in.MaxPodsPerFlavourPerNode, &out.MaxPodsPerFlavourPerNode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TopologyKey != nil {
+		in, out := &in.TopologyKey, &out.TopologyKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.LabelKeys != nil {
+		in, out := &in.LabelKeys, &out.LabelKeys
+		*out = make([]FlavourLabelKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.SnapshotGossipConfigMap != nil {
+		in, out := &in.SnapshotGossipConfigMap, &out.SnapshotGossipConfigMap
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeLabelSelector != nil {
+		in, out := &in.NodeLabelSelector, &out.NodeLabelSelector
+		*out = new(string)
+		**out = **in
+	}
+	in.Namespaces.DeepCopyInto(&out.Namespaces)
+	if in.TopologyTiers != nil {
+		in, out := &in.TopologyTiers, &out.TopologyTiers
+		*out = make([]FlavourTopologyTier, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetRatios != nil {
+		in, out := &in.TargetRatios, &out.TargetRatios
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AnnotatePlacementQuality != nil {
+		in, out := &in.AnnotatePlacementQuality, &out.AnnotatePlacementQuality
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxPodsPerTopologyDomain != nil {
+		in, out := &in.MaxPodsPerTopologyDomain, &out.MaxPodsPerTopologyDomain
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CapTopologyKey != nil {
+		in, out := &in.CapTopologyKey, &out.CapTopologyKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricsFlavours != nil {
+		in, out := &in.MetricsFlavours, &out.MetricsFlavours
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MetricsLabelCap != nil {
+		in, out := &in.MetricsLabelCap, &out.MetricsLabelCap
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SidecarContainers != nil {
+		in, out := &in.SidecarContainers, &out.SidecarContainers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RecordScoringEvents != nil {
+		in, out := &in.RecordScoringEvents, &out.RecordScoringEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodeUpdateAnnotations != nil {
+		in, out := &in.NodeUpdateAnnotations, &out.NodeUpdateAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeUpdateConditions != nil {
+		in, out := &in.NodeUpdateConditions, &out.NodeUpdateConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuditStore != nil {
+		in, out := &in.AuditStore, &out.AuditStore
+		*out = new(FlavourAuditStore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnforceFlavourQuotas != nil {
+		in, out := &in.EnforceFlavourQuotas, &out.EnforceFlavourQuotas
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QuotaWaitSeconds != nil {
+		in, out := &in.QuotaWaitSeconds, &out.QuotaWaitSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FlavourPolicy != nil {
+		in, out := &in.FlavourPolicy, &out.FlavourPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.FlavourPriorities != nil {
+		in, out := &in.FlavourPriorities, &out.FlavourPriorities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourClusterWideArgs.
+func (in *FlavourClusterWideArgs) DeepCopy() *FlavourClusterWideArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourClusterWideArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlavourClusterWideArgs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourLabelKey) DeepCopyInto(out *FlavourLabelKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourLabelKey.
+func (in *FlavourLabelKey) DeepCopy() *FlavourLabelKey {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourLabelKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourNamespaces) DeepCopyInto(out *FlavourNamespaces) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourNamespaces.
+func (in *FlavourNamespaces) DeepCopy() *FlavourNamespaces {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourNamespaces)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourRemoteCache) DeepCopyInto(out *FlavourRemoteCache) {
+	*out = *in
+	if in.KeyPrefix != nil {
+		in, out := &in.KeyPrefix, &out.KeyPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTLSeconds != nil {
+		in, out := &in.TTLSeconds, &out.TTLSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourRemoteCache.
+func (in *FlavourRemoteCache) DeepCopy() *FlavourRemoteCache {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourRemoteCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourScoreWeights) DeepCopyInto(out *FlavourScoreWeights) {
+	*out = *in
+	if in.NodeBalance != nil {
+		in, out := &in.NodeBalance, &out.NodeBalance
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ZoneBalance != nil {
+		in, out := &in.ZoneBalance, &out.ZoneBalance
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TieBreaker != nil {
+		in, out := &in.TieBreaker, &out.TieBreaker
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourScoreWeights.
+func (in *FlavourScoreWeights) DeepCopy() *FlavourScoreWeights {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourScoreWeights)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourTopologyTier) DeepCopyInto(out *FlavourTopologyTier) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourTopologyTier.
+func (in *FlavourTopologyTier) DeepCopy() *FlavourTopologyTier {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourTopologyTier)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadVariationRiskBalancingArgs) DeepCopyInto(out *LoadVariationRiskBalancingArgs) {
 	*out = *in
@@ -546,33 +996,3 @@ func (in *TrimaranSpec) DeepCopy() *TrimaranSpec {
 	in.DeepCopyInto(out)
 	return out
 }
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FlavourClusterWideArgs) DeepCopyInto(out *FlavourClusterWideArgs) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	if in.LabelName != nil {
-		in, out := &in.LabelName, &out.LabelName
-		*out = new(string)
-		**out = **in
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourClusterWideArgs.
-func (in *FlavourClusterWideArgs) DeepCopy() *FlavourClusterWideArgs {
-	if in == nil {
-		return nil
-	}
-	out := new(FlavourClusterWideArgs)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *FlavourClusterWideArgs) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
@@ -18,8 +18,17 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
 
+	v1 "k8s.io/api/core/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	schedconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
 
@@ -27,10 +36,26 @@ import (
 )
 
 var (
-	supportNodeResourcesMode sets.Set[string]
-	validScoringStrategy     sets.Set[string]
+	supportNodeResourcesMode    sets.Set[string]
+	validScoringStrategy        sets.Set[string]
+	validFlavourScoringStrategy sets.Set[string]
+	validFlavourUnknownNodes    sets.Set[string]
+	validPodPhases              sets.Set[string]
+	validPostBindOverflows      sets.Set[string]
+	validFlavourPresets         sets.Set[string]
+	validFlavourScoreCombiners  sets.Set[string]
+	validFlavourCountModes      sets.Set[string]
+	validFlavourAuditStores     sets.Set[string]
+	validFlavourRemoteCaches    sets.Set[string]
 )
 
+// sqlIdentifier matches the unquoted identifiers of PostgreSQL the audit table can be named with, as the
+// name is part of the statements rather than a parameter.
+var sqlIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// maxQuotaWaitSeconds is the longest the scheduler lets a pod wait in the Permit extension point.
+const maxQuotaWaitSeconds = 15 * 60
+
 func init() {
 	supportNodeResourcesMode = sets.New[string](
 		string(config.Least),
@@ -43,6 +68,60 @@ func init() {
 		string(config.LeastAllocated),
 		string(config.LeastNUMANodes),
 	)
+
+	validFlavourScoringStrategy = sets.New[string](
+		string(config.FlavourScoringSpread),
+		string(config.FlavourScoringVarianceReduction),
+		string(config.FlavourScoringProportional),
+		string(config.FlavourScoringBinPack),
+	)
+
+	validFlavourUnknownNodes = sets.New[string](
+		string(config.FlavourUnknownNodeEmpty),
+		string(config.FlavourUnknownNodeNeutral),
+	)
+
+	validPodPhases = sets.New[string](
+		string(v1.PodPending),
+		string(v1.PodRunning),
+		string(v1.PodSucceeded),
+		string(v1.PodFailed),
+		string(v1.PodUnknown),
+	)
+
+	validPostBindOverflows = sets.New[string](
+		string(config.FlavourPostBindDropAndReconcile),
+		string(config.FlavourPostBindBlock),
+	)
+
+	validFlavourPresets = sets.New[string](
+		string(config.FlavourPresetSpread),
+		string(config.FlavourPresetCostOptimized),
+		string(config.FlavourPresetHA),
+		string(config.FlavourPresetConsolidate),
+	)
+
+	validFlavourScoreCombiners = sets.New[string](
+		string(config.FlavourCombinerWeightedSum),
+		string(config.FlavourCombinerLexicographic),
+		string(config.FlavourCombinerMaxMin),
+	)
+
+	validFlavourCountModes = sets.New[string](
+		string(config.FlavourCountPods),
+		string(config.FlavourCountCPURequests),
+		string(config.FlavourCountMemoryRequests),
+		string(config.FlavourCountResourceWeighted),
+	)
+
+	validFlavourAuditStores = sets.New[string](
+		string(config.FlavourAuditStoreLoki),
+		string(config.FlavourAuditStorePostgreSQL),
+	)
+
+	validFlavourRemoteCaches = sets.New[string](
+		string(config.FlavourRemoteCacheRedis),
+	)
 }
 
 func ValidateNodeResourceTopologyMatchArgs(path *field.Path, args *config.NodeResourceTopologyMatchArgs) error {
@@ -109,3 +188,365 @@ func ValidateCoschedulingArgs(args *config.CoschedulingArgs, _ *field.Path) erro
 	}
 	return allErrs.ToAggregate()
 }
+
+func ValidateFlavourClusterWideArgs(args *config.FlavourClusterWideArgs, path *field.Path) error {
+	var allErrs field.ErrorList
+	if args.LabelName != "" {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(args.LabelName, path.Child("labelName"))...)
+	}
+	if args.NodeLifecycleLabel != "" {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(args.NodeLifecycleLabel, path.Child("nodeLifecycleLabel"))...)
+	}
+	for flavour, lifecycles := range args.LifecyclePreferences {
+		lifecyclesPath := path.Child("lifecyclePreferences").Key(flavour)
+		if len(lifecycles) == 0 {
+			allErrs = append(allErrs, field.Required(lifecyclesPath, "must list at least one node lifecycle"))
+		}
+		seen := sets.New[string]()
+		for i, lifecycle := range lifecycles {
+			if seen.Has(lifecycle) {
+				allErrs = append(allErrs, field.Duplicate(lifecyclesPath.Index(i), lifecycle))
+			}
+			seen.Insert(lifecycle)
+		}
+	}
+	if args.BatchLookahead < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("batchLookahead"), args.BatchLookahead, "must be greater than or equal to 0"))
+	}
+	if args.ScoringStrategy != "" && !validFlavourScoringStrategy.Has(string(args.ScoringStrategy)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("scoringStrategy"), args.ScoringStrategy, sets.List(validFlavourScoringStrategy)))
+	}
+	if args.ComparisonStrategy != "" && !validFlavourScoringStrategy.Has(string(args.ComparisonStrategy)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("comparisonStrategy"), args.ComparisonStrategy, sets.List(validFlavourScoringStrategy)))
+	}
+	if args.UnknownNodeScoring != "" && !validFlavourUnknownNodes.Has(string(args.UnknownNodeScoring)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("unknownNodeScoring"), args.UnknownNodeScoring, sets.List(validFlavourUnknownNodes)))
+	}
+	if args.CloudEventsSink != "" {
+		if sink, err := url.Parse(args.CloudEventsSink); err != nil || (sink.Scheme != "http" && sink.Scheme != "https") || sink.Host == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("cloudEventsSink"), args.CloudEventsSink, "must be an absolute http or https URL"))
+		}
+	}
+	if args.InPlaceRebuildThreshold < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("inPlaceRebuildThreshold"), args.InPlaceRebuildThreshold, "must be greater than or equal to 0"))
+	}
+	if _, err := labels.Parse(args.NodeReadinessSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("nodeReadinessSelector"), args.NodeReadinessSelector, err.Error()))
+	}
+	for i, condition := range args.NodeReadinessConditions {
+		if condition == "" {
+			allErrs = append(allErrs, field.Required(path.Child("nodeReadinessConditions").Index(i), "must be a node condition type"))
+		}
+	}
+	allErrs = append(allErrs, validateFlavourScoreWeights(args.Weights, path.Child("weights"))...)
+	if args.ScoreCombiner != "" && !validFlavourScoreCombiners.Has(string(args.ScoreCombiner)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("scoreCombiner"), args.ScoreCombiner, sets.List(validFlavourScoreCombiners)))
+	}
+	if args.CountMode != "" && !validFlavourCountModes.Has(string(args.CountMode)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("countMode"), args.CountMode, sets.List(validFlavourCountModes)))
+	} else if args.CountMode != "" && args.CountMode != config.FlavourCountPods && args.RecentPlacementWindowSeconds > 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("countMode"), args.CountMode, "must be Pods with recentPlacementWindowSeconds"))
+	}
+	if args.DecisionSamplePercent < 0 || args.DecisionSamplePercent > 100 {
+		allErrs = append(allErrs, field.Invalid(path.Child("decisionSamplePercent"), args.DecisionSamplePercent, "must be between 0 and 100"))
+	}
+	if args.ForecastHorizonSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("forecastHorizonSeconds"), args.ForecastHorizonSeconds, "must be greater than or equal to 0"))
+	}
+	if (args.AdminTLSCertFile == "") != (args.AdminTLSKeyFile == "") {
+		allErrs = append(allErrs, field.Invalid(path.Child("adminTLSKeyFile"), args.AdminTLSKeyFile, "must be set together with adminTLSCertFile"))
+	}
+	if args.AdminAddress != "" {
+		if host, _, err := net.SplitHostPort(args.AdminAddress); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("adminAddress"), args.AdminAddress, "must be a host:port address"))
+		} else if args.AdminTLSCertFile == "" && !isLoopbackHost(host) {
+			allErrs = append(allErrs, field.Invalid(path.Child("adminAddress"), args.AdminAddress,
+				"must be a loopback address without adminTLSCertFile and adminTLSKeyFile, as the bearer token would be sent in clear text"))
+		}
+		if args.AdminTokenFile == "" {
+			allErrs = append(allErrs, field.Required(path.Child("adminTokenFile"), "required with adminAddress"))
+		}
+	}
+	if args.CacheTTLSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("cacheTTLSeconds"), args.CacheTTLSeconds, "must be greater than or equal to 0"))
+	}
+	for i, phase := range args.ExcludedPodPhases {
+		if !validPodPhases.Has(string(phase)) {
+			allErrs = append(allErrs, field.NotSupported(path.Child("excludedPodPhases").Index(i), phase, sets.List(validPodPhases)))
+		}
+	}
+	if args.PostBindQueueSize < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("postBindQueueSize"), args.PostBindQueueSize, "must be greater than or equal to 0"))
+	}
+	if args.PostBindOverflowPolicy != "" && !validPostBindOverflows.Has(string(args.PostBindOverflowPolicy)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("postBindOverflowPolicy"), args.PostBindOverflowPolicy, sets.List(validPostBindOverflows)))
+	}
+	if args.MaxPodsPerFlavourPerNode < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("maxPodsPerFlavourPerNode"), args.MaxPodsPerFlavourPerNode, "must be greater than or equal to 0"))
+	}
+	if args.Preset != "" && !validFlavourPresets.Has(string(args.Preset)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("preset"), args.Preset, sets.List(validFlavourPresets)))
+	}
+	if args.ScaleDownWindowSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("scaleDownWindowSeconds"), args.ScaleDownWindowSeconds, "must be greater than or equal to 0"))
+	}
+	if args.RecentPlacementWindowSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("recentPlacementWindowSeconds"), args.RecentPlacementWindowSeconds, "must be greater than or equal to 0"))
+	}
+	if args.RecentPlacementWindowSeconds > 0 && args.RecentPlacementWeightPercent < 100 {
+		allErrs = append(allErrs, field.Invalid(path.Child("recentPlacementWeightPercent"), args.RecentPlacementWeightPercent, "must be greater than or equal to 100"))
+	}
+	for flavour, share := range args.FairnessShares {
+		if share <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("fairnessShares").Key(flavour), share, "must be greater than 0"))
+		}
+	}
+	if args.FairnessWindowSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("fairnessWindowSeconds"), args.FairnessWindowSeconds, "must be greater than or equal to 0"))
+	}
+	if args.NodeGroupLabel != "" {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(args.NodeGroupLabel, path.Child("nodeGroupLabel"))...)
+	}
+	if args.TopologyKey != "" {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(args.TopologyKey, path.Child("topologyKey"))...)
+	}
+	allErrs = append(allErrs, validateFlavourLabelKeys(args.LabelKeys, path.Child("labelKeys"))...)
+	if args.SnapshotGossipConfigMap != "" {
+		namespace, name, found := strings.Cut(args.SnapshotGossipConfigMap, "/")
+		if !found || len(validation.IsDNS1123Label(namespace)) > 0 || len(validation.IsDNS1123Subdomain(name)) > 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("snapshotGossipConfigMap"), args.SnapshotGossipConfigMap, "must be the namespace/name of a ConfigMap"))
+		}
+	}
+	if _, err := labels.Parse(args.NodeLabelSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("nodeLabelSelector"), args.NodeLabelSelector, err.Error()))
+	}
+	allErrs = append(allErrs, validateFlavourNamespaces(args.Namespaces, path.Child("namespaces"))...)
+	allErrs = append(allErrs, validateFlavourTopologyTiers(args.TopologyTiers, path.Child("topologyTiers"))...)
+	for flavour, ratio := range args.TargetRatios {
+		for _, msg := range validation.IsValidLabelValue(flavour) {
+			allErrs = append(allErrs, field.Invalid(path.Child("targetRatios").Key(flavour), flavour, msg))
+		}
+		if ratio <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("targetRatios").Key(flavour), ratio, "must be greater than 0"))
+		}
+	}
+	for flavour, limit := range args.MaxPodsPerTopologyDomain {
+		for _, msg := range validation.IsValidLabelValue(flavour) {
+			allErrs = append(allErrs, field.Invalid(path.Child("maxPodsPerTopologyDomain").Key(flavour), flavour, msg))
+		}
+		if limit <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("maxPodsPerTopologyDomain").Key(flavour), limit, "must be greater than 0"))
+		}
+	}
+	if args.CapTopologyKey != "" {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(args.CapTopologyKey, path.Child("capTopologyKey"))...)
+	}
+	for i, flavour := range args.MetricsFlavours {
+		for _, msg := range validation.IsValidLabelValue(flavour) {
+			allErrs = append(allErrs, field.Invalid(path.Child("metricsFlavours").Index(i), flavour, msg))
+		}
+	}
+	if args.MetricsLabelCap < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("metricsLabelCap"), args.MetricsLabelCap, "must be greater than or equal to 0"))
+	}
+	for i, pattern := range args.SidecarContainers {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("sidecarContainers").Index(i), pattern, err.Error()))
+		}
+	}
+	if len(args.SidecarContainers) > 0 && (args.CountMode == "" || args.CountMode == config.FlavourCountPods) {
+		allErrs = append(allErrs, field.Invalid(path.Child("sidecarContainers"), args.SidecarContainers, "must not be set with the Pods count mode"))
+	}
+	for _, key := range sets.List(sets.KeySet(args.NodeUpdateAnnotations)) {
+		for _, msg := range validation.IsQualifiedName(key) {
+			allErrs = append(allErrs, field.Invalid(path.Child("nodeUpdateAnnotations").Key(key), key, msg))
+		}
+	}
+	for i, condition := range args.NodeUpdateConditions {
+		if condition == "" {
+			allErrs = append(allErrs, field.Required(path.Child("nodeUpdateConditions").Index(i), "must be a node condition type"))
+		}
+	}
+	if args.AuditStore != nil {
+		allErrs = append(allErrs, validateFlavourAuditStore(args.AuditStore, path.Child("auditStore"))...)
+	}
+	if args.RemoteCache != nil {
+		allErrs = append(allErrs, validateFlavourRemoteCache(args.RemoteCache, args.CacheTTLSeconds, path.Child("remoteCache"))...)
+		if args.InformerCache {
+			allErrs = append(allErrs, field.Invalid(path.Child("remoteCache"), args.RemoteCache.Type, "cannot be set with informerCache"))
+		}
+	}
+	if args.QuotaWaitSeconds < 0 || args.QuotaWaitSeconds > maxQuotaWaitSeconds {
+		allErrs = append(allErrs, field.Invalid(path.Child("quotaWaitSeconds"), args.QuotaWaitSeconds, fmt.Sprintf("must be between 0 and %d", maxQuotaWaitSeconds)))
+	}
+	if args.FlavourPolicy != "" {
+		namespace, name, found := strings.Cut(args.FlavourPolicy, "/")
+		if !found || len(validation.IsDNS1123Label(namespace)) > 0 || len(validation.IsDNS1123Subdomain(name)) > 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("flavourPolicy"), args.FlavourPolicy, "must be the namespace/name of a FlavourPolicy"))
+		}
+	}
+	priorities := sets.New[string]()
+	for i, flavour := range args.FlavourPriorities {
+		for _, msg := range validation.IsValidLabelValue(flavour) {
+			allErrs = append(allErrs, field.Invalid(path.Child("flavourPriorities").Index(i), flavour, msg))
+		}
+		if flavour == "" {
+			allErrs = append(allErrs, field.Required(path.Child("flavourPriorities").Index(i), "must be a flavour"))
+		} else if priorities.Has(flavour) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("flavourPriorities").Index(i), flavour))
+		}
+		priorities.Insert(flavour)
+	}
+	if len(args.TopologyTiers) > 0 && args.TopologyKey != "" {
+		allErrs = append(allErrs, field.Invalid(path.Child("topologyKey"), args.TopologyKey, "must not be set with topologyTiers"))
+	}
+	if args.OverheadBudgetMilliseconds < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("overheadBudgetMilliseconds"), args.OverheadBudgetMilliseconds, "must be greater than or equal to 0"))
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return allErrs.ToAggregate()
+}
+
+// validateFlavourLabelKeys checks that the label keys are valid label names, listed once, with positive weights.
+func validateFlavourLabelKeys(keys []config.FlavourLabelKey, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := sets.New[string]()
+	for i, key := range keys {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(key.LabelKey, path.Index(i).Child("labelKey"))...)
+		if seen.Has(key.LabelKey) {
+			allErrs = append(allErrs, field.Duplicate(path.Index(i).Child("labelKey"), key.LabelKey))
+		}
+		seen.Insert(key.LabelKey)
+		if key.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("weight"), key.Weight, "must be greater than 0"))
+		}
+	}
+	return allErrs
+}
+
+// validateFlavourNamespaces checks that the namespaces are valid namespace names, listed once, and not
+// both included and excluded.
+func validateFlavourNamespaces(namespaces config.FlavourNamespaces, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	included := sets.New[string]()
+	for i, namespace := range namespaces.Include {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(path.Child("include").Index(i), namespace, msg))
+		}
+		if included.Has(namespace) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("include").Index(i), namespace))
+		}
+		included.Insert(namespace)
+	}
+	excluded := sets.New[string]()
+	for i, namespace := range namespaces.Exclude {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(path.Child("exclude").Index(i), namespace, msg))
+		}
+		if excluded.Has(namespace) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("exclude").Index(i), namespace))
+		}
+		excluded.Insert(namespace)
+		if included.Has(namespace) {
+			allErrs = append(allErrs, field.Invalid(path.Child("exclude").Index(i), namespace, "must not be included as well"))
+		}
+	}
+	return allErrs
+}
+
+// validateFlavourTopologyTiers checks that the tiers have valid and distinct topology keys, the hostname
+// label only as the last tier after a tier of groups, and tolerances that are not negative.
+func validateFlavourTopologyTiers(tiers []config.FlavourTopologyTier, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := sets.New[string]()
+	for i, tier := range tiers {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(tier.TopologyKey, path.Index(i).Child("topologyKey"))...)
+		if seen.Has(tier.TopologyKey) {
+			allErrs = append(allErrs, field.Duplicate(path.Index(i).Child("topologyKey"), tier.TopologyKey))
+		}
+		seen.Insert(tier.TopologyKey)
+		if tier.TopologyKey == v1.LabelHostname && (i != len(tiers)-1 || i == 0) {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("topologyKey"), tier.TopologyKey, "must be the last tier, after a tier of node groups"))
+		}
+		if tier.Tolerance < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("tolerance"), tier.Tolerance, "must be greater than or equal to 0"))
+		}
+	}
+	return allErrs
+}
+
+// validateFlavourAuditStore checks that the store has a supported type and the settings of its type.
+func validateFlavourAuditStore(store *config.FlavourAuditStore, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch store.Type {
+	case config.FlavourAuditStoreLoki:
+		if endpoint, err := url.Parse(store.URL); err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("url"), store.URL, "must be an absolute http or https URL"))
+		}
+	case config.FlavourAuditStorePostgreSQL:
+		if store.ConnectionStringFile == "" {
+			allErrs = append(allErrs, field.Required(path.Child("connectionStringFile"), "required with the PostgreSQL store"))
+		}
+		if store.Driver == "" {
+			allErrs = append(allErrs, field.Required(path.Child("driver"), "must be a database/sql driver name"))
+		}
+		if !sqlIdentifier.MatchString(store.Table) {
+			allErrs = append(allErrs, field.Invalid(path.Child("table"), store.Table, "must be a lowercase SQL identifier"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("type"), store.Type, sets.List(validFlavourAuditStores)))
+	}
+	return allErrs
+}
+
+// validateFlavourRemoteCache checks that the remote cache has a supported type and the settings of its
+// type. A listing must expire from the remote cache before the cache it is rebuilt into does, or the
+// rebuilds would keep reading it.
+func validateFlavourRemoteCache(cache *config.FlavourRemoteCache, cacheTTLSeconds int64, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch cache.Type {
+	case config.FlavourRemoteCacheRedis:
+		if _, _, err := net.SplitHostPort(cache.Address); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("address"), cache.Address, "must be a host:port address"))
+		}
+		if cache.KeyPrefix == "" {
+			allErrs = append(allErrs, field.Required(path.Child("keyPrefix"), "required with the Redis remote cache"))
+		}
+		if cache.TTLSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("ttlSeconds"), cache.TTLSeconds, "must be greater than 0"))
+		} else if cacheTTLSeconds > 0 && int64(cache.TTLSeconds) >= cacheTTLSeconds {
+			allErrs = append(allErrs, field.Invalid(path.Child("ttlSeconds"), cache.TTLSeconds, "must be less than cacheTTLSeconds"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("type"), cache.Type, sets.List(validFlavourRemoteCaches)))
+	}
+	return allErrs
+}
+
+// validateFlavourScoreWeights checks that the weights are not negative. All zero weights are allowed
+// and mean the weights are unset, which is the node balance alone.
+func validateFlavourScoreWeights(weights config.FlavourScoreWeights, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if weights.NodeBalance < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("nodeBalance"), weights.NodeBalance, "must be greater than or equal to 0"))
+	}
+	if weights.ZoneBalance < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("zoneBalance"), weights.ZoneBalance, "must be greater than or equal to 0"))
+	}
+	if weights.TieBreaker < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("tieBreaker"), weights.TieBreaker, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+// isLoopbackHost reports whether the host of a listen address only accepts local connections: localhost
+// or a loopback IP. An empty host listens on every interface.
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
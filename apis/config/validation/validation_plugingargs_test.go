@@ -23,6 +23,7 @@ import (
 
 	gocmp "github.com/google/go-cmp/cmp"
 
+	v1 "k8s.io/api/core/v1"
 	schedconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
 
 	"sigs.k8s.io/scheduler-plugins/apis/config"
@@ -193,3 +194,572 @@ func TestValidateNodeResourcesAllocatableArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFlavourClusterWideArgs(t *testing.T) {
+	testCases := []struct {
+		args        *config.FlavourClusterWideArgs
+		expectedErr error
+		description string
+	}{
+		{
+			description: "correct config with default label name",
+			args:        &config.FlavourClusterWideArgs{LabelName: "flavour"},
+		},
+		{
+			description: "correct config with prefixed label name",
+			args:        &config.FlavourClusterWideArgs{LabelName: "example.com/tier"},
+		},
+		{
+			description: "empty label name falls back to the default",
+			args:        &config.FlavourClusterWideArgs{},
+		},
+		{
+			description: "invalid label name",
+			args:        &config.FlavourClusterWideArgs{LabelName: "not a label"},
+			expectedErr: fmt.Errorf("labelName: Invalid value: \"not a label\""),
+		},
+		{
+			description: "correct lifecycle preferences",
+			args: &config.FlavourClusterWideArgs{
+				NodeLifecycleLabel: "karpenter.sh/capacity-type",
+				LifecyclePreferences: map[string][]string{
+					"gold":   {"on-demand"},
+					"silver": {"spot", "on-demand"},
+				},
+			},
+		},
+		{
+			description: "empty lifecycle preference",
+			args: &config.FlavourClusterWideArgs{
+				LifecyclePreferences: map[string][]string{"gold": {}},
+			},
+			expectedErr: fmt.Errorf("lifecyclePreferences[gold]: Required value"),
+		},
+		{
+			description: "duplicated lifecycle preference",
+			args: &config.FlavourClusterWideArgs{
+				LifecyclePreferences: map[string][]string{"silver": {"spot", "spot"}},
+			},
+			expectedErr: fmt.Errorf("lifecyclePreferences[silver][1]: Duplicate value: \"spot\""),
+		},
+		{
+			description: "correct batch lookahead",
+			args:        &config.FlavourClusterWideArgs{BatchLookahead: 50},
+		},
+		{
+			description: "negative batch lookahead",
+			args:        &config.FlavourClusterWideArgs{BatchLookahead: -1},
+			expectedErr: fmt.Errorf("batchLookahead: Invalid value: -1"),
+		},
+		{
+			description: "correct scoring strategy",
+			args:        &config.FlavourClusterWideArgs{ScoringStrategy: config.FlavourScoringVarianceReduction},
+		},
+		{
+			description: "proportional scoring strategy",
+			args:        &config.FlavourClusterWideArgs{ScoringStrategy: config.FlavourScoringProportional},
+		},
+		{
+			description: "bin packing scoring strategy",
+			args:        &config.FlavourClusterWideArgs{ScoringStrategy: config.FlavourScoringBinPack},
+		},
+		{
+			description: "unsupported scoring strategy",
+			args:        &config.FlavourClusterWideArgs{ScoringStrategy: "MostAllocated"},
+			expectedErr: fmt.Errorf("scoringStrategy: Unsupported value: \"MostAllocated\""),
+		},
+		{
+			description: "correct comparison strategy",
+			args:        &config.FlavourClusterWideArgs{ComparisonStrategy: config.FlavourScoringVarianceReduction},
+		},
+		{
+			description: "unsupported comparison strategy",
+			args:        &config.FlavourClusterWideArgs{ComparisonStrategy: "MostAllocated"},
+			expectedErr: fmt.Errorf("comparisonStrategy: Unsupported value: \"MostAllocated\""),
+		},
+		{
+			description: "unsupported unknown node scoring",
+			args:        &config.FlavourClusterWideArgs{UnknownNodeScoring: "Ignore"},
+			expectedErr: fmt.Errorf("unknownNodeScoring: Unsupported value: \"Ignore\""),
+		},
+		{
+			description: "correct CloudEvents sink",
+			args:        &config.FlavourClusterWideArgs{CloudEventsSink: "http://event-display.default.svc"},
+		},
+		{
+			description: "relative CloudEvents sink",
+			args:        &config.FlavourClusterWideArgs{CloudEventsSink: "event-display"},
+			expectedErr: fmt.Errorf("cloudEventsSink: Invalid value: \"event-display\""),
+		},
+		{
+			description: "negative in-place rebuild threshold",
+			args:        &config.FlavourClusterWideArgs{InPlaceRebuildThreshold: -1},
+			expectedErr: fmt.Errorf("inPlaceRebuildThreshold: Invalid value: -1"),
+		},
+		{
+			description: "correct node readiness gate",
+			args: &config.FlavourClusterWideArgs{
+				NodeReadinessSelector:   "example.com/gpu-driver-ready=true",
+				NodeReadinessConditions: []string{"NetworkReady"},
+			},
+		},
+		{
+			description: "invalid node readiness selector",
+			args:        &config.FlavourClusterWideArgs{NodeReadinessSelector: "gpu in"},
+			expectedErr: fmt.Errorf("nodeReadinessSelector: Invalid value: \"gpu in\""),
+		},
+		{
+			description: "empty node readiness condition",
+			args:        &config.FlavourClusterWideArgs{NodeReadinessConditions: []string{"NetworkReady", ""}},
+			expectedErr: fmt.Errorf("nodeReadinessConditions[1]: Required value"),
+		},
+		{
+			description: "correct weights",
+			args:        &config.FlavourClusterWideArgs{Weights: config.FlavourScoreWeights{NodeBalance: 2, ZoneBalance: 1}},
+		},
+		{
+			description: "negative weight",
+			args:        &config.FlavourClusterWideArgs{Weights: config.FlavourScoreWeights{NodeBalance: 1, TieBreaker: -1}},
+			expectedErr: fmt.Errorf("weights.tieBreaker: Invalid value: -1"),
+		},
+		{
+			description: "correct age-weighted counting",
+			args:        &config.FlavourClusterWideArgs{RecentPlacementWindowSeconds: 300, RecentPlacementWeightPercent: 150},
+		},
+		{
+			description: "decision sample percent above 100",
+			args:        &config.FlavourClusterWideArgs{DecisionSamplePercent: 101},
+			expectedErr: fmt.Errorf("decisionSamplePercent: Invalid value: 101"),
+		},
+		{
+			description: "negative forecast horizon",
+			args:        &config.FlavourClusterWideArgs{ForecastHorizonSeconds: -1},
+			expectedErr: fmt.Errorf("forecastHorizonSeconds: Invalid value: -1"),
+		},
+		{
+			description: "admin service",
+			args:        &config.FlavourClusterWideArgs{AdminAddress: "127.0.0.1:10270", AdminTokenFile: "/etc/flavour-admin/token"},
+		},
+		{
+			description: "admin service without a port",
+			args:        &config.FlavourClusterWideArgs{AdminAddress: "127.0.0.1", AdminTokenFile: "/etc/flavour-admin/token"},
+			expectedErr: fmt.Errorf("adminAddress: Invalid value: \"127.0.0.1\""),
+		},
+		{
+			description: "admin service without a token",
+			args:        &config.FlavourClusterWideArgs{AdminAddress: "127.0.0.1:10270"},
+			expectedErr: fmt.Errorf("adminTokenFile: Required value"),
+		},
+		{
+			description: "admin service on localhost",
+			args:        &config.FlavourClusterWideArgs{AdminAddress: "localhost:10270", AdminTokenFile: "/etc/flavour-admin/token"},
+		},
+		{
+			description: "admin service on every interface without TLS",
+			args:        &config.FlavourClusterWideArgs{AdminAddress: ":10270", AdminTokenFile: "/etc/flavour-admin/token"},
+			expectedErr: fmt.Errorf("adminAddress: Invalid value: \":10270\": must be a loopback address"),
+		},
+		{
+			description: "admin service on a pod IP without TLS",
+			args:        &config.FlavourClusterWideArgs{AdminAddress: "10.0.0.5:10270", AdminTokenFile: "/etc/flavour-admin/token"},
+			expectedErr: fmt.Errorf("adminAddress: Invalid value: \"10.0.0.5:10270\": must be a loopback address"),
+		},
+		{
+			description: "admin service over TLS",
+			args: &config.FlavourClusterWideArgs{AdminAddress: ":10270", AdminTokenFile: "/etc/flavour-admin/token",
+				AdminTLSCertFile: "/etc/flavour-admin/tls.crt", AdminTLSKeyFile: "/etc/flavour-admin/tls.key"},
+		},
+		{
+			description: "admin TLS certificate without a key",
+			args: &config.FlavourClusterWideArgs{AdminAddress: ":10270", AdminTokenFile: "/etc/flavour-admin/token",
+				AdminTLSCertFile: "/etc/flavour-admin/tls.crt"},
+			expectedErr: fmt.Errorf("adminTLSKeyFile: Invalid value: \"\": must be set together with adminTLSCertFile"),
+		},
+		{
+			description: "negative cache TTL",
+			args:        &config.FlavourClusterWideArgs{CacheTTLSeconds: -1},
+			expectedErr: fmt.Errorf("cacheTTLSeconds: Invalid value: -1"),
+		},
+		{
+			description: "excluded pod phases",
+			args:        &config.FlavourClusterWideArgs{ExcludedPodPhases: []v1.PodPhase{v1.PodSucceeded, v1.PodFailed}},
+		},
+		{
+			description: "unknown excluded pod phase",
+			args:        &config.FlavourClusterWideArgs{ExcludedPodPhases: []v1.PodPhase{"Completed"}},
+			expectedErr: fmt.Errorf("excludedPodPhases[0]: Unsupported value: \"Completed\""),
+		},
+		{
+			description: "PostBind queue",
+			args:        &config.FlavourClusterWideArgs{PostBindQueueSize: 1000, PostBindOverflowPolicy: config.FlavourPostBindBlock},
+		},
+		{
+			description: "negative PostBind queue size",
+			args:        &config.FlavourClusterWideArgs{PostBindQueueSize: -1},
+			expectedErr: fmt.Errorf("postBindQueueSize: Invalid value: -1"),
+		},
+		{
+			description: "unknown PostBind overflow policy",
+			args:        &config.FlavourClusterWideArgs{PostBindOverflowPolicy: "Retry"},
+			expectedErr: fmt.Errorf("postBindOverflowPolicy: Unsupported value: \"Retry\""),
+		},
+		{
+			description: "negative max pods per flavour per node",
+			args:        &config.FlavourClusterWideArgs{MaxPodsPerFlavourPerNode: -1},
+			expectedErr: fmt.Errorf("maxPodsPerFlavourPerNode: Invalid value: -1"),
+		},
+		{
+			description: "preset",
+			args:        &config.FlavourClusterWideArgs{Preset: config.FlavourPresetHA},
+		},
+		{
+			description: "unknown preset",
+			args:        &config.FlavourClusterWideArgs{Preset: "Balanced"},
+			expectedErr: fmt.Errorf("preset: Unsupported value: \"Balanced\""),
+		},
+		{
+			description: "topology key",
+			args:        &config.FlavourClusterWideArgs{TopologyKey: "topology.kubernetes.io/zone"},
+		},
+		{
+			description: "invalid topology key",
+			args:        &config.FlavourClusterWideArgs{TopologyKey: "zone/"},
+			expectedErr: fmt.Errorf("topologyKey: Invalid value: \"zone/\""),
+		},
+		{
+			description: "label keys",
+			args: &config.FlavourClusterWideArgs{
+				LabelName: "flavour",
+				LabelKeys: []config.FlavourLabelKey{{LabelKey: "flavour", Weight: 2}, {LabelKey: "tier", Weight: 1}},
+			},
+		},
+		{
+			description: "duplicate label key",
+			args:        &config.FlavourClusterWideArgs{LabelKeys: []config.FlavourLabelKey{{LabelKey: "tier", Weight: 1}, {LabelKey: "tier", Weight: 2}}},
+			expectedErr: fmt.Errorf("labelKeys[1].labelKey: Duplicate value: \"tier\""),
+		},
+		{
+			description: "label key without weight",
+			args:        &config.FlavourClusterWideArgs{LabelKeys: []config.FlavourLabelKey{{LabelKey: "tier"}}},
+			expectedErr: fmt.Errorf("labelKeys[0].weight: Invalid value: 0"),
+		},
+		{
+			description: "snapshot gossip ConfigMap",
+			args:        &config.FlavourClusterWideArgs{SnapshotGossipConfigMap: "kube-system/flavour-snapshot"},
+		},
+		{
+			description: "snapshot gossip ConfigMap without namespace",
+			args:        &config.FlavourClusterWideArgs{SnapshotGossipConfigMap: "flavour-snapshot"},
+			expectedErr: fmt.Errorf("snapshotGossipConfigMap: Invalid value: \"flavour-snapshot\""),
+		},
+		{
+			description: "node label selector",
+			args:        &config.FlavourClusterWideArgs{NodeLabelSelector: "node.kubernetes.io/instance-type in (m5.large,m5.xlarge)"},
+		},
+		{
+			description: "invalid node label selector",
+			args:        &config.FlavourClusterWideArgs{NodeLabelSelector: "role in (worker"},
+			expectedErr: fmt.Errorf("nodeLabelSelector: Invalid value: \"role in (worker\""),
+		},
+		{
+			description: "lexicographic score combiner",
+			args:        &config.FlavourClusterWideArgs{ScoreCombiner: config.FlavourCombinerLexicographic},
+		},
+		{
+			description: "unknown score combiner",
+			args:        &config.FlavourClusterWideArgs{ScoreCombiner: "Product"},
+			expectedErr: fmt.Errorf("scoreCombiner: Unsupported value: \"Product\""),
+		},
+		{
+			description: "namespaces",
+			args:        &config.FlavourClusterWideArgs{Namespaces: config.FlavourNamespaces{Include: []string{"tenant-a", "tenant-b"}, Exclude: []string{"kube-system"}}},
+		},
+		{
+			description: "invalid included namespace",
+			args:        &config.FlavourClusterWideArgs{Namespaces: config.FlavourNamespaces{Include: []string{"Tenant_A"}}},
+			expectedErr: fmt.Errorf("namespaces.include[0]: Invalid value: \"Tenant_A\""),
+		},
+		{
+			description: "duplicate excluded namespace",
+			args:        &config.FlavourClusterWideArgs{Namespaces: config.FlavourNamespaces{Exclude: []string{"kube-system", "kube-system"}}},
+			expectedErr: fmt.Errorf("namespaces.exclude[1]: Duplicate value: \"kube-system\""),
+		},
+		{
+			description: "namespace included and excluded",
+			args:        &config.FlavourClusterWideArgs{Namespaces: config.FlavourNamespaces{Include: []string{"tenant-a"}, Exclude: []string{"tenant-a"}}},
+			expectedErr: fmt.Errorf("namespaces.exclude[0]: Invalid value: \"tenant-a\": must not be included as well"),
+		},
+		{
+			description: "topology tiers",
+			args: &config.FlavourClusterWideArgs{TopologyTiers: []config.FlavourTopologyTier{
+				{TopologyKey: "topology.kubernetes.io/region", Tolerance: 10},
+				{TopologyKey: "topology.kubernetes.io/zone", Tolerance: 2},
+				{TopologyKey: "kubernetes.io/hostname", Tolerance: 1},
+			}},
+		},
+		{
+			description: "hostname topology tier before a tier of groups",
+			args: &config.FlavourClusterWideArgs{TopologyTiers: []config.FlavourTopologyTier{
+				{TopologyKey: "kubernetes.io/hostname"},
+				{TopologyKey: "topology.kubernetes.io/zone"},
+			}},
+			expectedErr: fmt.Errorf("topologyTiers[0].topologyKey: Invalid value: \"kubernetes.io/hostname\": must be the last tier, after a tier of node groups"),
+		},
+		{
+			description: "hostname topology tier alone",
+			args:        &config.FlavourClusterWideArgs{TopologyTiers: []config.FlavourTopologyTier{{TopologyKey: "kubernetes.io/hostname"}}},
+			expectedErr: fmt.Errorf("topologyTiers[0].topologyKey: Invalid value: \"kubernetes.io/hostname\": must be the last tier, after a tier of node groups"),
+		},
+		{
+			description: "duplicate topology tier",
+			args: &config.FlavourClusterWideArgs{TopologyTiers: []config.FlavourTopologyTier{
+				{TopologyKey: "topology.kubernetes.io/zone"},
+				{TopologyKey: "topology.kubernetes.io/zone"},
+			}},
+			expectedErr: fmt.Errorf("topologyTiers[1].topologyKey: Duplicate value: \"topology.kubernetes.io/zone\""),
+		},
+		{
+			description: "negative topology tier tolerance",
+			args:        &config.FlavourClusterWideArgs{TopologyTiers: []config.FlavourTopologyTier{{TopologyKey: "topology.kubernetes.io/zone", Tolerance: -1}}},
+			expectedErr: fmt.Errorf("topologyTiers[0].tolerance: Invalid value: -1"),
+		},
+		{
+			description: "topology tiers with a topology key",
+			args: &config.FlavourClusterWideArgs{
+				TopologyKey:   "topology.kubernetes.io/zone",
+				TopologyTiers: []config.FlavourTopologyTier{{TopologyKey: "topology.kubernetes.io/region"}},
+			},
+			expectedErr: fmt.Errorf("topologyKey: Invalid value: \"topology.kubernetes.io/zone\": must not be set with topologyTiers"),
+		},
+		{
+			description: "target ratios",
+			args:        &config.FlavourClusterWideArgs{TargetRatios: map[string]int32{"gold": 1, "silver": 2, "bronze": 4}},
+		},
+		{
+			description: "zero target ratio",
+			args:        &config.FlavourClusterWideArgs{TargetRatios: map[string]int32{"gold": 0}},
+			expectedErr: fmt.Errorf("targetRatios[gold]: Invalid value: 0: must be greater than 0"),
+		},
+		{
+			description: "invalid target ratio flavour",
+			args:        &config.FlavourClusterWideArgs{TargetRatios: map[string]int32{"not valid": 1}},
+			expectedErr: fmt.Errorf("targetRatios[not valid]: Invalid value: \"not valid\""),
+		},
+		{
+			description: "invalid count mode",
+			args:        &config.FlavourClusterWideArgs{CountMode: "Nodes"},
+			expectedErr: fmt.Errorf("countMode: Unsupported value: \"Nodes\""),
+		},
+		{
+			description: "count mode with age weighting",
+			args:        &config.FlavourClusterWideArgs{CountMode: config.FlavourCountCPURequests, RecentPlacementWindowSeconds: 60, RecentPlacementWeightPercent: 200},
+			expectedErr: fmt.Errorf("countMode: Invalid value: \"CPURequests\""),
+		},
+		{
+			description: "non-positive topology domain cap",
+			args:        &config.FlavourClusterWideArgs{MaxPodsPerTopologyDomain: map[string]int32{"gold": 0}},
+			expectedErr: fmt.Errorf("maxPodsPerTopologyDomain[gold]: Invalid value: 0"),
+		},
+		{
+			description: "invalid cap topology key",
+			args:        &config.FlavourClusterWideArgs{CapTopologyKey: "not valid"},
+			expectedErr: fmt.Errorf("capTopologyKey: Invalid value: \"not valid\""),
+		},
+		{
+			description: "invalid metrics flavour",
+			args:        &config.FlavourClusterWideArgs{MetricsFlavours: []string{"gold", "not valid"}},
+			expectedErr: fmt.Errorf("metricsFlavours[1]: Invalid value: \"not valid\""),
+		},
+		{
+			description: "negative metrics label cap",
+			args:        &config.FlavourClusterWideArgs{MetricsLabelCap: -1},
+			expectedErr: fmt.Errorf("metricsLabelCap: Invalid value: -1"),
+		},
+		{
+			description: "invalid sidecar container pattern",
+			args:        &config.FlavourClusterWideArgs{CountMode: config.FlavourCountResourceWeighted, SidecarContainers: []string{"istio-proxy", "[sidecar"}},
+			expectedErr: fmt.Errorf("sidecarContainers[1]: Invalid value: \"[sidecar\""),
+		},
+		{
+			description: "sidecar containers with the Pods count mode",
+			args:        &config.FlavourClusterWideArgs{CountMode: config.FlavourCountPods, SidecarContainers: []string{"istio-proxy"}},
+			expectedErr: fmt.Errorf("sidecarContainers: Invalid value"),
+		},
+		{
+			description: "correct node update annotations and conditions",
+			args: &config.FlavourClusterWideArgs{
+				NodeUpdateAnnotations: map[string]string{"weave.works/kured-reboot-in-progress": "", "machineconfiguration.openshift.io/state": "Working"},
+				NodeUpdateConditions:  []string{"OSUpdateInProgress"},
+			},
+		},
+		{
+			description: "invalid node update annotation",
+			args:        &config.FlavourClusterWideArgs{NodeUpdateAnnotations: map[string]string{"not valid": ""}},
+			expectedErr: fmt.Errorf("nodeUpdateAnnotations[not valid]: Invalid value: \"not valid\""),
+		},
+		{
+			description: "empty node update condition",
+			args:        &config.FlavourClusterWideArgs{NodeUpdateConditions: []string{"OSUpdateInProgress", ""}},
+			expectedErr: fmt.Errorf("nodeUpdateConditions[1]: Required value"),
+		},
+		{
+			description: "correct Loki audit store",
+			args:        &config.FlavourClusterWideArgs{AuditStore: &config.FlavourAuditStore{Type: config.FlavourAuditStoreLoki, URL: "http://loki:3100/loki/api/v1/push"}},
+		},
+		{
+			description: "correct PostgreSQL audit store",
+			args: &config.FlavourClusterWideArgs{AuditStore: &config.FlavourAuditStore{
+				Type:                 config.FlavourAuditStorePostgreSQL,
+				ConnectionStringFile: "/etc/audit/dsn",
+				Driver:               "pgx",
+				Table:                "flavour_audit",
+			}},
+		},
+		{
+			description: "unsupported audit store",
+			args:        &config.FlavourClusterWideArgs{AuditStore: &config.FlavourAuditStore{Type: "Elasticsearch"}},
+			expectedErr: fmt.Errorf("auditStore.type: Unsupported value: \"Elasticsearch\""),
+		},
+		{
+			description: "Loki audit store without URL",
+			args:        &config.FlavourClusterWideArgs{AuditStore: &config.FlavourAuditStore{Type: config.FlavourAuditStoreLoki}},
+			expectedErr: fmt.Errorf("auditStore.url: Invalid value: \"\""),
+		},
+		{
+			description: "PostgreSQL audit store without connection string file",
+			args:        &config.FlavourClusterWideArgs{AuditStore: &config.FlavourAuditStore{Type: config.FlavourAuditStorePostgreSQL, Driver: "pgx", Table: "flavour_audit"}},
+			expectedErr: fmt.Errorf("auditStore.connectionStringFile: Required value"),
+		},
+		{
+			description: "PostgreSQL audit table that is not an identifier",
+			args: &config.FlavourClusterWideArgs{AuditStore: &config.FlavourAuditStore{
+				Type:                 config.FlavourAuditStorePostgreSQL,
+				ConnectionStringFile: "/etc/audit/dsn",
+				Driver:               "pgx",
+				Table:                "audit; DROP TABLE pods",
+			}},
+			expectedErr: fmt.Errorf("auditStore.table: Invalid value"),
+		},
+		{
+			description: "correct Redis remote cache",
+			args:        &config.FlavourClusterWideArgs{CacheTTLSeconds: 60, RemoteCache: &config.FlavourRemoteCache{Type: config.FlavourRemoteCacheRedis, Address: "redis:6379", KeyPrefix: "flavourclusterwide", TTLSeconds: 10}},
+		},
+		{
+			description: "unsupported remote cache",
+			args:        &config.FlavourClusterWideArgs{RemoteCache: &config.FlavourRemoteCache{Type: "Memcached"}},
+			expectedErr: fmt.Errorf("remoteCache.type: Unsupported value: \"Memcached\""),
+		},
+		{
+			description: "Redis remote cache without port",
+			args:        &config.FlavourClusterWideArgs{RemoteCache: &config.FlavourRemoteCache{Type: config.FlavourRemoteCacheRedis, Address: "redis", KeyPrefix: "flavourclusterwide", TTLSeconds: 10}},
+			expectedErr: fmt.Errorf("remoteCache.address: Invalid value: \"redis\""),
+		},
+		{
+			description: "Redis remote cache TTL as long as the cache TTL",
+			args:        &config.FlavourClusterWideArgs{CacheTTLSeconds: 60, RemoteCache: &config.FlavourRemoteCache{Type: config.FlavourRemoteCacheRedis, Address: "redis:6379", KeyPrefix: "flavourclusterwide", TTLSeconds: 60}},
+			expectedErr: fmt.Errorf("remoteCache.ttlSeconds: Invalid value: 60: must be less than cacheTTLSeconds"),
+		},
+		{
+			description: "Redis remote cache with the informer cache",
+			args:        &config.FlavourClusterWideArgs{InformerCache: true, RemoteCache: &config.FlavourRemoteCache{Type: config.FlavourRemoteCacheRedis, Address: "redis:6379", KeyPrefix: "flavourclusterwide", TTLSeconds: 10}},
+			expectedErr: fmt.Errorf("remoteCache: Invalid value: \"Redis\": cannot be set with informerCache"),
+		},
+		{
+			description: "correct flavour quotas",
+			args:        &config.FlavourClusterWideArgs{EnforceFlavourQuotas: true, QuotaWaitSeconds: 900},
+		},
+		{
+			description: "negative quota wait",
+			args:        &config.FlavourClusterWideArgs{EnforceFlavourQuotas: true, QuotaWaitSeconds: -1},
+			expectedErr: fmt.Errorf("quotaWaitSeconds: Invalid value: -1"),
+		},
+		{
+			description: "quota wait longer than the scheduler allows",
+			args:        &config.FlavourClusterWideArgs{EnforceFlavourQuotas: true, QuotaWaitSeconds: 901},
+			expectedErr: fmt.Errorf("quotaWaitSeconds: Invalid value: 901"),
+		},
+		{
+			description: "flavour policy",
+			args:        &config.FlavourClusterWideArgs{FlavourPolicy: "kube-system/flavours"},
+		},
+		{
+			description: "flavour policy without namespace",
+			args:        &config.FlavourClusterWideArgs{FlavourPolicy: "flavours"},
+			expectedErr: fmt.Errorf("flavourPolicy: Invalid value: \"flavours\""),
+		},
+		{
+			description: "flavour priorities",
+			args:        &config.FlavourClusterWideArgs{FlavourPriorities: []string{"gold", "silver", "bronze"}},
+		},
+		{
+			description: "duplicate flavour priority",
+			args:        &config.FlavourClusterWideArgs{FlavourPriorities: []string{"gold", "silver", "gold"}},
+			expectedErr: fmt.Errorf("flavourPriorities[2]: Duplicate value: \"gold\""),
+		},
+		{
+			description: "empty flavour priority",
+			args:        &config.FlavourClusterWideArgs{FlavourPriorities: []string{"gold", ""}},
+			expectedErr: fmt.Errorf("flavourPriorities[1]: Required value"),
+		},
+		{
+			description: "negative scale-down window",
+			args:        &config.FlavourClusterWideArgs{ScaleDownWindowSeconds: -1},
+			expectedErr: fmt.Errorf("scaleDownWindowSeconds: Invalid value: -1"),
+		},
+		{
+			description: "negative recent placement window",
+			args:        &config.FlavourClusterWideArgs{RecentPlacementWindowSeconds: -1},
+			expectedErr: fmt.Errorf("recentPlacementWindowSeconds: Invalid value: -1"),
+		},
+		{
+			description: "recent placements weighing less than older ones",
+			args:        &config.FlavourClusterWideArgs{RecentPlacementWindowSeconds: 300, RecentPlacementWeightPercent: 50},
+			expectedErr: fmt.Errorf("recentPlacementWeightPercent: Invalid value: 50"),
+		},
+		{
+			description: "correct fairness shares",
+			args: &config.FlavourClusterWideArgs{
+				FairnessShares:        map[string]int32{"gold": 2, "silver": 1},
+				FairnessWindowSeconds: 600,
+				NodeGroupLabel:        "topology.kubernetes.io/zone",
+			},
+		},
+		{
+			description: "zero fairness share",
+			args:        &config.FlavourClusterWideArgs{FairnessShares: map[string]int32{"gold": 0}},
+			expectedErr: fmt.Errorf("fairnessShares[gold]: Invalid value: 0"),
+		},
+		{
+			description: "negative fairness window",
+			args:        &config.FlavourClusterWideArgs{FairnessWindowSeconds: -1},
+			expectedErr: fmt.Errorf("fairnessWindowSeconds: Invalid value: -1"),
+		},
+		{
+			description: "invalid node group label",
+			args:        &config.FlavourClusterWideArgs{NodeGroupLabel: "not a label"},
+			expectedErr: fmt.Errorf("nodeGroupLabel: Invalid value: \"not a label\""),
+		},
+		{
+			description: "negative overhead budget",
+			args:        &config.FlavourClusterWideArgs{OverheadBudgetMilliseconds: -1},
+			expectedErr: fmt.Errorf("overheadBudgetMilliseconds: Invalid value: -1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := ValidateFlavourClusterWideArgs(testCase.args, nil)
+			if testCase.expectedErr != nil {
+				if err == nil {
+					t.Fatalf("expected err to equal %v not nil", testCase.expectedErr)
+				}
+				if !strings.Contains(err.Error(), testCase.expectedErr.Error()) {
+					t.Fatalf("expected err to contain %s in error message: %s", testCase.expectedErr.Error(), err.Error())
+				}
+			}
+			if testCase.expectedErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
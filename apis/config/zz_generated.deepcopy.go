@@ -52,6 +52,232 @@ func (in *CoschedulingArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourAuditStore) DeepCopyInto(out *FlavourAuditStore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourAuditStore.
+func (in *FlavourAuditStore) DeepCopy() *FlavourAuditStore {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourAuditStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourClusterWideArgs) DeepCopyInto(out *FlavourClusterWideArgs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.LifecyclePreferences != nil {
+		in, out := &in.LifecyclePreferences, &out.LifecyclePreferences
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.FairnessShares != nil {
+		in, out := &in.FairnessShares, &out.FairnessShares
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeReadinessConditions != nil {
+		in, out := &in.NodeReadinessConditions, &out.NodeReadinessConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Weights = in.Weights
+	if in.RemoteCache != nil {
+		in, out := &in.RemoteCache, &out.RemoteCache
+		*out = new(FlavourRemoteCache)
+		**out = **in
+	}
+	if in.ExcludedPodPhases != nil {
+		in, out := &in.ExcludedPodPhases, &out.ExcludedPodPhases
+		*out = make([]v1.PodPhase, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelKeys != nil {
+		in, out := &in.LabelKeys, &out.LabelKeys
+		*out = make([]FlavourLabelKey, len(*in))
+		copy(*out, *in)
+	}
+	in.Namespaces.DeepCopyInto(&out.Namespaces)
+	if in.TopologyTiers != nil {
+		in, out := &in.TopologyTiers, &out.TopologyTiers
+		*out = make([]FlavourTopologyTier, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetRatios != nil {
+		in, out := &in.TargetRatios, &out.TargetRatios
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MaxPodsPerTopologyDomain != nil {
+		in, out := &in.MaxPodsPerTopologyDomain, &out.MaxPodsPerTopologyDomain
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MetricsFlavours != nil {
+		in, out := &in.MetricsFlavours, &out.MetricsFlavours
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SidecarContainers != nil {
+		in, out := &in.SidecarContainers, &out.SidecarContainers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeUpdateAnnotations != nil {
+		in, out := &in.NodeUpdateAnnotations, &out.NodeUpdateAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeUpdateConditions != nil {
+		in, out := &in.NodeUpdateConditions, &out.NodeUpdateConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuditStore != nil {
+		in, out := &in.AuditStore, &out.AuditStore
+		*out = new(FlavourAuditStore)
+		**out = **in
+	}
+	if in.FlavourPriorities != nil {
+		in, out := &in.FlavourPriorities, &out.FlavourPriorities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourClusterWideArgs.
+func (in *FlavourClusterWideArgs) DeepCopy() *FlavourClusterWideArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourClusterWideArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlavourClusterWideArgs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourLabelKey) DeepCopyInto(out *FlavourLabelKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourLabelKey.
+func (in *FlavourLabelKey) DeepCopy() *FlavourLabelKey {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourLabelKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourNamespaces) DeepCopyInto(out *FlavourNamespaces) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourNamespaces.
+func (in *FlavourNamespaces) DeepCopy() *FlavourNamespaces {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourNamespaces)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourRemoteCache) DeepCopyInto(out *FlavourRemoteCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourRemoteCache.
+func (in *FlavourRemoteCache) DeepCopy() *FlavourRemoteCache {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourRemoteCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourScoreWeights) DeepCopyInto(out *FlavourScoreWeights) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourScoreWeights.
+func (in *FlavourScoreWeights) DeepCopy() *FlavourScoreWeights {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourScoreWeights)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavourTopologyTier) DeepCopyInto(out *FlavourTopologyTier) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourTopologyTier.
+func (in *FlavourTopologyTier) DeepCopy() *FlavourTopologyTier {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavourTopologyTier)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadVariationRiskBalancingArgs) DeepCopyInto(out *LoadVariationRiskBalancingArgs) {
 	*out = *in
@@ -452,28 +678,3 @@ func (in *TrimaranSpec) DeepCopy() *TrimaranSpec {
 	in.DeepCopyInto(out)
 	return out
 }
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FlavourClusterWideArgs) DeepCopyInto(out *FlavourClusterWideArgs) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavourClusterWideArgs.
-func (in *FlavourClusterWideArgs) DeepCopy() *FlavourClusterWideArgs {
-	if in == nil {
-		return nil
-	}
-	out := new(FlavourClusterWideArgs)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *FlavourClusterWideArgs) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
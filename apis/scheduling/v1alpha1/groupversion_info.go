@@ -53,6 +53,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ElasticQuotaList{},
 		&PodGroup{},
 		&PodGroupList{},
+		&FlavourQuota{},
+		&FlavourQuotaList{},
+		&FlavourPolicy{},
+		&FlavourPolicyList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	v1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -196,3 +196,135 @@ type PodGroupList struct {
 	// Items is the list of PodGroup
 	Items []PodGroup `json:"items"`
 }
+
+// FlavourDoNotEvictAnnotation, set to "true", protects a pod from ever being moved for its flavour, for
+// critical singleton pods that must stay in place even if they worsen the skew of their flavour: the
+// flavour rebalance controller never asks it to move, and the FlavourClusterWide plugin never preempts it.
+const FlavourDoNotEvictAnnotation = "flavour." + scheduling.GroupName + "/do-not-evict"
+
+// FlavourQuota limits the pods of a flavour, the pods sharing a value of the flavour label of the
+// FlavourClusterWide plugin, across the whole cluster. The plugin holds the pods exceeding the quota of
+// their flavour in its Permit extension point, and rejects them if the quota does not free up in time.
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName={fq,fqs}
+// +kubebuilder:metadata:annotations="api-approved.kubernetes.io=unapproved, experimental-only"
+// +kubebuilder:printcolumn:name="Flavour",JSONPath=".spec.flavour",type=string,description="Flavour is the value of the flavour label of the pods the quota applies to."
+// +kubebuilder:printcolumn:name="MaxPods",JSONPath=".spec.maxPods",type=integer,description="MaxPods is the number of pods of the flavour that can run in the cluster."
+// +kubebuilder:printcolumn:name="Max",JSONPath=".spec.max",type=string,description="Max is the total of the resources the pods of the flavour can request in the cluster."
+// +kubebuilder:printcolumn:name="Age",JSONPath=".metadata.creationTimestamp",type=date,description="Age is the time FlavourQuota was created."
+type FlavourQuota struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// FlavourQuotaSpec defines the flavour and its limits.
+	// +optional
+	Spec FlavourQuotaSpec `json:"spec,omitempty"`
+}
+
+// FlavourQuotaSpec defines the flavour and its limits. Several quotas of the same flavour all apply.
+type FlavourQuotaSpec struct {
+	// Flavour is the value of the flavour label of the pods the quota applies to.
+	// +kubebuilder:validation:MinLength=1
+	Flavour string `json:"flavour"`
+
+	// MaxPods is the number of pods of the flavour that can run in the cluster.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty"`
+
+	// Max is the total of the resources the pods of the flavour can request in the cluster, for each
+	// named resource. The requests are the ones of the scheduled pods that are not terminated.
+	// +optional
+	Max v1.ResourceList `json:"max,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FlavourQuotaList is a list of FlavourQuota items.
+type FlavourQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of FlavourQuota objects.
+	Items []FlavourQuota `json:"items"`
+}
+
+// FlavourPolicy retunes the flavour balancing of the FlavourClusterWide plugin at runtime, without a
+// restart of the scheduler. The plugin watches the policy named by its flavourPolicy argument: while the
+// policy exists, its fields replace the target ratios, caps and topology keys of the arguments, and the
+// fields it does not set disable them. The arguments apply again once the policy is deleted.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName={fp,fps}
+// +kubebuilder:metadata:annotations="api-approved.kubernetes.io=unapproved, experimental-only"
+// +kubebuilder:printcolumn:name="LabelName",JSONPath=".spec.labelName",type=string,description="LabelName is the label key whose values are the flavours."
+// +kubebuilder:printcolumn:name="TopologyKey",JSONPath=".spec.topologyKey",type=string,description="TopologyKey is the node label key the flavours are balanced across."
+// +kubebuilder:printcolumn:name="Age",JSONPath=".metadata.creationTimestamp",type=date,description="Age is the time FlavourPolicy was created."
+type FlavourPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// FlavourPolicySpec defines the tuning of the flavour balancing.
+	// +optional
+	Spec FlavourPolicySpec `json:"spec,omitempty"`
+}
+
+// FlavourPolicySpec defines the tuning of the flavour balancing, as the arguments of the same names.
+type FlavourPolicySpec struct {
+	// LabelName is the label key whose values are the flavours. Changing it rebuilds the cache of the
+	// plugin on the new label. Defaults to the labelName of the plugin.
+	// +optional
+	LabelName string `json:"labelName,omitempty"`
+
+	// TargetRatios are the target proportions of the listed flavours in the pods of a node.
+	// +optional
+	TargetRatios map[string]int32 `json:"targetRatios,omitempty"`
+
+	// MaxPodsPerFlavourPerNode is the number of pods of a flavour a node can host, 0 when unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxPodsPerFlavourPerNode int32 `json:"maxPodsPerFlavourPerNode,omitempty"`
+
+	// MaxPodsPerTopologyDomain is the number of pods of a flavour a topology domain of CapTopologyKey can
+	// host, per flavour.
+	// +optional
+	MaxPodsPerTopologyDomain map[string]int32 `json:"maxPodsPerTopologyDomain,omitempty"`
+
+	// CapTopologyKey is the node label key whose values are the topology domains of
+	// MaxPodsPerTopologyDomain. Defaults to topology.kubernetes.io/zone.
+	// +optional
+	CapTopologyKey string `json:"capTopologyKey,omitempty"`
+
+	// TopologyKey is the node label key the flavours are balanced across instead of the nodes.
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FlavourPolicyList is a list of FlavourPolicy items.
+type FlavourPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of FlavourPolicy objects.
+	Items []FlavourPolicy `json:"items"`
+}
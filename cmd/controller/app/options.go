@@ -17,7 +17,11 @@ limitations under the License.
 package app
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
 )
 
 type ServerRunOptions struct {
@@ -27,6 +31,20 @@ type ServerRunOptions struct {
 	ApiServerBurst       int
 	Workers              int
 	EnableLeaderElection bool
+
+	EnableFlavourRebalance      bool
+	FlavourLabelName            string
+	FlavourNodeSelector         string
+	FlavourSkewTolerance        int
+	FlavourRebalanceGracePeriod time.Duration
+	FlavourAttractionPeriod     time.Duration
+	FlavourWriteQPS             float32
+	FlavourWriteBurst           int
+
+	EnableFlavourStarvation    bool
+	FlavourStarvationThreshold time.Duration
+	FlavourMetricsLabelCap     int32
+	FlavourMetricsFlavours     []string
 }
 
 func NewServerRunOptions() *ServerRunOptions {
@@ -42,4 +60,16 @@ func (s *ServerRunOptions) addAllFlags() {
 	pflag.IntVar(&s.ApiServerBurst, "burst", 10, "burst of query apiserver.")
 	pflag.IntVar(&s.Workers, "workers", 1, "workers of scheduler-plugin-controllers.")
 	pflag.BoolVar(&s.EnableLeaderElection, "enableLeaderElection", s.EnableLeaderElection, "If EnableLeaderElection for controller.")
+	pflag.BoolVar(&s.EnableFlavourRebalance, "enableFlavourRebalance", false, "If enable the controller annotating pods to move when a flavour stays skewed.")
+	pflag.StringVar(&s.FlavourLabelName, "flavourLabelName", "flavour", "Pod label holding the flavour, as configured for FlavourClusterWide.")
+	pflag.StringVar(&s.FlavourNodeSelector, "flavourNodeSelector", "node-role.kubernetes.io/worker", "Label selector of the nodes the flavours are balanced across, as configured for FlavourClusterWide. Empty selects every schedulable node.")
+	pflag.IntVar(&s.FlavourSkewTolerance, "flavourSkewTolerance", 1, "Tolerated difference of flavour pods between the most and least loaded nodes.")
+	pflag.DurationVar(&s.FlavourRebalanceGracePeriod, "flavourRebalanceGracePeriod", 10*time.Minute, "How long a flavour must stay skewed before pods are asked to move.")
+	pflag.DurationVar(&s.FlavourAttractionPeriod, "flavourAttractionPeriod", 0, "How long a node must stay the most loaded node of a skewed flavour before a penalty or cordon is suggested, 0 disables it.")
+	pflag.Float32Var(&s.FlavourWriteQPS, "flavourWriteQPS", 5, "qps of the annotation writes of the flavour rebalance controller, 0 disables the limit.")
+	pflag.IntVar(&s.FlavourWriteBurst, "flavourWriteBurst", 10, "burst of the annotation writes of the flavour rebalance controller.")
+	pflag.BoolVar(&s.EnableFlavourStarvation, "enableFlavourStarvation", false, "If enable the controller detecting flavours whose pods stay pending while other flavours schedule.")
+	pflag.DurationVar(&s.FlavourStarvationThreshold, "flavourStarvationThreshold", 5*time.Minute, "How long pods of a flavour must stay pending while other flavours schedule for the flavour to be starved.")
+	pflag.Int32Var(&s.FlavourMetricsLabelCap, "flavourMetricsLabelCap", cfgv1.DefaultMetricsLabelCap, "Number of flavours reported under their own name in the flavour label of the metrics, the others as \"other\", as configured for FlavourClusterWide.")
+	pflag.StringSliceVar(&s.FlavourMetricsFlavours, "flavourMetricsFlavours", nil, "Flavours reported under their own name in the flavour label of the metrics instead of the first flavourMetricsLabelCap ones, as configured for FlavourClusterWide.")
 }
@@ -80,6 +80,42 @@ func Run(s *ServerRunOptions) error {
 		return err
 	}
 
+	if s.EnableFlavourRebalance {
+		if !s.EnableLeaderElection {
+			setupLog.Info("flavour rebalancing is enabled without leader election, run a single replica of the controller to avoid conflicting annotation writes")
+		}
+		if err = (&controllers.FlavourRebalanceReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			Workers:          s.Workers,
+			LabelName:        s.FlavourLabelName,
+			NodeSelector:     s.FlavourNodeSelector,
+			SkewTolerance:    s.FlavourSkewTolerance,
+			GracePeriod:      s.FlavourRebalanceGracePeriod,
+			AttractionPeriod: s.FlavourAttractionPeriod,
+			WriteQPS:         s.FlavourWriteQPS,
+			WriteBurst:       s.FlavourWriteBurst,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FlavourRebalance")
+			return err
+		}
+	}
+
+	if s.EnableFlavourStarvation {
+		if err = (&controllers.FlavourStarvationReconciler{
+			Client:          mgr.GetClient(),
+			Scheme:          mgr.GetScheme(),
+			Workers:         s.Workers,
+			LabelName:       s.FlavourLabelName,
+			Threshold:       s.FlavourStarvationThreshold,
+			MetricsLabelCap: s.FlavourMetricsLabelCap,
+			MetricsFlavours: s.FlavourMetricsFlavours,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FlavourStarvation")
+			return err
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		return err
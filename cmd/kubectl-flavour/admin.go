@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	v1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+)
+
+const adminUsage = `Usage: kubectl flavour admin <operation> --address <host:port> --token-file <file> [flags]
+
+Operations:
+  refresh   Rebuild the plugin cache now, whatever its TTL
+  pause     Pause the scoring of --flavour
+  resume    Resume the scoring of --flavour
+  cap       Cap --flavour to --max-per-node pods per node for --duration, or remove the cap with --duration 0
+  dump      Print the plugin cache and the overrides in effect as JSON
+
+When several plugin instances share the address, with several profiles or instances under other names,
+select one with --profile and --plugin, and use its token.
+
+Without --ca-file, the admin service is called in plain text, which it only serves on a loopback
+address: reach it through kubectl port-forward. With --ca-file, it is called over TLS.
+`
+
+func runAdmin(args []string) error {
+	if len(args) < 1 || strings.HasPrefix(args[0], "-") {
+		return fmt.Errorf("missing operation\n\n%s", adminUsage)
+	}
+	operation := args[0]
+	fs := pflag.NewFlagSet("admin", pflag.ContinueOnError)
+	address := fs.String("address", "127.0.0.1:10270", "Address of the plugin admin service, as set in its adminAddress argument.")
+	tokenFile := fs.String("token-file", "", "File holding the bearer token of the admin service.")
+	caFile := fs.String("ca-file", "", "File holding the PEM certificates of the authorities verifying the admin service served over TLS.")
+	serverName := fs.String("server-name", "", "Name the certificate of the admin service is verified against, the host of --address by default.")
+	profile := fs.String("profile", "", "Scheduler profile of the plugin instance, when several instances share the admin service.")
+	plugin := fs.String("plugin", "", "Name of the plugin instance, when several instances share the admin service.")
+	flavour := fs.String("flavour", "", "Flavour to pause, resume or cap.")
+	maxPerNode := fs.Int("max-per-node", 0, "Pods of the flavour per node at which nodes score 0, for cap.")
+	duration := fs.Duration("duration", time.Hour, "How long the cap is in effect, 0 removes it, for cap.")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *tokenFile == "" {
+		return fmt.Errorf("--token-file is required")
+	}
+	token, err := os.ReadFile(*tokenFile)
+	if err != nil {
+		return fmt.Errorf("error reading admin token: %v", err)
+	}
+
+	creds := insecure.NewCredentials()
+	if *caFile != "" {
+		pem, err := os.ReadFile(*caFile)
+		if err != nil {
+			return fmt.Errorf("error reading the admin CA: %v", err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no PEM certificates in %s", *caFile)
+		}
+		creds = credentials.NewTLS(&tls.Config{RootCAs: roots, ServerName: *serverName, MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(*address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return fmt.Errorf("error connecting to %s: %v", *address, err)
+	}
+	defer conn.Close()
+	client := flavourclusterwide.NewAdminClient(conn, strings.TrimSpace(string(token)))
+	if *profile != "" || *plugin != "" {
+		if *profile == "" {
+			*profile = v1.DefaultSchedulerName
+		}
+		if *plugin == "" {
+			*plugin = flavourclusterwide.Name
+		}
+		client = client.ForPlugin(*profile, *plugin)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	switch operation {
+	case "refresh":
+		nodes, err := client.Refresh(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Cache refreshed: %d nodes\n", nodes)
+	case "pause":
+		if err := client.PauseFlavour(ctx, *flavour); err != nil {
+			return err
+		}
+		fmt.Printf("Scoring of flavour %s paused\n", *flavour)
+	case "resume":
+		if err := client.ResumeFlavour(ctx, *flavour); err != nil {
+			return err
+		}
+		fmt.Printf("Scoring of flavour %s resumed\n", *flavour)
+	case "cap":
+		if err := client.SetCapOverride(ctx, *flavour, *maxPerNode, *duration); err != nil {
+			return err
+		}
+		if *duration == 0 {
+			fmt.Printf("Cap of flavour %s removed\n", *flavour)
+		} else {
+			fmt.Printf("Flavour %s capped to %d pods per node for %v\n", *flavour, *maxPerNode, *duration)
+		}
+	case "dump":
+		snapshot, err := client.DumpSnapshot(ctx)
+		if err != nil {
+			return err
+		}
+		out, err := json.MarshalIndent(snapshot, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	default:
+		return fmt.Errorf("unknown operation %q\n\n%s", operation, adminUsage)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+)
+
+// exportHeader is the header row of the exported snapshots.
+var exportHeader = []string{"timestamp", "node", "flavour", "count"}
+
+func runExport(args []string) error {
+	fs := pflag.NewFlagSet("export", pflag.ContinueOnError)
+	kubeconfig := fs.String("kubeconfig", "", "Path to the kubeconfig file. Defaults to the standard kubectl loading rules.")
+	labelName := fs.String("label-name", cfgv1.DefaultLabelName, "Label key identifying pod flavours; must match the plugin labelName argument.")
+	nodeSelector := fs.String("node-selector", cfgv1.DefaultNodeLabelSelector, "Label selector of the nodes the flavours are balanced across; must match the plugin nodeLabelSelector argument.")
+	outputDir := fs.String("output-dir", "", "Directory receiving one CSV file per snapshot, such as a mounted volume. Defaults to the standard output.")
+	interval := fs.Duration("interval", 0, "Interval between snapshots. Defaults to 0, which exports a single snapshot.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *interval < 0 {
+		return fmt.Errorf("--interval must be greater than or equal to 0")
+	}
+
+	client, err := newClient(*kubeconfig)
+	if err != nil {
+		return err
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	for {
+		if err := exportSnapshot(ctx, client, *labelName, *nodeSelector, *outputDir, time.Now().UTC()); err != nil {
+			// A failed snapshot is retried on the next tick when exporting periodically.
+			if *interval == 0 {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+		}
+		if *interval == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(*interval):
+		}
+	}
+}
+
+// exportSnapshot lists the per-node flavour counts of the nodes matching nodeSelector and writes them to
+// the standard output, or to a new file of outputDir named after the time of the snapshot.
+func exportSnapshot(ctx context.Context, client kubernetes.Interface, labelName, nodeSelector, outputDir string, at time.Time) error {
+	snapshot, err := flavourclusterwide.ListNodeSnapshot(ctx, client, labelName, nodeSelector)
+	if err != nil {
+		return err
+	}
+	if outputDir == "" {
+		return writeSnapshotCSV(os.Stdout, snapshot, at)
+	}
+
+	// The file is written under a temporary name and renamed once complete, so that the tools shipping
+	// the directory to a bucket never pick up a partial snapshot.
+	name := filepath.Join(outputDir, fmt.Sprintf("flavour-snapshot-%s.csv", at.Format("20060102T150405Z")))
+	tmp, err := os.CreateTemp(outputDir, ".flavour-snapshot-*.csv")
+	if err != nil {
+		return fmt.Errorf("error creating snapshot file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := writeSnapshotCSV(tmp, snapshot, at); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing snapshot file: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return fmt.Errorf("error renaming snapshot file: %v", err)
+	}
+	return nil
+}
+
+// writeSnapshotCSV writes one row per node and flavour of snapshot, sorted by node and flavour, every
+// row carrying the time of the snapshot so that the files can be loaded into a single table.
+func writeSnapshotCSV(out io.Writer, snapshot map[string]map[string]int, at time.Time) error {
+	w := csv.NewWriter(out)
+	if err := w.Write(exportHeader); err != nil {
+		return fmt.Errorf("error writing snapshot: %v", err)
+	}
+	nodes := make([]string, 0, len(snapshot))
+	for node := range snapshot {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	timestamp := at.Format(time.RFC3339)
+	for _, node := range nodes {
+		for _, flavour := range sortedFlavours(snapshot[node]) {
+			row := []string{timestamp, node, flavour, strconv.Itoa(snapshot[node][flavour])}
+			if err := w.Write(row); err != nil {
+				return fmt.Errorf("error writing snapshot: %v", err)
+			}
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("error writing snapshot: %v", err)
+	}
+	return nil
+}
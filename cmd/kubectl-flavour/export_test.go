@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+)
+
+func TestWriteSnapshotCSV(t *testing.T) {
+	snapshot := map[string]map[string]int{
+		"node2": {"silver": 1, "gold": 0},
+		"node1": {"gold": 2},
+	}
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	var out bytes.Buffer
+	if err := writeSnapshotCSV(&out, snapshot, at); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "timestamp,node,flavour,count\n" +
+		"2026-03-01T12:00:00Z,node1,gold,2\n" +
+		"2026-03-01T12:00:00Z,node2,gold,0\n" +
+		"2026-03-01T12:00:00Z,node2,silver,1\n"
+	if diff := cmp.Diff(want, out.String()); diff != "" {
+		t.Errorf("unexpected CSV (-want,+got):\n%s", diff)
+	}
+}
+
+func TestExportSnapshot(t *testing.T) {
+	client := clientsetfake.NewSimpleClientset(
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{flavourclusterwide.WorkerNodeLabelSelector: ""}}},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1", Labels: map[string]string{"flavour": "gold"}},
+			Spec:       v1.PodSpec{NodeName: "node1"},
+		},
+	)
+	dir := t.TempDir()
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := exportSnapshot(context.Background(), client, "flavour", flavourclusterwide.WorkerNodeLabelSelector, dir, at); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "flavour-snapshot-20260301T120000Z.csv" {
+		t.Fatalf("expected a single snapshot file, got %v", entries)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "timestamp,node,flavour,count\n2026-03-01T12:00:00Z,node1,gold,1\n"
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("unexpected snapshot file (-want,+got):\n%s", diff)
+	}
+}
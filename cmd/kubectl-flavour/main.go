@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// kubectl-flavour is a kubectl plugin (invoked as `kubectl flavour`) that reports how the
+// FlavourClusterWide plugin sees the cluster.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `Usage: kubectl flavour <command> [flags]
+
+Commands:
+  nodes    Classify worker nodes by capacity class, flavour mix, headroom and balance
+  admin    Refresh, pause, resume, cap or dump a running plugin through its admin service
+  export   Export the per-node flavour counts as CSV, once or periodically
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(1)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "nodes":
+		err = runNodes(os.Args[2:])
+	case "admin":
+		err = runAdmin(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	case "-h", "--help", "help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		err = fmt.Errorf("unknown command %q\n\n%s", os.Args[1], usage)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
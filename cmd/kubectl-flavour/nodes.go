@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/pflag"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+type nodeStatus string
+
+const (
+	// statusBalanced means every flavour on the node is within the skew tolerance of the cluster minimum.
+	statusBalanced nodeStatus = "Balanced"
+	// statusSkewed means at least one flavour exceeds the cluster minimum by more than the skew tolerance.
+	statusSkewed nodeStatus = "Skewed"
+	// statusFull means the node has no CPU or memory headroom left.
+	statusFull nodeStatus = "Full"
+)
+
+func (s nodeStatus) color() string {
+	switch s {
+	case statusBalanced:
+		return colorGreen
+	case statusSkewed:
+		return colorYellow
+	default:
+		return colorRed
+	}
+}
+
+// nodeReport is the classification of a single worker node.
+type nodeReport struct {
+	name        string
+	class       string
+	flavours    map[string]int
+	cpuHeadroom resource.Quantity
+	cpuPercent  int64
+	memHeadroom resource.Quantity
+	memPercent  int64
+	status      nodeStatus
+	detail      string
+}
+
+func runNodes(args []string) error {
+	fs := pflag.NewFlagSet("nodes", pflag.ContinueOnError)
+	kubeconfig := fs.String("kubeconfig", "", "Path to the kubeconfig file. Defaults to the standard kubectl loading rules.")
+	labelName := fs.String("label-name", cfgv1.DefaultLabelName, "Label key identifying pod flavours; must match the plugin labelName argument.")
+	nodeSelector := fs.String("node-selector", cfgv1.DefaultNodeLabelSelector, "Label selector of the nodes the flavours are balanced across; must match the plugin nodeLabelSelector argument.")
+	tolerance := fs.Int("skew-tolerance", 1, "Number of pods a node may exceed the cluster minimum of a flavour by before it is reported as skewed.")
+	noColor := fs.Bool("no-color", false, "Disable colored output.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, err := newClient(*kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	snapshot, err := flavourclusterwide.ListNodeSnapshot(ctx, client, *labelName, *nodeSelector)
+	if err != nil {
+		return err
+	}
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: *nodeSelector,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing nodes: %v", err)
+	}
+	pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return fmt.Errorf("error listing pods: %v", err)
+	}
+
+	reports := buildNodeReports(nodes.Items, pods.Items, snapshot, *tolerance)
+	printNodeReports(os.Stdout, reports, !*noColor)
+	return nil
+}
+
+// newClient returns a client for the kubeconfig, or for the standard kubectl loading rules when empty.
+func newClient(kubeconfig string) (kubernetes.Interface, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error loading kubeconfig: %v", err)
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kubernetes client: %v", err)
+	}
+	return client, nil
+}
+
+// buildNodeReports classifies the given nodes. Flavour counts come from snapshot, while headroom
+// is computed from the requests of all non-terminal pods bound to each node.
+func buildNodeReports(nodes []v1.Node, pods []v1.Pod, snapshot map[string]map[string]int, tolerance int) []nodeReport {
+	requested := make(map[string]v1.ResourceList)
+	for i := range pods {
+		nodeName := pods[i].Spec.NodeName
+		if nodeName == "" {
+			continue
+		}
+		if _, ok := requested[nodeName]; !ok {
+			requested[nodeName] = v1.ResourceList{}
+		}
+		for name, quantity := range util.GetPodEffectiveRequest(&pods[i]) {
+			sum := requested[nodeName][name]
+			sum.Add(quantity)
+			requested[nodeName][name] = sum
+		}
+	}
+
+	minPerFlavour := make(map[string]int)
+	for _, node := range nodes {
+		for flavour, count := range snapshot[node.Name] {
+			if min, ok := minPerFlavour[flavour]; !ok || count < min {
+				minPerFlavour[flavour] = count
+			}
+		}
+	}
+
+	reports := make([]nodeReport, 0, len(nodes))
+	for _, node := range nodes {
+		r := nodeReport{
+			name:     node.Name,
+			class:    flavourclusterwide.CapacityClass(&node),
+			flavours: snapshot[node.Name],
+		}
+		r.cpuHeadroom, r.cpuPercent = headroom(node.Status.Allocatable, requested[node.Name], v1.ResourceCPU)
+		r.memHeadroom, r.memPercent = headroom(node.Status.Allocatable, requested[node.Name], v1.ResourceMemory)
+
+		var skewed []string
+		for _, flavour := range sortedFlavours(r.flavours) {
+			if excess := r.flavours[flavour] - minPerFlavour[flavour]; excess > tolerance {
+				skewed = append(skewed, fmt.Sprintf("%s+%d", flavour, excess))
+			}
+		}
+		switch {
+		case r.cpuHeadroom.Sign() <= 0 || r.memHeadroom.Sign() <= 0:
+			r.status = statusFull
+		case len(skewed) > 0:
+			r.status = statusSkewed
+			r.detail = strings.Join(skewed, ",")
+		default:
+			r.status = statusBalanced
+		}
+		reports = append(reports, r)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].name < reports[j].name })
+	return reports
+}
+
+// headroom returns the unrequested amount of a resource and its percentage of the allocatable amount.
+func headroom(allocatable, requested v1.ResourceList, name v1.ResourceName) (resource.Quantity, int64) {
+	free := allocatable[name].DeepCopy()
+	if req, ok := requested[name]; ok {
+		free.Sub(req)
+	}
+	total := allocatable[name]
+	if total.IsZero() {
+		return free, 0
+	}
+	return free, free.MilliValue() * 100 / total.MilliValue()
+}
+
+func sortedFlavours(counts map[string]int) []string {
+	flavours := make([]string, 0, len(counts))
+	for flavour := range counts {
+		flavours = append(flavours, flavour)
+	}
+	sort.Strings(flavours)
+	return flavours
+}
+
+func printNodeReports(out io.Writer, reports []nodeReport, color bool) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tCLASS\tFLAVOURS\tCPU HEADROOM\tMEMORY HEADROOM\tSTATUS")
+	for _, r := range reports {
+		var mix []string
+		for _, flavour := range sortedFlavours(r.flavours) {
+			mix = append(mix, fmt.Sprintf("%s=%d", flavour, r.flavours[flavour]))
+		}
+		if len(mix) == 0 {
+			mix = append(mix, "<none>")
+		}
+		status := string(r.status)
+		if r.detail != "" {
+			status = fmt.Sprintf("%s (%s)", status, r.detail)
+		}
+		// The status column is last so that color escapes don't break the column alignment.
+		if color {
+			status = r.status.color() + status + colorReset
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s (%d%%)\t%s (%d%%)\t%s\n",
+			r.name, r.class, strings.Join(mix, ","),
+			r.cpuHeadroom.String(), r.cpuPercent, r.memHeadroom.String(), r.memPercent, status)
+	}
+	w.Flush()
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func makeNode(name, cpu, memory string, labels map[string]string) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse(cpu),
+				v1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+}
+
+func makePod(nodeName, cpu, memory string) v1.Pod {
+	return v1.Pod{
+		Spec: v1.PodSpec{
+			NodeName: nodeName,
+			Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse(cpu),
+						v1.ResourceMemory: resource.MustParse(memory),
+					},
+				},
+			}},
+		},
+	}
+}
+
+func TestBuildNodeReports(t *testing.T) {
+	nodes := []v1.Node{
+		makeNode("node1", "4", "8Gi", map[string]string{v1.LabelInstanceTypeStable: "m5.xlarge"}),
+		makeNode("node2", "4", "8Gi", nil),
+		makeNode("node3", "2", "4Gi", nil),
+	}
+	pods := []v1.Pod{
+		makePod("node1", "1", "2Gi"),
+		makePod("node2", "3", "2Gi"),
+		makePod("node3", "2", "1Gi"),
+	}
+	snapshot := map[string]map[string]int{
+		"node1": {"gold": 1, "silver": 0},
+		"node2": {"gold": 4, "silver": 1},
+		"node3": {"gold": 0, "silver": 0},
+	}
+
+	reports := buildNodeReports(nodes, pods, snapshot, 1)
+	if len(reports) != 3 {
+		t.Fatalf("expected 3 reports, got %d", len(reports))
+	}
+
+	tests := []struct {
+		name       string
+		class      string
+		status     nodeStatus
+		detail     string
+		cpuPercent int64
+	}{
+		{name: "node1", class: "m5.xlarge", status: statusBalanced, cpuPercent: 75},
+		{name: "node2", class: "4cpu-8Gi", status: statusSkewed, detail: "gold+4", cpuPercent: 25},
+		{name: "node3", class: "2cpu-4Gi", status: statusFull, cpuPercent: 0},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := reports[i]
+			if r.name != tt.name {
+				t.Fatalf("expected node %s, got %s", tt.name, r.name)
+			}
+			if r.class != tt.class {
+				t.Errorf("expected class %s, got %s", tt.class, r.class)
+			}
+			if r.status != tt.status || r.detail != tt.detail {
+				t.Errorf("expected status %s (%s), got %s (%s)", tt.status, tt.detail, r.status, r.detail)
+			}
+			if r.cpuPercent != tt.cpuPercent {
+				t.Errorf("expected %d%% CPU headroom, got %d%%", tt.cpuPercent, r.cpuPercent)
+			}
+		})
+	}
+
+	var out bytes.Buffer
+	printNodeReports(&out, reports, false)
+	if !strings.Contains(out.String(), "gold=4,silver=1") || strings.Contains(out.String(), colorReset) {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
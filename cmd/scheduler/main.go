@@ -19,6 +19,7 @@ package main
 import (
 	"os"
 
+	_ "github.com/jackc/pgx/v5/stdlib" // for the "pgx" driver of the PostgreSQL audit store
 	"k8s.io/component-base/cli"
 	_ "k8s.io/component-base/metrics/prometheus/clientgo" // for rest client metric registration
 	_ "k8s.io/component-base/metrics/prometheus/version"  // for version metric registration
@@ -45,6 +46,10 @@ import (
 )
 
 func main() {
+	if code, ok := runOfflineMode(os.Args[1:], os.Stdout, os.Stderr); ok {
+		os.Exit(code)
+	}
+
 	// Register custom plugins to the scheduler framework.
 	// Later they can consist of scheduler profile(s) and hence
 	// used by various kinds of workloads.
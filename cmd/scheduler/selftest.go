@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+)
+
+const (
+	selfTestFlag     = "--self-test"
+	selfTestExitCode = 1
+)
+
+// selfTestConfig is the configuration the self-test decodes when no file is given: a profile enabling
+// FlavourClusterWide with its default args.
+var selfTestConfig = []byte(`apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  plugins:
+    multiPoint:
+      enabled:
+      - name: FlavourClusterWide
+  pluginConfig:
+  - name: FlavourClusterWide
+    args: {}
+`)
+
+// selfTestFlagValue returns the configuration file of "--self-test=file", or "" for a bare
+// "--self-test", which unlike the other offline flags takes no separate value, so that it can be
+// followed by the usual scheduler flags.
+func selfTestFlagValue(args []string) (string, bool) {
+	for _, arg := range args {
+		if arg == selfTestFlag {
+			return "", true
+		}
+		if value, ok := strings.CutPrefix(arg, selfTestFlag+"="); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// runSelfTest decodes and validates the configuration file at path, or selfTestConfig when empty, and
+// runs flavourclusterwide.SelfTest with the args of every FlavourClusterWide plugin config, against an
+// in-memory fake cluster. It reports every step on stdout, and the failed ones with their diagnostics on
+// stderr, and returns selfTestExitCode when a step failed. It never contacts a cluster, so it can run as
+// an init container or a pre-flight check of a deployment pipeline.
+func runSelfTest(path string, stdout, stderr io.Writer) int {
+	data, source := selfTestConfig, "the built-in configuration"
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			fmt.Fprintf(stderr, "decode config: FAILED: %v\n", err)
+			return selfTestExitCode
+		}
+		source = path
+	}
+	cfg, err := decodeConfig(data, source)
+	if err != nil {
+		fmt.Fprintf(stderr, "decode config: FAILED: %v\n", err)
+		return selfTestExitCode
+	}
+	fmt.Fprintf(stdout, "decode config: ok (%s)\n", source)
+	if err := validateConfig(cfg); err != nil {
+		fmt.Fprintf(stderr, "validate config: FAILED:\n%v\n", err)
+		return selfTestExitCode
+	}
+	fmt.Fprintln(stdout, "validate config: ok")
+
+	code, tested := 0, 0
+	for _, profile := range cfg.Profiles {
+		for _, pluginConfig := range profile.PluginConfig {
+			args, ok := pluginConfig.Args.(*config.FlavourClusterWideArgs)
+			if !ok {
+				continue
+			}
+			tested++
+			step := fmt.Sprintf("profile %s, plugin %s", profile.SchedulerName, pluginConfig.Name)
+			if err := flavourclusterwide.SelfTest(context.Background(), args); err != nil {
+				fmt.Fprintf(stderr, "%s: FAILED: %v\n", step, err)
+				code = selfTestExitCode
+				continue
+			}
+			fmt.Fprintf(stdout, "%s: ok\n", step)
+		}
+	}
+	if tested == 0 {
+		fmt.Fprintf(stderr, "self-test: FAILED: no %s args in %s\n", flavourclusterwide.Name, source)
+		return selfTestExitCode
+	}
+	return code
+}
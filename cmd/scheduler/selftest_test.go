@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunSelfTest(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig := func(name, data string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return path
+	}
+	twoProfiles := writeConfig("two-profiles.yaml", `apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  plugins:
+    multiPoint:
+      enabled:
+      - name: FlavourClusterWide
+  pluginConfig:
+  - name: FlavourClusterWide
+    args: {}
+- schedulerName: spread-scheduler
+  plugins:
+    multiPoint:
+      enabled:
+      - name: FlavourClusterWide
+  pluginConfig:
+  - name: FlavourClusterWide
+    args: {scoringStrategy: Spread}
+`)
+	invalid := writeConfig("invalid.yaml", `apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  pluginConfig:
+  - name: FlavourClusterWide
+    args: {cacheTTLSeconds: -1}
+`)
+	withoutPlugin := writeConfig("without-plugin.yaml", `apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+`)
+
+	cases := []struct {
+		name       string
+		path       string
+		wantCode   int
+		wantStdout []string
+		wantStderr []string
+	}{
+		{
+			name:       "built-in configuration",
+			wantStdout: []string{"decode config: ok (the built-in configuration)", "validate config: ok", "profile default-scheduler, plugin FlavourClusterWide: ok"},
+		},
+		{
+			name:       "every profile passes",
+			path:       twoProfiles,
+			wantStdout: []string{"decode config: ok (" + twoProfiles + ")", "profile default-scheduler, plugin FlavourClusterWide: ok", "profile spread-scheduler, plugin FlavourClusterWide: ok"},
+		},
+		{
+			name:       "invalid args",
+			path:       invalid,
+			wantCode:   selfTestExitCode,
+			wantStdout: []string{"decode config: ok"},
+			wantStderr: []string{"validate config: FAILED", "cacheTTLSeconds"},
+		},
+		{
+			name:       "no FlavourClusterWide args",
+			path:       withoutPlugin,
+			wantCode:   selfTestExitCode,
+			wantStdout: []string{"validate config: ok"},
+			wantStderr: []string{"self-test: FAILED: no FlavourClusterWide args in " + withoutPlugin},
+		},
+		{
+			name:       "unreadable file",
+			path:       filepath.Join(dir, "missing.yaml"),
+			wantCode:   selfTestExitCode,
+			wantStderr: []string{"decode config: FAILED", "no such file or directory"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if code := runSelfTest(c.path, &stdout, &stderr); code != c.wantCode {
+				t.Errorf("expected exit code %d, got %d, stderr %q", c.wantCode, code, stderr.String())
+			}
+			for _, want := range c.wantStdout {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("expected stdout to contain %q, got %q", want, stdout.String())
+				}
+			}
+			if len(c.wantStderr) == 0 && stderr.Len() > 0 {
+				t.Errorf("unexpected stderr %q", stderr.String())
+			}
+			for _, want := range c.wantStderr {
+				if !strings.Contains(stderr.String(), want) {
+					t.Errorf("expected stderr to contain %q, got %q", want, stderr.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	schedconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+	schedvalidation "k8s.io/kubernetes/pkg/scheduler/apis/config/validation"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/scheme"
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+)
+
+const (
+	validateConfigFlag     = "--validate-config"
+	flavourArgsSchemaFlag  = "--flavour-args-schema"
+	validateConfigExitCode = 1
+)
+
+// offlineFlagValue returns the value of flag in args, accepting both "--flag=value" and
+// "--flag value". These flags are handled before the scheduler command is built so that
+// they work without a cluster.
+func offlineFlagValue(args []string, flag string) (string, bool) {
+	for i, arg := range args {
+		if value, ok := strings.CutPrefix(arg, flag+"="); ok {
+			return value, true
+		}
+		if arg == flag {
+			if i+1 < len(args) {
+				return args[i+1], true
+			}
+			return "", true
+		}
+	}
+	return "", false
+}
+
+// validateConfigFile decodes a KubeSchedulerConfiguration file with the scheme of this binary
+// (strict decoding and defaulting included) and validates both the framework configuration
+// and the args of the out-of-tree plugins. It never contacts a cluster.
+func validateConfigFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	cfg, err := decodeConfig(data, path)
+	if err != nil {
+		return err
+	}
+	return validateConfig(cfg)
+}
+
+// decodeConfig decodes the KubeSchedulerConfiguration data read from source with the scheme of this
+// binary, strict decoding and defaulting included.
+func decodeConfig(data []byte, source string) (*schedconfig.KubeSchedulerConfiguration, error) {
+	obj, gvk, err := scheme.Codecs.UniversalDecoder().Decode(data, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s: %v", source, err)
+	}
+	cfg, ok := obj.(*schedconfig.KubeSchedulerConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("decoding %s: expected KubeSchedulerConfiguration, got %s", source, gvk)
+	}
+	cfg.TypeMeta.APIVersion = gvk.GroupVersion().String()
+	return cfg, nil
+}
+
+// validateConfig validates both the framework configuration and the args of the out-of-tree plugins.
+func validateConfig(cfg *schedconfig.KubeSchedulerConfiguration) error {
+	var errs []error
+	if err := schedvalidation.ValidateKubeSchedulerConfiguration(cfg); err != nil {
+		errs = append(errs, err)
+	}
+	for i, profile := range cfg.Profiles {
+		for j, pluginConfig := range profile.PluginConfig {
+			path := field.NewPath("profiles").Index(i).Child("pluginConfig").Index(j).Child("args")
+			if err := validatePluginArgs(pluginConfig, path); err != nil {
+				errs = append(errs, fmt.Errorf("plugin %s: %v", pluginConfig.Name, err))
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func validatePluginArgs(pluginConfig schedconfig.PluginConfig, path *field.Path) error {
+	switch args := pluginConfig.Args.(type) {
+	case *config.FlavourClusterWideArgs:
+		return validation.ValidateFlavourClusterWideArgs(args, path)
+	case *config.CoschedulingArgs:
+		return validation.ValidateCoschedulingArgs(args, path)
+	case *config.NodeResourcesAllocatableArgs:
+		return validation.ValidateNodeResourcesAllocatableArgs(args, path)
+	case *config.NodeResourceTopologyMatchArgs:
+		return validation.ValidateNodeResourceTopologyMatchArgs(path, args)
+	}
+	return nil
+}
+
+// runOfflineMode handles the flags that don't start the scheduler, writing their output to stdout
+// and stderr. It returns false when none of them is set.
+func runOfflineMode(args []string, stdout, stderr io.Writer) (int, bool) {
+	if _, ok := offlineFlagValue(args, flavourArgsSchemaFlag); ok {
+		stdout.Write(cfgv1.FlavourClusterWideArgsSchema)
+		return 0, true
+	}
+	if path, ok := selfTestFlagValue(args); ok {
+		return runSelfTest(path, stdout, stderr), true
+	}
+	path, ok := offlineFlagValue(args, validateConfigFlag)
+	if !ok {
+		return 0, false
+	}
+	if path == "" {
+		fmt.Fprintf(stderr, "%s requires a file path\n", validateConfigFlag)
+		return validateConfigExitCode, true
+	}
+	if err := validateConfigFile(path); err != nil {
+		fmt.Fprintf(stderr, "%s is invalid:\n%v\n", path, err)
+		return validateConfigExitCode, true
+	}
+	fmt.Fprintf(stdout, "%s is valid\n", path)
+	return 0, true
+}
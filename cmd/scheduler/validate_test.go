@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateConfig(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig := func(name, args string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		data := `apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  plugins:
+    multiPoint:
+      enabled:
+      - name: FlavourClusterWide
+  pluginConfig:
+  - name: FlavourClusterWide
+    args: ` + args + "\n"
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return path
+	}
+	valid := writeConfig("valid.yaml", `{labelName: flavour}`)
+	invalid := writeConfig("invalid.yaml", `{cacheTTLSeconds: -1}`)
+	missing := filepath.Join(dir, "missing.yaml")
+
+	cases := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+		wantStderr []string
+	}{
+		{
+			name:       "valid config",
+			args:       []string{validateConfigFlag, valid},
+			wantStdout: valid + " is valid\n",
+		},
+		{
+			name:       "invalid config",
+			args:       []string{validateConfigFlag + "=" + invalid},
+			wantCode:   validateConfigExitCode,
+			wantStderr: []string{invalid + " is invalid:", "plugin FlavourClusterWide", "cacheTTLSeconds", "must be greater than or equal to 0"},
+		},
+		{
+			name:       "unreadable file",
+			args:       []string{validateConfigFlag, missing},
+			wantCode:   validateConfigExitCode,
+			wantStderr: []string{missing + " is invalid:", "no such file or directory"},
+		},
+		{
+			name:       "missing path",
+			args:       []string{validateConfigFlag},
+			wantCode:   validateConfigExitCode,
+			wantStderr: []string{validateConfigFlag + " requires a file path"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code, ok := runOfflineMode(c.args, &stdout, &stderr)
+			if !ok {
+				t.Fatalf("expected %v to run offline", c.args)
+			}
+			if code != c.wantCode {
+				t.Errorf("expected exit code %d, got %d", c.wantCode, code)
+			}
+			if got := stdout.String(); got != c.wantStdout {
+				t.Errorf("expected stdout %q, got %q", c.wantStdout, got)
+			}
+			if len(c.wantStderr) == 0 && stderr.Len() > 0 {
+				t.Errorf("unexpected stderr %q", stderr.String())
+			}
+			for _, want := range c.wantStderr {
+				if !strings.Contains(stderr.String(), want) {
+					t.Errorf("expected stderr to contain %q, got %q", want, stderr.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+)
+
+// FlavourPleaseMoveAnnotation is set on pods whose owners are asked to move them, for instance with a
+// rollout restart, to correct a persistent flavour imbalance. The value describes the imbalance.
+const FlavourPleaseMoveAnnotation = "scheduling.x-k8s.io/flavour-please-move"
+
+// FlavourAttractionAnnotation is set on nodes that persistently host the most pods of skewed flavours,
+// suggesting a temporary scoring penalty or a cordon. The value lists the flavours, separated by commas.
+const FlavourAttractionAnnotation = "scheduling.x-k8s.io/attracted-flavours"
+
+// FlavourRebalanceFieldManager is the field manager of the server-side applies of the reconciler.
+const FlavourRebalanceFieldManager = "flavour-rebalance-controller"
+
+// FlavourRebalanceReconciler watches the distribution of flavoured pods and, when a flavour stays skewed
+// for longer than GracePeriod, annotates pods on its most loaded node with FlavourPleaseMoveAnnotation.
+// Pods are never evicted, pods with FlavourDoNotEvictAnnotation are never annotated, and no more pods
+// are annotated than their PodDisruptionBudgets allow to be disrupted. When AttractionPeriod is set, nodes that keep attracting a flavour are reported with
+// FlavourAttractionAnnotation and an event. Each reconcile request is named after a flavour.
+// The reconciler is only run by the leader of the controller replicas, and its writes are rate-limited.
+type FlavourRebalanceReconciler struct {
+	recorder record.EventRecorder
+
+	client.Client
+	Scheme  *runtime.Scheme
+	Workers int
+	// LabelName is the pod label holding the flavour, as configured for the FlavourClusterWide plugin.
+	LabelName string
+	// NodeSelector is the label selector of the nodes the flavours are balanced across, as configured for
+	// the FlavourClusterWide plugin. Empty selects every schedulable node.
+	NodeSelector string
+	// SkewTolerance is the difference between the most and least loaded nodes of a flavour that is tolerated.
+	SkewTolerance int
+	// GracePeriod is how long a flavour must stay skewed before pods are annotated.
+	GracePeriod time.Duration
+	// AttractionPeriod is how long a node must stay the most loaded node of a skewed flavour before it
+	// is reported as attracting the flavour. 0 disables the analysis.
+	AttractionPeriod time.Duration
+	// WriteQPS and WriteBurst rate-limit the annotation writes of the reconciler. 0 disables the limit.
+	WriteQPS   float32
+	WriteBurst int
+
+	clock           clock.PassiveClock
+	writeLimiter    flowcontrol.RateLimiter
+	mu              sync.Mutex
+	imbalancedSince map[string]time.Time
+	attractedSince  map[string]attraction
+}
+
+// attraction records since when a node is the most loaded node of a skewed flavour.
+type attraction struct {
+	node  string
+	since time.Time
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=list;watch;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=list;watch
+func (r *FlavourRebalanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	flavour := req.Name
+
+	nodeSelector, err := labels.Parse(r.NodeSelector)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	nodeList := &v1.NodeList{}
+	if err := r.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: nodeSelector}); err != nil {
+		return ctrl.Result{}, err
+	}
+	podList := &v1.PodList{}
+	if err := r.List(ctx, podList, client.MatchingLabels{r.LabelName: flavour}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	nodes, pods := flavourclusterwide.SchedulableNodes(nodeList.Items, podList.Items)
+	snapshot := flavourclusterwide.BuildSnapshot(nodes, pods, r.LabelName)
+	busiest, maxPods, minPods := flavourSpread(snapshot, flavour)
+	now := r.clock.Now()
+	if maxPods-minPods <= r.SkewTolerance {
+		r.setImbalancedSince(flavour, nil)
+		if err := r.analyzeAttraction(ctx, flavour, "", nodeList.Items, now); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.clearAnnotations(ctx, podList.Items)
+	}
+
+	if err := r.analyzeAttraction(ctx, flavour, busiest, nodeList.Items, now); err != nil {
+		return ctrl.Result{}, err
+	}
+	since := r.setImbalancedSince(flavour, &now)
+	if wait := r.GracePeriod - now.Sub(since); wait > 0 {
+		log.V(5).Info("flavour is skewed, waiting for the grace period", "flavour", flavour, "wait", wait)
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	var candidates []*v1.Pod
+	toMove := (maxPods - minPods) / 2
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Spec.NodeName != busiest || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if pod.Annotations[schedv1alpha1.FlavourDoNotEvictAnnotation] == "true" {
+			log.V(5).Info("pod must not be evicted, not asking it to move", "pod", pod.Name, "namespace", pod.Namespace)
+			continue
+		}
+		if _, ok := pod.Annotations[FlavourPleaseMoveAnnotation]; ok {
+			toMove--
+			continue
+		}
+		candidates = append(candidates, pod)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Namespace+"/"+candidates[i].Name < candidates[j].Namespace+"/"+candidates[j].Name
+	})
+
+	reason := fmt.Sprintf("flavour %s has %d pods on node %s and %d on the least loaded node", flavour, maxPods, busiest, minPods)
+	for _, pod := range candidates {
+		if toMove <= 0 {
+			break
+		}
+		allowed, err := r.disruptionAllowed(ctx, pod)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !allowed {
+			log.V(5).Info("pod disruption budget does not allow moving pod", "pod", pod.Name, "namespace", pod.Namespace)
+			continue
+		}
+		if err := r.annotate(ctx, pod, reason); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.recorder.Event(pod, v1.EventTypeNormal, "PleaseMove", reason)
+		toMove--
+	}
+	return ctrl.Result{RequeueAfter: r.GracePeriod}, nil
+}
+
+// flavourSpread returns the node hosting the most pods of the flavour, with the largest and smallest counts.
+func flavourSpread(snapshot map[string]map[string]int, flavour string) (string, int, int) {
+	nodes := make([]string, 0, len(snapshot))
+	for node := range snapshot {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	busiest, maxPods, minPods := "", 0, -1
+	for _, node := range nodes {
+		count := snapshot[node][flavour]
+		if count > maxPods {
+			busiest, maxPods = node, count
+		}
+		if minPods == -1 || count < minPods {
+			minPods = count
+		}
+	}
+	if minPods == -1 {
+		minPods = 0
+	}
+	return busiest, maxPods, minPods
+}
+
+// setImbalancedSince records since when the flavour is skewed and returns the recorded time.
+// A nil time clears the record.
+func (r *FlavourRebalanceReconciler) setImbalancedSince(flavour string, now *time.Time) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now == nil {
+		delete(r.imbalancedSince, flavour)
+		return time.Time{}
+	}
+	if since, ok := r.imbalancedSince[flavour]; ok {
+		return since
+	}
+	r.imbalancedSince[flavour] = *now
+	return *now
+}
+
+// analyzeAttraction reports the node that has been the most loaded node of the skewed flavour for
+// longer than AttractionPeriod, and withdraws the reports of the other nodes. Such a node points at a
+// root cause of the imbalance, such as a node much larger than the others, that moving pods alone does
+// not address. An empty busiest node means the flavour is balanced.
+func (r *FlavourRebalanceReconciler) analyzeAttraction(ctx context.Context, flavour, busiest string, nodes []v1.Node, now time.Time) error {
+	if r.AttractionPeriod == 0 {
+		return nil
+	}
+
+	attracting := ""
+	if since := r.setAttractedSince(flavour, busiest, now); busiest != "" && now.Sub(since) >= r.AttractionPeriod {
+		attracting = busiest
+	}
+	for i := range nodes {
+		node := &nodes[i]
+		flavours := attractedFlavours(node)
+		switch {
+		case node.Name == attracting && !flavours.Has(flavour):
+			if err := r.setAttractedFlavour(ctx, node, flavour, true); err != nil {
+				return err
+			}
+			r.recorder.Event(node, v1.EventTypeWarning, "FlavourOverConcentration", attractionMessage(nodes, node, flavour, r.AttractionPeriod))
+		case node.Name != attracting && flavours.Has(flavour):
+			if err := r.setAttractedFlavour(ctx, node, flavour, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setAttractedSince records since when the node is the most loaded node of the flavour and returns the
+// recorded time. An empty node clears the record.
+func (r *FlavourRebalanceReconciler) setAttractedSince(flavour, node string, now time.Time) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if node == "" {
+		delete(r.attractedSince, flavour)
+		return time.Time{}
+	}
+	if a, ok := r.attractedSince[flavour]; ok && a.node == node {
+		return a.since
+	}
+	r.attractedSince[flavour] = attraction{node: node, since: now}
+	return now
+}
+
+// attractedFlavours returns the flavours listed in the FlavourAttractionAnnotation of the node.
+func attractedFlavours(node *v1.Node) sets.Set[string] {
+	flavours := sets.New[string]()
+	if value := node.Annotations[FlavourAttractionAnnotation]; value != "" {
+		flavours.Insert(strings.Split(value, ",")...)
+	}
+	return flavours
+}
+
+// setAttractedFlavour adds the flavour to, or removes it from, the FlavourAttractionAnnotation of the
+// node with a server-side apply. The annotation is removed with a patch when no flavour is left, as an
+// apply only removes the fields no other manager owns. Reconciles of other flavours may update the
+// annotation concurrently, hence the optimistic lock: on a conflict, the node is read again and the
+// update retried on its latest annotation.
+func (r *FlavourRebalanceReconciler) setAttractedFlavour(ctx context.Context, node *v1.Node, flavour string, attracted bool) error {
+	first := true
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !first {
+			if err := r.Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		flavours := attractedFlavours(node)
+		if flavours.Has(flavour) == attracted {
+			return nil
+		}
+		if attracted {
+			flavours.Insert(flavour)
+		} else {
+			flavours.Delete(flavour)
+		}
+		if err := r.waitForWrite(ctx); err != nil {
+			return err
+		}
+		if flavours.Len() == 0 {
+			original := node.DeepCopy()
+			delete(node.Annotations, FlavourAttractionAnnotation)
+			return r.Patch(ctx, node, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}), client.FieldOwner(FlavourRebalanceFieldManager))
+		}
+		// The resource version makes the apply fail with a conflict when the node changed since it was read.
+		apply := corev1ac.Node(node.Name).
+			WithResourceVersion(node.ResourceVersion).
+			WithAnnotations(map[string]string{FlavourAttractionAnnotation: strings.Join(sets.List(flavours), ",")})
+		return r.Apply(ctx, apply, client.FieldOwner(FlavourRebalanceFieldManager), client.ForceOwnership)
+	})
+}
+
+// waitForWrite blocks until the write rate limit allows another write.
+func (r *FlavourRebalanceReconciler) waitForWrite(ctx context.Context) error {
+	if r.writeLimiter == nil {
+		return nil
+	}
+	return r.writeLimiter.Wait(ctx)
+}
+
+// attractionMessage describes the attraction of the flavour to the node. When no other node shares its
+// capacity class, the heterogeneity of the nodes is pointed out as the likely cause.
+func attractionMessage(nodes []v1.Node, node *v1.Node, flavour string, period time.Duration) string {
+	message := fmt.Sprintf("node %s has hosted the most pods of flavour %s for %v", node.Name, flavour, period)
+	class := flavourclusterwide.CapacityClass(node)
+	shared := false
+	for i := range nodes {
+		if nodes[i].Name != node.Name && flavourclusterwide.CapacityClass(&nodes[i]) == class {
+			shared = true
+			break
+		}
+	}
+	if !shared && len(nodes) > 1 {
+		message += fmt.Sprintf("; its capacity class %s differs from every other worker node", class)
+	}
+	return message + ", consider a temporary scoring penalty or cordoning the node"
+}
+
+// disruptionAllowed returns true if every PodDisruptionBudget covering the pod still allows a disruption
+// once the pods of the budget already asked to move are accounted for.
+func (r *FlavourRebalanceReconciler) disruptionAllowed(ctx context.Context, pod *v1.Pod) (bool, error) {
+	pdbList := &policyv1.PodDisruptionBudgetList{}
+	if err := r.List(ctx, pdbList, client.InNamespace(pod.Namespace)); err != nil {
+		return false, err
+	}
+	for i := range pdbList.Items {
+		pdb := &pdbList.Items[i]
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		podList := &v1.PodList{}
+		if err := r.List(ctx, podList, client.InNamespace(pod.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return false, err
+		}
+		moving := int32(0)
+		for _, p := range podList.Items {
+			if _, ok := p.Annotations[FlavourPleaseMoveAnnotation]; ok {
+				moving++
+			}
+		}
+		if pdb.Status.DisruptionsAllowed-moving <= 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// annotate asks the pod to move with a server-side apply of the FlavourPleaseMoveAnnotation.
+func (r *FlavourRebalanceReconciler) annotate(ctx context.Context, pod *v1.Pod, reason string) error {
+	apply := corev1ac.Pod(pod.Name, pod.Namespace).
+		WithUID(pod.UID).
+		WithAnnotations(map[string]string{FlavourPleaseMoveAnnotation: reason})
+	if err := r.waitForWrite(ctx); err != nil {
+		return err
+	}
+	return r.Apply(ctx, apply, client.FieldOwner(FlavourRebalanceFieldManager), client.ForceOwnership)
+}
+
+// clearAnnotations withdraws the move requests once the flavour is balanced again. The annotations are
+// removed with a patch, as an apply only removes the fields no other manager owns, such as those set by
+// hand or by earlier releases.
+func (r *FlavourRebalanceReconciler) clearAnnotations(ctx context.Context, pods []v1.Pod) error {
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, FlavourPleaseMoveAnnotation)
+	for i := range pods {
+		if _, ok := pods[i].Annotations[FlavourPleaseMoveAnnotation]; !ok {
+			continue
+		}
+		if err := r.waitForWrite(ctx); err != nil {
+			return err
+		}
+		if err := r.Patch(ctx, &pods[i], client.RawPatch(types.MergePatchType, []byte(patch)), client.FieldOwner(FlavourRebalanceFieldManager)); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+	}
+	return nil
+}
+
+// podToFlavour maps a flavoured pod to the reconcile request of its flavour.
+func (r *FlavourRebalanceReconciler) podToFlavour(_ context.Context, obj client.Object) []reconcile.Request {
+	flavour := obj.GetLabels()[r.LabelName]
+	if flavour == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: flavour}}}
+}
+
+func (r *FlavourRebalanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("FlavourRebalanceController")
+	if r.clock == nil {
+		r.clock = clock.RealClock{}
+	}
+	r.imbalancedSince = make(map[string]time.Time)
+	r.attractedSince = make(map[string]attraction)
+	if r.WriteQPS > 0 {
+		r.writeLimiter = flowcontrol.NewTokenBucketRateLimiter(r.WriteQPS, max(r.WriteBurst, 1))
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("flavourrebalance").
+		Watches(&v1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.podToFlavour)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.Workers}).
+		Complete(r)
+}
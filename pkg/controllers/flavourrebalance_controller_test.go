@@ -0,0 +1,412 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+)
+
+func TestFlavourRebalanceController_Run(t *testing.T) {
+	ctx := context.TODO()
+	worker := func(name string) *v1.Node {
+		return &v1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{flavourclusterwide.WorkerNodeLabelSelector: ""},
+		}}
+	}
+	flavouredPods := func(prefix, nodeName string, count int, annotated bool) []*v1.Pod {
+		var pods []*v1.Pod
+		for i := 0; i < count; i++ {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      fmt.Sprintf("%s-%d", prefix, i),
+					Labels:    map[string]string{"flavour": "gold", "app": prefix},
+				},
+				Spec: v1.PodSpec{NodeName: nodeName},
+			}
+			if annotated {
+				pod.Annotations = map[string]string{FlavourPleaseMoveAnnotation: "skewed"}
+			}
+			pods = append(pods, pod)
+		}
+		return pods
+	}
+	doNotEvict := func(pods []*v1.Pod) []*v1.Pod {
+		for _, pod := range pods {
+			pod.Annotations = map[string]string{v1alpha1.FlavourDoNotEvictAnnotation: "true"}
+		}
+		return pods
+	}
+	pdb := func(app string, allowed int32) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: app},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: allowed},
+		}
+	}
+
+	cases := []struct {
+		name          string
+		pods          []*v1.Pod
+		pdbs          []*policyv1.PodDisruptionBudget
+		nodeSelector  string
+		cordonNode3   bool
+		skewedFor     time.Duration
+		wantAnnotated int
+		wantRequeue   time.Duration
+	}{
+		{
+			name: "balanced flavour clears previous requests",
+			pods: append(append(flavouredPods("a", "node1", 2, true),
+				flavouredPods("b", "node2", 1, false)...), flavouredPods("c", "node3", 1, false)...),
+			skewedFor:     time.Hour,
+			wantAnnotated: 0,
+		},
+		{
+			name:          "skew within the grace period",
+			pods:          flavouredPods("a", "node1", 6, false),
+			skewedFor:     4 * time.Minute,
+			wantAnnotated: 0,
+			wantRequeue:   time.Minute,
+		},
+		{
+			name:          "persistent skew asks half of the difference to move",
+			pods:          flavouredPods("a", "node1", 6, false),
+			skewedFor:     5 * time.Minute,
+			wantAnnotated: 3,
+			wantRequeue:   5 * time.Minute,
+		},
+		{
+			name:          "pods already asked to move are accounted for",
+			pods:          append(flavouredPods("a", "node1", 4, false), flavouredPods("b", "node1", 2, true)...),
+			skewedFor:     5 * time.Minute,
+			wantAnnotated: 3,
+			wantRequeue:   5 * time.Minute,
+		},
+		{
+			name:          "pods that must not be evicted are never asked to move",
+			pods:          append(doNotEvict(flavouredPods("a", "node1", 4, false)), flavouredPods("b", "node1", 2, false)...),
+			skewedFor:     5 * time.Minute,
+			wantAnnotated: 2,
+			wantRequeue:   5 * time.Minute,
+		},
+		{
+			name:          "pod disruption budget bounds the requests",
+			pods:          flavouredPods("a", "node1", 6, false),
+			pdbs:          []*policyv1.PodDisruptionBudget{pdb("a", 1)},
+			skewedFor:     5 * time.Minute,
+			wantAnnotated: 1,
+			wantRequeue:   5 * time.Minute,
+		},
+		{
+			name:          "exhausted pod disruption budget",
+			pods:          flavouredPods("a", "node1", 6, false),
+			pdbs:          []*policyv1.PodDisruptionBudget{pdb("a", 0)},
+			skewedFor:     5 * time.Minute,
+			wantAnnotated: 0,
+			wantRequeue:   5 * time.Minute,
+		},
+		{
+			name:          "cordoned node left out by the worker selector",
+			pods:          append(flavouredPods("a", "node1", 2, false), flavouredPods("b", "node2", 1, false)...),
+			nodeSelector:  flavourclusterwide.WorkerNodeLabelSelector,
+			cordonNode3:   true,
+			skewedFor:     5 * time.Minute,
+			wantAnnotated: 0,
+		},
+		{
+			name:          "cordoned node left out by the empty selector",
+			pods:          append(flavouredPods("a", "node1", 2, false), flavouredPods("b", "node2", 1, false)...),
+			cordonNode3:   true,
+			skewedFor:     5 * time.Minute,
+			wantAnnotated: 0,
+		},
+		{
+			name:          "schedulable node hosting no pod",
+			pods:          append(flavouredPods("a", "node1", 2, false), flavouredPods("b", "node2", 1, false)...),
+			nodeSelector:  flavourclusterwide.WorkerNodeLabelSelector,
+			skewedFor:     5 * time.Minute,
+			wantAnnotated: 1,
+			wantRequeue:   5 * time.Minute,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			node3 := worker("node3")
+			node3.Spec.Unschedulable = c.cordonNode3
+			builder := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+				WithObjects(worker("node1"), worker("node2"), node3)
+			for _, pod := range c.pods {
+				builder.WithObjects(pod)
+			}
+			for _, pdb := range c.pdbs {
+				builder.WithObjects(pdb)
+			}
+			client := builder.Build()
+
+			fakeClock := clocktesting.NewFakeClock(time.Now())
+			r := &FlavourRebalanceReconciler{
+				Client:          client,
+				Scheme:          scheme.Scheme,
+				LabelName:       "flavour",
+				NodeSelector:    c.nodeSelector,
+				SkewTolerance:   1,
+				GracePeriod:     5 * time.Minute,
+				recorder:        record.NewFakeRecorder(10),
+				clock:           fakeClock,
+				imbalancedSince: map[string]time.Time{"gold": fakeClock.Now().Add(-c.skewedFor)},
+			}
+
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "gold"}})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.RequeueAfter != c.wantRequeue {
+				t.Errorf("expected requeue after %v, got %v", c.wantRequeue, result.RequeueAfter)
+			}
+
+			annotated := 0
+			for _, pod := range c.pods {
+				got := &v1.Pod{}
+				if err := client.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}, got); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if _, ok := got.Annotations[FlavourPleaseMoveAnnotation]; ok {
+					annotated++
+				}
+			}
+			if annotated != c.wantAnnotated {
+				t.Errorf("expected %d pods asked to move, got %d", c.wantAnnotated, annotated)
+			}
+		})
+	}
+}
+
+func TestFlavourRebalancePodToFlavour(t *testing.T) {
+	r := &FlavourRebalanceReconciler{LabelName: "flavour"}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p", Labels: map[string]string{"flavour": "gold"}}}
+	if got := r.podToFlavour(context.TODO(), pod); len(got) != 1 || got[0].Name != "gold" || got[0].Namespace != "" {
+		t.Errorf("expected a request for flavour gold, got %v", got)
+	}
+	if got := r.podToFlavour(context.TODO(), &v1.Pod{}); got != nil {
+		t.Errorf("expected no request for an unflavoured pod, got %v", got)
+	}
+}
+
+func TestFlavourRebalanceAttraction(t *testing.T) {
+	ctx := context.TODO()
+	node := func(name, instanceType, attracted string) *v1.Node {
+		n := &v1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				flavourclusterwide.WorkerNodeLabelSelector: "",
+				v1.LabelInstanceTypeStable:                 instanceType,
+			},
+		}}
+		if attracted != "" {
+			n.Annotations = map[string]string{FlavourAttractionAnnotation: attracted}
+		}
+		return n
+	}
+	pods := func(nodeName string, count int) []*v1.Pod {
+		var pods []*v1.Pod
+		for i := 0; i < count; i++ {
+			pods = append(pods, &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      fmt.Sprintf("%s-%d", nodeName, i),
+					Labels:    map[string]string{"flavour": "gold"},
+				},
+				Spec: v1.PodSpec{NodeName: nodeName},
+			})
+		}
+		return pods
+	}
+
+	cases := []struct {
+		name          string
+		nodes         []*v1.Node
+		pods          []*v1.Pod
+		attractedNode string
+		attractedFor  time.Duration
+		wantAttracted map[string]string
+		wantEvent     string
+	}{
+		{
+			name:          "node attracting the flavour for less than the period",
+			nodes:         []*v1.Node{node("node1", "m5.4xlarge", ""), node("node2", "m5.xlarge", ""), node("node3", "m5.xlarge", "")},
+			pods:          pods("node1", 4),
+			attractedNode: "node1",
+			attractedFor:  time.Minute,
+			wantAttracted: map[string]string{},
+		},
+		{
+			name:          "larger node persistently attracting the flavour",
+			nodes:         []*v1.Node{node("node1", "m5.4xlarge", "silver"), node("node2", "m5.xlarge", ""), node("node3", "m5.xlarge", "")},
+			pods:          pods("node1", 4),
+			attractedNode: "node1",
+			attractedFor:  time.Hour,
+			wantAttracted: map[string]string{"node1": "gold,silver"},
+			wantEvent:     "Warning FlavourOverConcentration node node1 has hosted the most pods of flavour gold for 1h0m0s; its capacity class m5.4xlarge differs from every other worker node, consider a temporary scoring penalty or cordoning the node",
+		},
+		{
+			name:          "another node became the most loaded",
+			nodes:         []*v1.Node{node("node1", "m5.xlarge", "gold"), node("node2", "m5.xlarge", ""), node("node3", "m5.xlarge", "")},
+			pods:          pods("node2", 4),
+			attractedNode: "node1",
+			attractedFor:  time.Hour,
+			wantAttracted: map[string]string{},
+		},
+		{
+			name:          "balanced flavour withdraws the report",
+			nodes:         []*v1.Node{node("node1", "m5.xlarge", "gold,silver"), node("node2", "m5.xlarge", ""), node("node3", "m5.xlarge", "")},
+			pods:          append(append(pods("node1", 1), pods("node2", 1)...), pods("node3", 1)...),
+			attractedNode: "node1",
+			attractedFor:  time.Hour,
+			wantAttracted: map[string]string{"node1": "silver"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+			for _, n := range c.nodes {
+				builder.WithObjects(n)
+			}
+			for _, pod := range c.pods {
+				builder.WithObjects(pod)
+			}
+			client := builder.Build()
+
+			fakeClock := clocktesting.NewFakeClock(time.Now())
+			recorder := record.NewFakeRecorder(10)
+			r := &FlavourRebalanceReconciler{
+				Client:           client,
+				Scheme:           scheme.Scheme,
+				LabelName:        "flavour",
+				SkewTolerance:    1,
+				GracePeriod:      5 * time.Minute,
+				AttractionPeriod: time.Hour,
+				recorder:         recorder,
+				clock:            fakeClock,
+				imbalancedSince:  map[string]time.Time{},
+				attractedSince: map[string]attraction{
+					"gold": {node: c.attractedNode, since: fakeClock.Now().Add(-c.attractedFor)},
+				},
+			}
+
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "gold"}}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			attracted := map[string]string{}
+			for _, n := range c.nodes {
+				got := &v1.Node{}
+				if err := client.Get(ctx, types.NamespacedName{Name: n.Name}, got); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if value, ok := got.Annotations[FlavourAttractionAnnotation]; ok {
+					attracted[n.Name] = value
+				}
+			}
+			if diff := cmp.Diff(c.wantAttracted, attracted); diff != "" {
+				t.Errorf("unexpected attracted flavours (-want,+got):\n%s", diff)
+			}
+
+			event := ""
+			for len(recorder.Events) > 0 {
+				if e := <-recorder.Events; strings.Contains(e, "FlavourOverConcentration") {
+					event = e
+				}
+			}
+			if event != c.wantEvent {
+				t.Errorf("expected event %q, got %q", c.wantEvent, event)
+			}
+		})
+	}
+}
+
+func TestFlavourRebalanceAttractionConflict(t *testing.T) {
+	ctx := context.TODO()
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:        "node1",
+		Annotations: map[string]string{FlavourAttractionAnnotation: "silver"},
+	}}
+	conflicts := 0
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(node).
+		WithInterceptorFuncs(interceptor.Funcs{
+			// The reconcile of another flavour updates the node first, failing the apply on its stale
+			// resource version.
+			Apply: func(ctx context.Context, c client.WithWatch, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+				if conflicts == 0 {
+					conflicts++
+					concurrent := &v1.Node{}
+					if err := c.Get(ctx, types.NamespacedName{Name: "node1"}, concurrent); err != nil {
+						return err
+					}
+					concurrent.Annotations[FlavourAttractionAnnotation] = "bronze,silver"
+					if err := c.Update(ctx, concurrent); err != nil {
+						return err
+					}
+					return apierrors.NewConflict(v1.Resource("nodes"), "node1", fmt.Errorf("the object has been modified"))
+				}
+				return c.Apply(ctx, obj, opts...)
+			},
+		}).Build()
+	r := &FlavourRebalanceReconciler{Client: fakeClient}
+
+	stale := &v1.Node{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "node1"}, stale); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.setAttractedFlavour(ctx, stale, "gold", true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := &v1.Node{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "node1"}, got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value := got.Annotations[FlavourAttractionAnnotation]; value != "bronze,gold,silver" {
+		t.Errorf("expected the concurrent update to be kept, got %q", value)
+	}
+}
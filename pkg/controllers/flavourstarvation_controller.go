@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+)
+
+// starvedPods is the number of pods of a flavour pending beyond the threshold while other flavours
+// schedule. Only starved flavours have a series, and the flavour label is bounded as the metrics of the
+// plugin bound theirs, so its cardinality is bounded by MetricsLabelCap, the flavours beyond it adding
+// their pods to the "other" series.
+var starvedPods = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "flavour_starved_pods",
+	Help: "Number of pods of a flavour pending for longer than the starvation threshold while pods of other flavours were scheduled.",
+}, []string{"flavour"})
+
+var registerStarvationMetrics sync.Once
+
+// FlavourStarvationReconciler watches the pending flavoured pods and detects the flavours starving: those
+// with pods pending for longer than Threshold while pods of other flavours were scheduled within
+// Threshold, the signal of a misconfigured cap or ratio rather than of a full cluster. A starved flavour
+// gets a FlavourStarvation warning event on its oldest pending pod when the starvation starts, and a
+// series of the flavour_starved_pods gauge for as long as it lasts. Each reconcile request is named after
+// a flavour. The reconciler only reads pods.
+type FlavourStarvationReconciler struct {
+	recorder record.EventRecorder
+
+	client.Client
+	Scheme  *runtime.Scheme
+	Workers int
+	// LabelName is the pod label holding the flavour, as configured for the FlavourClusterWide plugin.
+	LabelName string
+	// Threshold is how long a pod must stay pending for its flavour to be starved, and how recently pods of
+	// other flavours must have been scheduled.
+	Threshold time.Duration
+	// MetricsLabelCap and MetricsFlavours bound the values of the flavour label of flavour_starved_pods, as
+	// configured for the FlavourClusterWide plugin.
+	MetricsLabelCap int32
+	MetricsFlavours []string
+
+	clock        clock.PassiveClock
+	flavourLabel func(flavour string) string
+	mu           sync.Mutex
+	// starved are the pending pods of the starved flavours.
+	starved map[string]int
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+func (r *FlavourStarvationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	flavour := req.Name
+
+	podList := &v1.PodList{}
+	if err := r.List(ctx, podList, client.HasLabels{r.LabelName}); err != nil {
+		return ctrl.Result{}, err
+	}
+	now := r.clock.Now()
+	var oldest *v1.Pod
+	waiting := 0
+	scheduled := sets.New[string]()
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		podFlavour := pod.Labels[r.LabelName]
+		if podFlavour != flavour {
+			if scheduledSince(pod, now.Add(-r.Threshold)) {
+				scheduled.Insert(podFlavour)
+			}
+			continue
+		}
+		if !isPending(pod) {
+			continue
+		}
+		if now.Sub(pod.CreationTimestamp.Time) >= r.Threshold {
+			waiting++
+		}
+		if oldest == nil || pod.CreationTimestamp.Before(&oldest.CreationTimestamp) {
+			oldest = pod
+		}
+	}
+
+	if waiting == 0 || scheduled.Len() == 0 {
+		r.setStarved(flavour, 0)
+		if oldest == nil {
+			return ctrl.Result{}, nil
+		}
+		// Check again once the oldest pending pod reaches the threshold, or a threshold later when the
+		// other flavours are not scheduling either.
+		wait := r.Threshold - now.Sub(oldest.CreationTimestamp.Time)
+		if wait <= 0 {
+			wait = r.Threshold
+		}
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	if !r.setStarved(flavour, waiting) {
+		message := fmt.Sprintf("flavour %s has %d pods pending for more than %v while pods of flavours %s were scheduled, check its caps and ratios",
+			flavour, waiting, r.Threshold, strings.Join(sets.List(scheduled), ", "))
+		r.recorder.Event(oldest, v1.EventTypeWarning, "FlavourStarvation", message)
+		log.Info("flavour is starving", "flavour", flavour, "pending", waiting, "scheduled", sets.List(scheduled))
+	}
+	// The other flavours may stop scheduling, or the pending pods be scheduled, without an event of the
+	// flavour.
+	return ctrl.Result{RequeueAfter: r.Threshold}, nil
+}
+
+// isPending returns true if the pod waits for a node.
+func isPending(pod *v1.Pod) bool {
+	return pod.Spec.NodeName == "" && pod.DeletionTimestamp == nil && pod.Status.Phase == v1.PodPending
+}
+
+// scheduledSince returns true if the pod was scheduled after since.
+func scheduledSince(pod *v1.Pod, since time.Time) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionTrue {
+			return condition.LastTransitionTime.Time.After(since)
+		}
+	}
+	return false
+}
+
+// setStarved records the pending pods of the flavour, 0 when it is not starved, updates the series of its
+// flavour label with the pods of the starved flavours sharing it, and returns whether it was starved already.
+func (r *FlavourStarvationReconciler) setStarved(flavour string, waiting int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, was := r.starved[flavour]
+	if waiting == 0 {
+		if !was {
+			return false
+		}
+		delete(r.starved, flavour)
+	} else {
+		r.starved[flavour] = waiting
+	}
+
+	value := r.flavourLabel(flavour)
+	total := 0
+	for starved, pods := range r.starved {
+		if r.flavourLabel(starved) == value {
+			total += pods
+		}
+	}
+	if total == 0 {
+		starvedPods.DeleteLabelValues(value)
+	} else {
+		starvedPods.WithLabelValues(value).Set(float64(total))
+	}
+	return was
+}
+
+// podToFlavour maps a flavoured pod to the reconcile request of its flavour.
+func (r *FlavourStarvationReconciler) podToFlavour(_ context.Context, obj client.Object) []reconcile.Request {
+	flavour := obj.GetLabels()[r.LabelName]
+	if flavour == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: flavour}}}
+}
+
+func (r *FlavourStarvationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("FlavourStarvationController")
+	if r.clock == nil {
+		r.clock = clock.RealClock{}
+	}
+	if r.flavourLabel == nil {
+		r.flavourLabel = flavourclusterwide.FlavourMetricLabel(r.MetricsLabelCap, r.MetricsFlavours)
+	}
+	r.starved = make(map[string]int)
+	registerStarvationMetrics.Do(func() {
+		metrics.Registry.MustRegister(starvedPods)
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("flavourstarvation").
+		Watches(&v1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.podToFlavour)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.Workers}).
+		Complete(r)
+}
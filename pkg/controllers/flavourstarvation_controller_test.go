@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+)
+
+func TestFlavourStarvationController_Run(t *testing.T) {
+	ctx := context.TODO()
+	// The timestamps of the objects are stored to the second.
+	now := time.Now().Truncate(time.Second)
+	pending := func(name, flavour string, age time.Duration) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              name,
+				Labels:            map[string]string{"flavour": flavour},
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Status: v1.PodStatus{Phase: v1.PodPending},
+		}
+	}
+	scheduled := func(name, flavour string, ago time.Duration) *v1.Pod {
+		pod := pending(name, flavour, time.Hour)
+		pod.Spec.NodeName = "node1"
+		pod.Status = v1.PodStatus{
+			Phase: v1.PodRunning,
+			Conditions: []v1.PodCondition{{
+				Type:               v1.PodScheduled,
+				Status:             v1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(now.Add(-ago)),
+			}},
+		}
+		return pod
+	}
+	cases := []struct {
+		name        string
+		pods        []*v1.Pod
+		wasStarved  bool
+		wantStarved float64
+		wantEvent   bool
+		wantRequeue time.Duration
+	}{
+		{
+			name:        "starved while another flavour schedules",
+			pods:        []*v1.Pod{pending("g1", "gold", 10*time.Minute), pending("g2", "gold", 6*time.Minute), pending("g3", "gold", time.Minute), scheduled("s1", "silver", time.Minute)},
+			wantStarved: 2,
+			wantEvent:   true,
+			wantRequeue: 5 * time.Minute,
+		},
+		{
+			name:        "already starved",
+			pods:        []*v1.Pod{pending("g1", "gold", 10*time.Minute), scheduled("s1", "silver", time.Minute)},
+			wasStarved:  true,
+			wantStarved: 1,
+			wantRequeue: 5 * time.Minute,
+		},
+		{
+			name:        "no other flavour scheduling",
+			pods:        []*v1.Pod{pending("g1", "gold", 10*time.Minute), scheduled("s1", "silver", 10*time.Minute)},
+			wasStarved:  true,
+			wantRequeue: 5 * time.Minute,
+		},
+		{
+			name:        "pending within the threshold",
+			pods:        []*v1.Pod{pending("g1", "gold", 2*time.Minute), scheduled("s1", "silver", time.Minute)},
+			wantRequeue: 3 * time.Minute,
+		},
+		{
+			name: "nothing pending",
+			pods: []*v1.Pod{scheduled("g1", "gold", time.Minute), scheduled("s1", "silver", time.Minute)},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+			for _, pod := range c.pods {
+				builder.WithObjects(pod)
+			}
+			recorder := record.NewFakeRecorder(10)
+			r := &FlavourStarvationReconciler{
+				Client:       builder.Build(),
+				Scheme:       scheme.Scheme,
+				LabelName:    "flavour",
+				Threshold:    5 * time.Minute,
+				recorder:     recorder,
+				clock:        clocktesting.NewFakeClock(now),
+				flavourLabel: flavourclusterwide.FlavourMetricLabel(20, nil),
+				starved:      make(map[string]int),
+			}
+			if c.wasStarved {
+				r.starved["gold"] = 1
+			}
+			starvedPods.Reset()
+
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "gold"}})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.RequeueAfter != c.wantRequeue {
+				t.Errorf("expected requeue after %v, got %v", c.wantRequeue, result.RequeueAfter)
+			}
+			if got := testutil.ToFloat64(starvedPods.WithLabelValues("gold")); got != c.wantStarved {
+				t.Errorf("expected %v starved pods, got %v", c.wantStarved, got)
+			}
+			if _, got := r.starved["gold"]; got != (c.wantStarved > 0) {
+				t.Errorf("expected starved %v, got %v", c.wantStarved > 0, got)
+			}
+			select {
+			case event := <-recorder.Events:
+				if !c.wantEvent {
+					t.Errorf("unexpected event %q", event)
+				} else if !strings.Contains(event, "FlavourStarvation") || !strings.Contains(event, "flavours silver") {
+					t.Errorf("unexpected event %q", event)
+				}
+			default:
+				if c.wantEvent {
+					t.Errorf("expected a FlavourStarvation event")
+				}
+			}
+		})
+	}
+}
+
+func TestStarvedPodsLabelCap(t *testing.T) {
+	r := &FlavourStarvationReconciler{
+		flavourLabel: flavourclusterwide.FlavourMetricLabel(1, nil),
+		starved:      make(map[string]int),
+	}
+	starvedPods.Reset()
+
+	// gold takes the only series of its own, the flavours beyond the cap add up under "other".
+	r.setStarved("gold", 3)
+	r.setStarved("silver", 2)
+	r.setStarved("bronze", 4)
+	want := map[string]float64{"gold": 3, "other": 6}
+	if got := testutil.CollectAndCount(starvedPods); got != len(want) {
+		t.Errorf("expected %d series, got %d", len(want), got)
+	}
+	for value, pods := range want {
+		if got := testutil.ToFloat64(starvedPods.WithLabelValues(value)); got != pods {
+			t.Errorf("expected %v starved pods for %s, got %v", pods, value, got)
+		}
+	}
+
+	r.setStarved("silver", 0)
+	if got := testutil.ToFloat64(starvedPods.WithLabelValues("other")); got != 4 {
+		t.Errorf("expected 4 starved pods for other, got %v", got)
+	}
+	r.setStarved("bronze", 0)
+	r.setStarved("gold", 0)
+	if got := testutil.CollectAndCount(starvedPods); got != 0 {
+		t.Errorf("expected no series once no flavour starves, got %d", got)
+	}
+}
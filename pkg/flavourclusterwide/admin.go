@@ -0,0 +1,521 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// AdminServiceName is the name of the gRPC admin service of the plugin. Its messages are encoded in
+// JSON rather than protobuf, see AdminClient.
+const AdminServiceName = "flavourclusterwide.v1.Admin"
+
+// RefreshRequest forces a rebuild of the cache, whatever its TTL.
+type RefreshRequest struct{}
+
+// RefreshResponse reports the cache rebuilt by a RefreshRequest.
+type RefreshResponse struct {
+	Nodes int `json:"nodes"`
+}
+
+// FlavourRequest pauses or resumes the scoring of a flavour.
+type FlavourRequest struct {
+	Flavour string `json:"flavour"`
+}
+
+// CapOverrideRequest caps the pods of a flavour per node for a while: the nodes hosting MaxPerNode pods
+// of the flavour or more score 0. A DurationSeconds of 0 removes the override of the flavour.
+type CapOverrideRequest struct {
+	Flavour         string `json:"flavour"`
+	MaxPerNode      int    `json:"maxPerNode"`
+	DurationSeconds int64  `json:"durationSeconds"`
+}
+
+// DumpSnapshotRequest requests the cache and the overrides in effect.
+type DumpSnapshotRequest struct{}
+
+// DumpSnapshotResponse is the cache of the plugin and the overrides in effect.
+type DumpSnapshotResponse struct {
+	LabelName      string                    `json:"labelName"`
+	LastUpdated    time.Time                 `json:"lastUpdated"`
+	Cache          map[string]map[string]int `json:"cache"`
+	PausedFlavours []string                  `json:"pausedFlavours,omitempty"`
+	CapOverrides   map[string]CapOverride    `json:"capOverrides,omitempty"`
+}
+
+// CapOverride is a cap on the pods of a flavour per node, in effect until Expires.
+type CapOverride struct {
+	MaxPerNode int       `json:"maxPerNode"`
+	Expires    time.Time `json:"expires"`
+}
+
+// AdminResponse acknowledges the requests that return nothing.
+type AdminResponse struct{}
+
+// adminOverrides are the scoring overrides set through the admin service. They are kept in memory
+// and lost when the scheduler restarts.
+type adminOverrides struct {
+	mutex  sync.RWMutex
+	paused sets.Set[string]
+	caps   map[string]CapOverride
+}
+
+func newAdminOverrides() *adminOverrides {
+	return &adminOverrides{paused: sets.New[string](), caps: make(map[string]CapOverride)}
+}
+
+// isPaused returns true if the scoring of the flavour is paused. A nil overrides pauses nothing.
+func (o *adminOverrides) isPaused(flavour string) bool {
+	if o == nil {
+		return false
+	}
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	return o.paused.Has(flavour)
+}
+
+// isCapped returns true if a node hosting count pods of the flavour reached its cap override.
+func (o *adminOverrides) isCapped(flavour string, count int, now time.Time) bool {
+	if o == nil {
+		return false
+	}
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	override, ok := o.caps[flavour]
+	return ok && now.Before(override.Expires) && count >= override.MaxPerNode
+}
+
+// adminTargetMetadata is the metadata key of the calls naming the plugin instance they target, as
+// "<profile>/<plugin>", see AdminClient.ForPlugin.
+const adminTargetMetadata = "flavour-plugin"
+
+// adminServers are the admin services of the process by address. The plugin instances with the same
+// adminAddress, in several profiles or under several names, share the service of the address, which
+// routes every call to the instance it targets, see serveAdmin.
+var (
+	adminServersMutex sync.Mutex
+	adminServers      = make(map[string]*sharedAdminServer)
+)
+
+// adminInstance is a plugin instance served by an admin service, with its bearer token.
+type adminInstance struct {
+	f     *FlavourClusterWide
+	token string
+}
+
+// adminInstanceKey is the context key of the plugin instance a call targets.
+type adminInstanceKey struct{}
+
+// adminServer implements the admin service for the plugin instances it serves, by target.
+type adminServer struct {
+	mutex     sync.RWMutex
+	instances map[string]adminInstance
+}
+
+func newAdminServer() *adminServer {
+	return &adminServer{instances: make(map[string]adminInstance)}
+}
+
+// adminTarget returns the target of the plugin instance in the calls of the admin service.
+func (f *FlavourClusterWide) adminTarget() string {
+	return profileName(f.handle) + "/" + f.Name()
+}
+
+// route returns the instance named by the target metadata of a call, or the only instance served when
+// the call names none.
+func (s *adminServer) route(targets []string) (adminInstance, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if len(targets) == 0 {
+		if len(s.instances) == 1 {
+			for _, instance := range s.instances {
+				return instance, nil
+			}
+		}
+		return adminInstance{}, status.Errorf(codes.FailedPrecondition, "%d plugin instances serve the admin service, select one by profile and plugin name", len(s.instances))
+	}
+	instance, ok := s.instances[targets[0]]
+	if !ok {
+		return adminInstance{}, status.Errorf(codes.NotFound, "no plugin instance %s serves the admin service", targets[0])
+	}
+	return instance, nil
+}
+
+// authenticate routes the call to the instance it targets, and rejects it without the bearer token of
+// that instance in its authorization metadata.
+func (s *adminServer) authenticate(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	instance, err := s.route(md.Get(adminTargetMetadata))
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range md.Get("authorization") {
+		if bearer, ok := strings.CutPrefix(value, "Bearer "); ok && subtle.ConstantTimeCompare([]byte(bearer), []byte(instance.token)) == 1 {
+			return handler(context.WithValue(ctx, adminInstanceKey{}, instance.f), req)
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+}
+
+func (f *FlavourClusterWide) adminRefresh(ctx context.Context, _ *RefreshRequest) (any, error) {
+	f.cacheMutex.Lock()
+	f.lastUpdated = time.Time{}
+	f.cacheMutex.Unlock()
+	f.updateCacheIfNeeded(ctx)
+
+	f.cacheMutex.RLock()
+	defer f.cacheMutex.RUnlock()
+	return &RefreshResponse{Nodes: len(f.cache)}, nil
+}
+
+func (f *FlavourClusterWide) adminPauseFlavour(_ context.Context, in *FlavourRequest) (any, error) {
+	if in.Flavour == "" {
+		return nil, status.Error(codes.InvalidArgument, "flavour is required")
+	}
+	o := f.overrides
+	o.mutex.Lock()
+	o.paused.Insert(in.Flavour)
+	o.mutex.Unlock()
+	f.logger.Info("Scoring of flavour paused through the admin service", "flavour", in.Flavour)
+	return &AdminResponse{}, nil
+}
+
+func (f *FlavourClusterWide) adminResumeFlavour(_ context.Context, in *FlavourRequest) (any, error) {
+	if in.Flavour == "" {
+		return nil, status.Error(codes.InvalidArgument, "flavour is required")
+	}
+	o := f.overrides
+	o.mutex.Lock()
+	o.paused.Delete(in.Flavour)
+	o.mutex.Unlock()
+	f.logger.Info("Scoring of flavour resumed through the admin service", "flavour", in.Flavour)
+	return &AdminResponse{}, nil
+}
+
+func (f *FlavourClusterWide) adminSetCapOverride(_ context.Context, in *CapOverrideRequest) (any, error) {
+	if in.Flavour == "" {
+		return nil, status.Error(codes.InvalidArgument, "flavour is required")
+	}
+	if in.MaxPerNode < 0 || in.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "maxPerNode and durationSeconds must be greater than or equal to 0")
+	}
+	duration := time.Duration(in.DurationSeconds) * time.Second
+	o := f.overrides
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if duration == 0 {
+		delete(o.caps, in.Flavour)
+		f.logger.Info("Cap override of flavour removed through the admin service", "flavour", in.Flavour)
+		return &AdminResponse{}, nil
+	}
+	o.caps[in.Flavour] = CapOverride{MaxPerNode: in.MaxPerNode, Expires: f.clock.Now().Add(duration)}
+	f.logger.Info("Flavour capped through the admin service", "flavour", in.Flavour, "maxPerNode", in.MaxPerNode, "duration", duration)
+	return &AdminResponse{}, nil
+}
+
+func (f *FlavourClusterWide) adminDumpSnapshot(_ context.Context, _ *DumpSnapshotRequest) (any, error) {
+	f.cacheMutex.RLock()
+	out := &DumpSnapshotResponse{
+		LabelName:   f.labelName(),
+		LastUpdated: f.lastUpdated,
+		Cache:       make(map[string]map[string]int, len(f.cache)),
+	}
+	for node, counts := range f.cache {
+		out.Cache[node] = make(map[string]int, len(counts))
+		for flavour, count := range counts {
+			out.Cache[node][flavour] = count
+		}
+	}
+	f.cacheMutex.RUnlock()
+
+	o := f.overrides
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	out.PausedFlavours = sets.List(o.paused)
+	now := f.clock.Now()
+	for flavour, override := range o.caps {
+		if now.Before(override.Expires) {
+			if out.CapOverrides == nil {
+				out.CapOverrides = make(map[string]CapOverride)
+			}
+			out.CapOverrides[flavour] = override
+		}
+	}
+	return out, nil
+}
+
+// adminMethod describes a unary method of the admin service decoding its request into a Req, called on
+// the plugin instance the call targets.
+func adminMethod[Req any](name string, call func(*FlavourClusterWide, context.Context, *Req) (any, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			in := new(Req)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			handler := func(ctx context.Context, req any) (any, error) {
+				f, ok := ctx.Value(adminInstanceKey{}).(*FlavourClusterWide)
+				if !ok {
+					return nil, status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+				}
+				return call(f, ctx, req.(*Req))
+			}
+			if interceptor == nil {
+				return handler(ctx, in)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + AdminServiceName + "/" + name}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
+
+var adminServiceDesc = grpc.ServiceDesc{
+	ServiceName: AdminServiceName,
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{
+		adminMethod("Refresh", (*FlavourClusterWide).adminRefresh),
+		adminMethod("PauseFlavour", (*FlavourClusterWide).adminPauseFlavour),
+		adminMethod("ResumeFlavour", (*FlavourClusterWide).adminResumeFlavour),
+		adminMethod("SetCapOverride", (*FlavourClusterWide).adminSetCapOverride),
+		adminMethod("DumpSnapshot", (*FlavourClusterWide).adminDumpSnapshot),
+	},
+}
+
+// jsonCodec encodes the messages of the admin service in JSON, so that they need no generated code.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+// grpcServer returns the gRPC server of the admin service.
+func (s *adminServer) grpcServer(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts, grpc.ForceServerCodec(jsonCodec{}), grpc.UnaryInterceptor(s.authenticate))
+	server := grpc.NewServer(opts...)
+	server.RegisterService(&adminServiceDesc, s)
+	return server
+}
+
+// newAdminServer returns the gRPC server of the admin service of the plugin alone, authenticating the
+// calls with token.
+func (f *FlavourClusterWide) newAdminServer(token string, opts ...grpc.ServerOption) *grpc.Server {
+	s := newAdminServer()
+	s.instances[f.adminTarget()] = adminInstance{f: f, token: token}
+	return s.grpcServer(opts...)
+}
+
+// adminCredentials returns the server option serving the admin service over TLS with the certificate
+// and private key of the files, or no option when they are not set. Args validation only allows plain
+// text on a loopback address.
+func adminCredentials(certFile, keyFile string) ([]grpc.ServerOption, error) {
+	if certFile == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the admin TLS certificate: %v", err)
+	}
+	config := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(config))}, nil
+}
+
+// sharedAdminServer is the admin service of an address, shared by the plugin instances serving it.
+type sharedAdminServer struct {
+	*adminServer
+	server   *grpc.Server
+	listener net.Listener
+	// certFile and keyFile are the TLS files the service was started with, which the instances joining
+	// it must share.
+	certFile, keyFile string
+	// stopped is closed once the server stopped serving.
+	stopped chan struct{}
+}
+
+// serveAdmin serves the plugin on the admin service of address until the context is done, over TLS
+// when certFile and keyFile are set. The bearer token is read from tokenFile, with surrounding
+// whitespace trimmed. The first instance serving an address starts its service, the others join it
+// with their own token, and the last one to leave stops it.
+func (f *FlavourClusterWide) serveAdmin(ctx context.Context, address, tokenFile, certFile, keyFile string) error {
+	data, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return fmt.Errorf("error reading admin token: %v", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return fmt.Errorf("admin token file %s is empty", tokenFile)
+	}
+
+	target := f.adminTarget()
+	adminServersMutex.Lock()
+	defer adminServersMutex.Unlock()
+	shared, ok := adminServers[address]
+	if ok {
+		if shared.certFile != certFile || shared.keyFile != keyFile {
+			return fmt.Errorf("the admin service on %s is already served with other TLS files", address)
+		}
+		shared.mutex.Lock()
+		_, duplicate := shared.instances[target]
+		shared.mutex.Unlock()
+		if duplicate {
+			return fmt.Errorf("plugin instance %s already serves the admin service on %s", target, address)
+		}
+	} else {
+		if shared, err = f.startAdminServer(address, certFile, keyFile); err != nil {
+			return err
+		}
+		adminServers[address] = shared
+	}
+	shared.mutex.Lock()
+	shared.instances[target] = adminInstance{f: f, token: token}
+	shared.mutex.Unlock()
+
+	f.runInBackground(func() {
+		<-ctx.Done()
+		adminServersMutex.Lock()
+		shared.mutex.Lock()
+		delete(shared.instances, target)
+		last := len(shared.instances) == 0
+		shared.mutex.Unlock()
+		if last {
+			delete(adminServers, address)
+		}
+		adminServersMutex.Unlock()
+		if last {
+			shared.server.GracefulStop()
+			<-shared.stopped
+		}
+	})
+	f.logger.Info("Admin service serving the plugin", "address", shared.listener.Addr().String(), "target", target)
+	return nil
+}
+
+// startAdminServer starts the admin service of address, with no instance yet.
+func (f *FlavourClusterWide) startAdminServer(address, certFile, keyFile string) (*sharedAdminServer, error) {
+	opts, err := adminCredentials(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on %s: %v", address, err)
+	}
+	shared := &sharedAdminServer{
+		adminServer: newAdminServer(),
+		listener:    listener,
+		certFile:    certFile,
+		keyFile:     keyFile,
+		stopped:     make(chan struct{}),
+	}
+	shared.server = shared.grpcServer(opts...)
+	// The service outlives the instance starting it when others joined it, so it is not part of the
+	// background work of the instance; the last instance leaving waits for it to stop.
+	logger := f.logger
+	go func() {
+		defer close(shared.stopped)
+		if err := shared.server.Serve(listener); err != nil {
+			logger.Error(err, "Admin service stopped")
+		}
+	}()
+	logger.Info("Admin service listening", "address", listener.Addr().String(), "tls", len(opts) > 0)
+	return shared, nil
+}
+
+// AdminClient calls the admin service of a FlavourClusterWide plugin.
+type AdminClient struct {
+	conn  grpc.ClientConnInterface
+	token string
+	// target is the plugin instance the calls target, "" for the only instance of the service.
+	target string
+}
+
+// NewAdminClient returns a client of the admin service on conn, authenticating with the bearer token.
+func NewAdminClient(conn grpc.ClientConnInterface, token string) *AdminClient {
+	return &AdminClient{conn: conn, token: token}
+}
+
+// ForPlugin returns a client of the plugin instance named plugin in the scheduler profile, for the
+// admin services shared by several instances, with several profiles or instances under other names.
+// The token must be the one of that instance.
+func (c *AdminClient) ForPlugin(profile, plugin string) *AdminClient {
+	return &AdminClient{conn: c.conn, token: c.token, target: profile + "/" + plugin}
+}
+
+func (c *AdminClient) invoke(ctx context.Context, method string, in, out any) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.token)
+	if c.target != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, adminTargetMetadata, c.target)
+	}
+	return c.conn.Invoke(ctx, "/"+AdminServiceName+"/"+method, in, out, grpc.ForceCodec(jsonCodec{}))
+}
+
+// Refresh forces a rebuild of the cache and returns the number of nodes it holds.
+func (c *AdminClient) Refresh(ctx context.Context) (int, error) {
+	out := &RefreshResponse{}
+	err := c.invoke(ctx, "Refresh", &RefreshRequest{}, out)
+	return out.Nodes, err
+}
+
+// PauseFlavour makes every node score 0 for the pods of the flavour until ResumeFlavour.
+func (c *AdminClient) PauseFlavour(ctx context.Context, flavour string) error {
+	return c.invoke(ctx, "PauseFlavour", &FlavourRequest{Flavour: flavour}, &AdminResponse{})
+}
+
+// ResumeFlavour resumes the scoring of the pods of the flavour.
+func (c *AdminClient) ResumeFlavour(ctx context.Context, flavour string) error {
+	return c.invoke(ctx, "ResumeFlavour", &FlavourRequest{Flavour: flavour}, &AdminResponse{})
+}
+
+// SetCapOverride caps the pods of the flavour per node for the duration, in whole seconds, or removes
+// the cap with a duration of 0.
+func (c *AdminClient) SetCapOverride(ctx context.Context, flavour string, maxPerNode int, duration time.Duration) error {
+	in := &CapOverrideRequest{Flavour: flavour, MaxPerNode: maxPerNode, DurationSeconds: int64(duration / time.Second)}
+	return c.invoke(ctx, "SetCapOverride", in, &AdminResponse{})
+}
+
+// DumpSnapshot returns the cache of the plugin and the overrides in effect.
+func (c *AdminClient) DumpSnapshot(ctx context.Context) (*DumpSnapshotResponse, error) {
+	out := &DumpSnapshotResponse{}
+	if err := c.invoke(ctx, "DumpSnapshot", &DumpSnapshotRequest{}, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
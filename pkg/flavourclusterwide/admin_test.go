@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	v1 "k8s.io/api/core/v1"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	certutil "k8s.io/client-go/util/cert"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// dialAdmin serves the admin service of the plugin in memory and returns a connection to it.
+func dialAdmin(t *testing.T, f *FlavourClusterWide, token string) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := f.newAdminServer(token)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///admin",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestAdminAuthentication(t *testing.T) {
+	f := newTestPlugin(nil, map[string]map[string]int{})
+	f.overrides = newAdminOverrides()
+	conn := dialAdmin(t, f, "secret")
+
+	for _, token := range []string{"", "guess"} {
+		_, err := NewAdminClient(conn, token).DumpSnapshot(context.Background())
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("token %q: expected an Unauthenticated error, got %v", token, err)
+		}
+	}
+	if _, err := NewAdminClient(conn, "secret").DumpSnapshot(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestAdminTLS(t *testing.T) {
+	certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey("flavour-admin", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	if err := os.WriteFile(certFile, certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := adminCredentials(certFile, certFile); err == nil {
+		t.Errorf("expected an error loading a certificate as the private key")
+	}
+	opts, err := adminCredentials(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f := newTestPlugin(nil, map[string]map[string]int{})
+	f.overrides = newAdminOverrides()
+	listener := bufconn.Listen(1 << 20)
+	server := f.newAdminServer("secret", opts...)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	dial := func(creds credentials.TransportCredentials) *AdminClient {
+		conn, err := grpc.NewClient("passthrough:///flavour-admin",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(creds),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		return NewAdminClient(conn, "secret")
+	}
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(certPEM)
+	if _, err := dial(credentials.NewTLS(&tls.Config{RootCAs: roots})).DumpSnapshot(context.Background()); err != nil {
+		t.Errorf("unexpected error over TLS: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := dial(insecure.NewCredentials()).DumpSnapshot(ctx); err == nil {
+		t.Errorf("expected the plain text call to fail")
+	}
+}
+
+func TestAdminOverrides(t *testing.T) {
+	ctx := context.Background()
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	cache := map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 1},
+		"node3": {"gold": 2},
+	}
+	f := newTestPlugin(nodes, cache)
+	f.scoringStrategy = pluginConfig.FlavourScoringProportional
+	f.overrides = newAdminOverrides()
+	fakeClock := f.clock.(*clocktesting.FakeClock)
+	client := NewAdminClient(dialAdmin(t, f, "secret"), "secret")
+	pod := makePod("default", "p", "", flavoured("gold"))
+
+	if err := client.PauseFlavour(ctx, "gold"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]int64{"node1": 0, "node2": 0, "node3": 0}, scoreNodes(t, f, pod)); diff != "" {
+		t.Errorf("unexpected scores of a paused flavour (-want,+got):\n%s", diff)
+	}
+	if err := client.ResumeFlavour(ctx, "gold"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]int64{"node1": 100, "node2": 50, "node3": 0}, scoreNodes(t, f, pod)); diff != "" {
+		t.Errorf("unexpected scores of a resumed flavour (-want,+got):\n%s", diff)
+	}
+
+	if err := client.SetCapOverride(ctx, "gold", 1, 30*time.Second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]int64{"node1": 100, "node2": 0, "node3": 0}, scoreNodes(t, f, pod)); diff != "" {
+		t.Errorf("unexpected scores of a capped flavour (-want,+got):\n%s", diff)
+	}
+	snapshot, err := client.DumpSnapshot(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(cache, snapshot.Cache); diff != "" {
+		t.Errorf("unexpected cache (-want,+got):\n%s", diff)
+	}
+	if override := snapshot.CapOverrides["gold"]; override.MaxPerNode != 1 || !override.Expires.Equal(fakeClock.Now().Add(30*time.Second)) {
+		t.Errorf("unexpected cap override %+v", override)
+	}
+
+	fakeClock.Step(31 * time.Second)
+	if diff := cmp.Diff(map[string]int64{"node1": 100, "node2": 50, "node3": 0}, scoreNodes(t, f, pod)); diff != "" {
+		t.Errorf("unexpected scores after the cap override expired (-want,+got):\n%s", diff)
+	}
+
+	if err := client.SetCapOverride(ctx, "", 1, time.Minute); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected an InvalidArgument error, got %v", err)
+	}
+}
+
+func TestAdminRefresh(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	f := newTestPlugin(nodes, map[string]map[string]int{"node1": {"gold": 1}})
+	f.store = apiStore{client: clientsetfake.NewSimpleClientset(nodes[0], nodes[1], makePod("default", "p1", "node2", flavoured("gold")))}
+	f.overrides = newAdminOverrides()
+	client := NewAdminClient(dialAdmin(t, f, "secret"), "secret")
+
+	// The cache is still within its TTL, but the refresh rebuilds it.
+	got, err := client.Refresh(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 2 {
+		t.Errorf("expected 2 nodes, got %d", got)
+	}
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 1},
+	})
+}
+
+func TestAdminSharedAddress(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := func(name, token string) string {
+		file := filepath.Join(dir, name)
+		if err := os.WriteFile(file, []byte(token+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+	serve := func(name, token string) (*FlavourClusterWide, context.CancelFunc, error) {
+		f := newTestPlugin(nil, map[string]map[string]int{})
+		f.name = name
+		f.overrides = newAdminOverrides()
+		ctx, cancel := context.WithCancel(context.Background())
+		return f, cancel, f.serveAdmin(ctx, "127.0.0.1:0", tokenFile(name, token), "", "")
+	}
+
+	// Two instances of the process share the address, each with its own token.
+	first, cancelFirst, err := serve("FlavourClusterWide", "first")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, cancelSecond, err := serve("TeamClusterWide", "second")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := serve("FlavourClusterWide", "again"); err == nil {
+		t.Errorf("expected an error serving the same instance twice")
+	}
+	if err := second.serveAdmin(context.Background(), "127.0.0.1:0", tokenFile("tls", "tls"), "tls.crt", "tls.key"); err == nil {
+		t.Errorf("expected an error joining the service with other TLS files")
+	}
+
+	adminServersMutex.Lock()
+	shared := adminServers["127.0.0.1:0"]
+	adminServersMutex.Unlock()
+	conn, err := grpc.NewClient(shared.listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer conn.Close()
+	ctx := context.Background()
+
+	if err := NewAdminClient(conn, "first").PauseFlavour(ctx, "gold"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected a FailedPrecondition error without a target, got %v", err)
+	}
+	if err := NewAdminClient(conn, "first").ForPlugin("default-scheduler", "Other").PauseFlavour(ctx, "gold"); status.Code(err) != codes.NotFound {
+		t.Errorf("expected a NotFound error for an unknown instance, got %v", err)
+	}
+	if err := NewAdminClient(conn, "first").ForPlugin("default-scheduler", "TeamClusterWide").PauseFlavour(ctx, "gold"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an Unauthenticated error with the token of another instance, got %v", err)
+	}
+	if err := NewAdminClient(conn, "second").ForPlugin("default-scheduler", "TeamClusterWide").PauseFlavour(ctx, "gold"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.overrides.isPaused("gold") || !second.overrides.isPaused("gold") {
+		t.Errorf("expected only the targeted instance to pause gold")
+	}
+
+	// The service keeps serving the remaining instance, which no longer needs a target, and stops with
+	// the last one.
+	cancelFirst()
+	first.background.Wait()
+	if err := NewAdminClient(conn, "second").ResumeFlavour(ctx, "gold"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if second.overrides.isPaused("gold") {
+		t.Errorf("expected gold to be resumed")
+	}
+	cancelSecond()
+	second.background.Wait()
+	adminServersMutex.Lock()
+	defer adminServersMutex.Unlock()
+	if _, ok := adminServers["127.0.0.1:0"]; ok {
+		t.Errorf("expected the admin service to stop with the last instance")
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+)
+
+// affinityNodes returns the nodes of the scheduler snapshot matching the node selector and the required
+// node affinity of the pod, or nil when the pod has neither. The pod cannot land on the other nodes, so a
+// minimum computed with them would leave every allowed node without the full score. It is only needed
+// when the feasible nodes of the cycle are not known, as they already match.
+func (f *FlavourClusterWide) affinityNodes(pod *v1.Pod) sets.Set[string] {
+	affinity := pod.Spec.Affinity
+	if len(pod.Spec.NodeSelector) == 0 && (affinity == nil || affinity.NodeAffinity == nil ||
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil) {
+		return nil
+	}
+	nodeInfos, err := f.handle.SnapshotSharedLister().NodeInfos().List()
+	if err != nil {
+		f.logger.Error(err, "Error listing nodes from snapshot")
+		return nil
+	}
+	required := nodeaffinity.GetRequiredNodeAffinity(pod)
+	matching := sets.New[string]()
+	for _, nodeInfo := range nodeInfos {
+		if match, _ := required.Match(nodeInfo.Node()); match {
+			matching.Insert(nodeInfo.Node().Name)
+		}
+	}
+	return matching
+}
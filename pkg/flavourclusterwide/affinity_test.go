@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestScoreNodeAffinity(t *testing.T) {
+	gpuWorker := func(name string) *v1.Node {
+		node := makeWorker(name)
+		node.Labels["gpu"] = "true"
+		return node
+	}
+	nodes := []*v1.Node{makeWorker("node1"), gpuWorker("node2"), gpuWorker("node3")}
+	// node1 is the least loaded node, but it has no GPU.
+	cache := map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 2},
+		"node3": {"gold": 1},
+	}
+	requireGPU := &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+			NodeSelectorTerms: []v1.NodeSelectorTerm{{
+				MatchExpressions: []v1.NodeSelectorRequirement{{Key: "gpu", Operator: v1.NodeSelectorOpIn, Values: []string{"true"}}},
+			}},
+		},
+	}}
+
+	tests := []struct {
+		name         string
+		nodeSelector map[string]string
+		affinity     *v1.Affinity
+		want         map[string]int64
+	}{
+		{
+			name: "no node selector",
+			want: map[string]int64{"node2": 0, "node3": 0},
+		},
+		{
+			name:         "node selector",
+			nodeSelector: map[string]string{"gpu": "true"},
+			want:         map[string]int64{"node2": 0, "node3": 100},
+		},
+		{
+			name:     "required node affinity",
+			affinity: requireGPU,
+			want:     map[string]int64{"node2": 0, "node3": 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			pod := makePod("default", "p", "", flavoured("gold"))
+			pod.Spec.NodeSelector = tt.nodeSelector
+			pod.Spec.Affinity = tt.affinity
+
+			got := scoreNodes(t, f, pod)
+			// The scheduler does not score node1, which does not match.
+			delete(got, "node1")
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// recentPlacements returns the placement times of the flavoured pods bound after since, per node and
+// flavour. It is the age-weighting counterpart of BuildSnapshot.
+func recentPlacements(pods []v1.Pod, labelName string, since time.Time) map[string]map[string][]time.Time {
+	placements := make(map[string]map[string][]time.Time)
+	for i := range pods {
+		pod := &pods[i]
+		flavour := pod.Labels[labelName]
+		if pod.Spec.NodeName == "" || flavour == "" {
+			continue
+		}
+		placed := placementTime(pod)
+		if !placed.After(since) {
+			continue
+		}
+		if _, exists := placements[pod.Spec.NodeName]; !exists {
+			placements[pod.Spec.NodeName] = make(map[string][]time.Time)
+		}
+		placements[pod.Spec.NodeName][flavour] = append(placements[pod.Spec.NodeName][flavour], placed)
+	}
+	return placements
+}
+
+// placementTime returns the last transition of the PodScheduled condition of the pod, or its creation
+// time when the condition is missing.
+func placementTime(pod *v1.Pod) time.Time {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionTrue {
+			return condition.LastTransitionTime.Time
+		}
+	}
+	return pod.CreationTimestamp.Time
+}
+
+// weightedCount returns the count of pods of the flavour on the node, in hundredths of a pod when
+// age-weighted counting is enabled: pods placed within the window weigh recentWeightPercent and the
+// older ones 100. Without age weighting, it is the plain count.
+// The cache mutex must be held by the caller.
+func (f *FlavourClusterWide) weightedCount(node, flavour string, now time.Time) int {
+	count := f.cache[node][flavour]
+	if f.recentWindow == 0 {
+		return count
+	}
+	recent := 0
+	for _, placed := range f.recentPlacements[node][flavour] {
+		if now.Sub(placed) < f.recentWindow {
+			recent++
+		}
+	}
+	recent = min(recent, count)
+	return count*100 + recent*(int(f.recentWeightPercent)-100)
+}
+
+// placementStep returns what placing the pod adds to the weighted count of its node: its weight, or
+// recentWeightPercent with age weighting, a pod being placed being the most recent of all.
+func (f *FlavourClusterWide) placementStep(pod *v1.Pod) int {
+	if f.recentWindow == 0 {
+		return f.podWeight(pod)
+	}
+	return int(f.recentWeightPercent)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestRecentPlacements(t *testing.T) {
+	now := time.Now()
+	scheduledAt := func(pod *v1.Pod, placed time.Time) v1.Pod {
+		pod.Status.Conditions = []v1.PodCondition{{
+			Type:               v1.PodScheduled,
+			Status:             v1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(placed),
+		}}
+		return *pod
+	}
+	createdAt := func(pod *v1.Pod, created time.Time) v1.Pod {
+		pod.CreationTimestamp = metav1.NewTime(created)
+		return *pod
+	}
+	pods := []v1.Pod{
+		scheduledAt(makePod("default", "recent", "node1", flavoured("gold")), now.Add(-time.Minute)),
+		scheduledAt(makePod("default", "old", "node1", flavoured("gold")), now.Add(-time.Hour)),
+		createdAt(makePod("default", "no-condition", "node2", flavoured("silver")), now.Add(-2*time.Minute)),
+		scheduledAt(makePod("default", "pending", "", flavoured("gold")), now.Add(-time.Minute)),
+	}
+
+	got := recentPlacements(pods, "flavour", now.Add(-5*time.Minute))
+	want := map[string]map[string][]time.Time{
+		"node1": {"gold": {pods[0].Status.Conditions[0].LastTransitionTime.Time}},
+		"node2": {"silver": {pods[2].CreationTimestamp.Time}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected placements (-want,+got):\n%s", diff)
+	}
+}
+
+func TestScoreAgeWeighted(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	newPlugin := func(window time.Duration) (*FlavourClusterWide, *clocktesting.FakeClock) {
+		f := newTestPlugin(nodes, map[string]map[string]int{
+			"node1": {"gold": 1},
+			"node2": {"gold": 2},
+		})
+		fakeClock := f.clock.(*clocktesting.FakeClock)
+		f.recentWindow = window
+		f.recentWeightPercent = 150
+		return f, fakeClock
+	}
+	pod := makePod("default", "p", "", flavoured("gold"))
+
+	// Without age weighting, node1 and node2 are even once node1 got a new pod.
+	f, _ := newPlugin(0)
+	f.PostBind(context.Background(), nil, pod, "node1")
+	if diff := cmp.Diff(map[string]int64{"node1": 100, "node2": 100}, scoreNodes(t, f, pod)); diff != "" {
+		t.Errorf("unexpected scores without age weighting (-want,+got):\n%s", diff)
+	}
+
+	// With age weighting, the recent placement on node1 weighs more than the old pods on node2.
+	f, fakeClock := newPlugin(5 * time.Minute)
+	f.PostBind(context.Background(), nil, pod, "node1")
+	if diff := cmp.Diff(map[string]int64{"node1": 0, "node2": 100}, scoreNodes(t, f, pod)); diff != "" {
+		t.Errorf("unexpected scores with a recent placement (-want,+got):\n%s", diff)
+	}
+
+	// Once the window elapsed, the placement weighs like the other pods.
+	fakeClock.Step(5 * time.Minute)
+	f.lastUpdated = fakeClock.Now()
+	if diff := cmp.Diff(map[string]int64{"node1": 100, "node2": 100}, scoreNodes(t, f, pod)); diff != "" {
+		t.Errorf("unexpected scores after the window (-want,+got):\n%s", diff)
+	}
+}
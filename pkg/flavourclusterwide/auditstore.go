@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+const (
+	// auditQueueSize bounds the audit records waiting to be stored; records are dropped when it is full.
+	auditQueueSize = 10000
+	// auditBatchSize is the number of records stored at once, unless auditFlushInterval passes first.
+	auditBatchSize = 100
+	// auditFlushInterval bounds how long a record waits for its batch to fill up.
+	auditFlushInterval = 5 * time.Second
+	// auditTimeout bounds the storing of a batch.
+	auditTimeout = 10 * time.Second
+)
+
+// AuditRecord is the record of the bind of a flavoured pod written to the audit store.
+type AuditRecord struct {
+	Time      time.Time `json:"time"`
+	Plugin    string    `json:"plugin"`
+	Namespace string    `json:"namespace"`
+	Pod       string    `json:"pod"`
+	UID       string    `json:"uid"`
+	Flavour   string    `json:"flavour"`
+	Node      string    `json:"node"`
+	NodeGroup string    `json:"nodeGroup,omitempty"`
+	// PlacementQuality is the normalized score of the node, nil when the cycle did not score the nodes.
+	PlacementQuality *int64 `json:"placementQuality,omitempty"`
+}
+
+// AuditStore keeps the audit records of the bind decisions outside of the scheduler, for as long as
+// the retention of the organization requires. The plugin comes with Loki and PostgreSQL stores, selected
+// by auditStore, and scheduler builds can provide their own store with WithAuditStore.
+type AuditStore interface {
+	// Store writes the records, in the order of the binds. It is called from a single background
+	// goroutine and must return by the deadline of the context. A batch that failed is dropped.
+	// Stores that are also an io.Closer are closed when the plugin is closed.
+	Store(ctx context.Context, records []AuditRecord) error
+}
+
+// newAuditStore returns the built-in store of the configuration.
+func newAuditStore(config *pluginConfig.FlavourAuditStore, plugin string) (AuditStore, error) {
+	switch config.Type {
+	case pluginConfig.FlavourAuditStoreLoki:
+		return &lokiStore{
+			url:      config.URL,
+			tenantID: config.TenantID,
+			plugin:   plugin,
+			client:   &http.Client{Timeout: auditTimeout},
+		}, nil
+	case pluginConfig.FlavourAuditStorePostgreSQL:
+		return newPostgreSQLStore(config.Driver, config.ConnectionStringFile, config.Table)
+	}
+	return nil, fmt.Errorf("unsupported audit store type %q", config.Type)
+}
+
+// auditTrail queues the audit records and stores them in batches in the background, so that auditing
+// never delays scheduling.
+type auditTrail struct {
+	store  AuditStore
+	plugin string
+	logger klog.Logger
+	queue  chan AuditRecord
+}
+
+func newAuditTrail(store AuditStore, plugin string, logger klog.Logger) *auditTrail {
+	return &auditTrail{
+		store:  store,
+		plugin: plugin,
+		logger: logger,
+		queue:  make(chan AuditRecord, auditQueueSize),
+	}
+}
+
+// record queues the record, or drops it when the queue is full.
+func (a *auditTrail) record(record AuditRecord) {
+	select {
+	case a.queue <- record:
+	default:
+		auditRecordsDropped.WithLabelValues(a.plugin, "QueueFull").Inc()
+		a.logger.V(4).Info("Dropping audit record, the queue is full", "pod", klog.KRef(record.Namespace, record.Pod))
+	}
+}
+
+// run stores the queued records until the context is done, and then stores the records still queued
+// and closes the store, if it is an io.Closer.
+func (a *auditTrail) run(ctx context.Context) {
+	if closer, ok := a.store.(io.Closer); ok {
+		defer closer.Close()
+	}
+	ticker := time.NewTicker(auditFlushInterval)
+	defer ticker.Stop()
+	batch := make([]AuditRecord, 0, auditBatchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			for {
+				select {
+				case record := <-a.queue:
+					batch = append(batch, record)
+				default:
+					// The context of the plugin is done, the last batch gets a context of its own.
+					a.flush(context.Background(), batch)
+					return
+				}
+			}
+		case record := <-a.queue:
+			batch = append(batch, record)
+			if len(batch) < auditBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+		a.flush(ctx, batch)
+		batch = make([]AuditRecord, 0, auditBatchSize)
+	}
+}
+
+// flush stores the batch, if any.
+func (a *auditTrail) flush(ctx context.Context, batch []AuditRecord) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, auditTimeout)
+	defer cancel()
+	if err := a.store.Store(ctx, batch); err != nil {
+		auditRecordsDropped.WithLabelValues(a.plugin, "StoreError").Add(float64(len(batch)))
+		a.logger.Error(err, "Error storing audit records", "count", len(batch))
+	}
+}
+
+// recordAudit queues the audit record of the bind of the flavoured pod, if an audit store is configured.
+// The records are not sampled.
+func (f *FlavourClusterWide) recordAudit(update bindUpdate) {
+	if f.audit == nil {
+		return
+	}
+	record := AuditRecord{
+		Time:      f.clock.Now(),
+		Plugin:    f.Name(),
+		Namespace: update.pod.Namespace,
+		Pod:       update.pod.Name,
+		UID:       string(update.pod.UID),
+		Flavour:   update.flavour,
+		Node:      update.nodeName,
+	}
+	if f.handle != nil {
+		record.NodeGroup = f.nodeGroup(update.nodeName)
+	}
+	if update.scored {
+		quality := update.quality
+		record.PlacementQuality = &quality
+	}
+	f.audit.record(record)
+}
+
+// lokiStore pushes the audit records to the push API of Loki, as the JSON lines of a single stream
+// labelled with the plugin.
+type lokiStore struct {
+	url      string
+	tenantID string
+	plugin   string
+	client   *http.Client
+}
+
+// lokiPush is the body of a request to the push API of Loki.
+type lokiPush struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+// lokiStream is a stream of a lokiPush. Values are pairs of a timestamp in nanoseconds and a line.
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func (s *lokiStore) Store(ctx context.Context, records []AuditRecord) error {
+	stream := lokiStream{
+		Stream: map[string]string{"source": "scheduler-plugins", "plugin": s.plugin},
+		Values: make([][2]string, 0, len(records)),
+	}
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(record.Time.UnixNano(), 10), string(line)})
+	}
+	body, err := json.Marshal(lokiPush{Streams: []lokiStream{stream}})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.tenantID != "" {
+		req.Header.Set("X-Scope-OrgID", s.tenantID)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// postgreSQLStore inserts the audit records into a table of PostgreSQL, through the database/sql driver
+// registered under the configured name by the scheduler build, such as pgx or lib/pq.
+type postgreSQLStore struct {
+	db    *sql.DB
+	table string
+	// created is set once the table is known to exist. Store is never called concurrently.
+	created bool
+}
+
+// newPostgreSQLStore opens the database of the connection string held by the file. The database is
+// only connected to when records are stored, so that an unreachable database does not keep the
+// scheduler from starting.
+func newPostgreSQLStore(driver, connectionStringFile, table string) (*postgreSQLStore, error) {
+	data, err := os.ReadFile(connectionStringFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the connection string: %v", err)
+	}
+	db, err := sql.Open(driver, strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, err
+	}
+	return &postgreSQLStore{db: db, table: table}, nil
+}
+
+func (s *postgreSQLStore) Store(ctx context.Context, records []AuditRecord) error {
+	if !s.created {
+		// The table name is validated as an identifier, it cannot be a parameter.
+		if _, err := s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+s.table+` (
+			time TIMESTAMPTZ NOT NULL,
+			plugin TEXT NOT NULL,
+			namespace TEXT NOT NULL,
+			pod TEXT NOT NULL,
+			uid TEXT NOT NULL,
+			flavour TEXT NOT NULL,
+			node TEXT NOT NULL,
+			node_group TEXT,
+			placement_quality BIGINT
+		)`); err != nil {
+			return fmt.Errorf("error creating table %s: %v", s.table, err)
+		}
+		s.created = true
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO `+s.table+
+		` (time, plugin, namespace, pod, uid, flavour, node, node_group, placement_quality) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, record := range records {
+		var nodeGroup, quality any
+		if record.NodeGroup != "" {
+			nodeGroup = record.NodeGroup
+		}
+		if record.PlacementQuality != nil {
+			quality = *record.PlacementQuality
+		}
+		if _, err := stmt.ExecContext(ctx, record.Time, record.Plugin, record.Namespace, record.Pod, record.UID,
+			record.Flavour, record.Node, nodeGroup, quality); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *postgreSQLStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metricstestutil "k8s.io/component-base/metrics/testutil"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// fakeAuditStore keeps the batches it is given, or fails with err.
+type fakeAuditStore struct {
+	mutex   sync.Mutex
+	batches [][]AuditRecord
+	err     error
+}
+
+func (s *fakeAuditStore) Store(_ context.Context, records []AuditRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.batches = append(s.batches, records)
+	return nil
+}
+
+func TestAuditTrail(t *testing.T) {
+	RegisterMetrics()
+	nodes := []*v1.Node{makeNode("node1", map[string]string{WorkerNodeLabelSelector: "", v1.LabelTopologyZone: "zone-a"})}
+	f := newTestPlugin(nodes, map[string]map[string]int{"node1": {"gold": 1}})
+	f.nodeGroupLabel = v1.LabelTopologyZone
+	store := &fakeAuditStore{}
+	f.audit = newAuditTrail(store, f.Name(), logr.Discard())
+
+	state := framework.NewCycleState()
+	f.recordScores(state, framework.NodeScoreList{{Name: "node1", Score: 100}})
+	f.PostBind(context.Background(), state, makePod("default", "p1", "", flavoured("gold")), "node1")
+	f.PostBind(context.Background(), framework.NewCycleState(), makePod("default", "p2", "", flavoured("gold")), "node1")
+	// Pods without a flavour are not audited.
+	f.PostBind(context.Background(), framework.NewCycleState(), makePod("default", "p3", "", nil), "node1")
+
+	// The queued records are stored when the plugin is stopped.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f.audit.run(ctx)
+
+	quality := int64(100)
+	now := f.clock.Now()
+	want := [][]AuditRecord{{
+		{Time: now, Plugin: Name, Namespace: "default", Pod: "p1", Flavour: "gold", Node: "node1", NodeGroup: "zone-a", PlacementQuality: &quality},
+		{Time: now, Plugin: Name, Namespace: "default", Pod: "p2", Flavour: "gold", Node: "node1", NodeGroup: "zone-a"},
+	}}
+	if diff := cmp.Diff(want, store.batches); diff != "" {
+		t.Errorf("unexpected audit records (-want,+got):\n%s", diff)
+	}
+
+	// Batches the store failed are dropped and counted.
+	before, err := metricstestutil.GetCounterMetricValue(auditRecordsDropped.WithLabelValues(Name, "StoreError"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	store.err = errors.New("unavailable")
+	f.audit.flush(context.Background(), want[0])
+	after, err := metricstestutil.GetCounterMetricValue(auditRecordsDropped.WithLabelValues(Name, "StoreError"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if after-before != 2 {
+		t.Errorf("expected 2 more dropped records, got %v", after-before)
+	}
+}
+
+func TestLokiStore(t *testing.T) {
+	var got lokiPush
+	var tenant string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant = r.Header.Get("X-Scope-OrgID")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	store, err := newAuditStore(&pluginConfig.FlavourAuditStore{Type: pluginConfig.FlavourAuditStoreLoki, URL: server.URL, TenantID: "platform"}, Name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	at := time.Unix(1700000000, 5)
+	if err := store.Store(context.Background(), []AuditRecord{{Time: at, Plugin: Name, Namespace: "default", Pod: "p1", UID: "uid1", Flavour: "gold", Node: "node1"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tenant != "platform" {
+		t.Errorf("expected tenant platform, got %q", tenant)
+	}
+	line := `{"time":"` + at.Format(time.RFC3339Nano) + `","plugin":"FlavourClusterWide","namespace":"default","pod":"p1","uid":"uid1","flavour":"gold","node":"node1"}`
+	want := lokiPush{Streams: []lokiStream{{
+		Stream: map[string]string{"source": "scheduler-plugins", "plugin": Name},
+		Values: [][2]string{{"1700000000000000005", line}},
+	}}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected push (-want,+got):\n%s", diff)
+	}
+}
+
+// recordingDriver is a database/sql driver recording the statements executed through it.
+type recordingDriver struct {
+	mutex      sync.Mutex
+	dsn        string
+	statements []string
+	args       [][]driver.Value
+}
+
+var auditDriver = &recordingDriver{}
+
+func init() {
+	sql.Register("flavour-audit-recorder", auditDriver)
+}
+
+func (d *recordingDriver) Open(dsn string) (driver.Conn, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.dsn = dsn
+	return &recordingConn{driver: d}, nil
+}
+
+func (d *recordingDriver) record(statement string, args []driver.Value) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.statements = append(d.statements, strings.Join(strings.Fields(statement), " "))
+	d.args = append(d.args, args)
+}
+
+// recordingConn is a connection of recordingDriver, and its own transaction.
+type recordingConn struct {
+	driver *recordingDriver
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return &recordingStmt{driver: c.driver, query: query}, nil
+}
+
+func (c *recordingConn) Close() error { return nil }
+
+func (c *recordingConn) Begin() (driver.Tx, error) { return c, nil }
+
+func (c *recordingConn) Commit() error {
+	c.driver.record("COMMIT", nil)
+	return nil
+}
+
+func (c *recordingConn) Rollback() error { return nil }
+
+type recordingStmt struct {
+	driver *recordingDriver
+	query  string
+}
+
+func (s *recordingStmt) Close() error { return nil }
+
+func (s *recordingStmt) NumInput() int { return -1 }
+
+func (s *recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.record(s.query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *recordingStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestPostgreSQLStore(t *testing.T) {
+	auditDriver.statements, auditDriver.args = nil, nil
+	connectionStringFile := filepath.Join(t.TempDir(), "dsn")
+	if err := os.WriteFile(connectionStringFile, []byte("postgres://audit@db/audit\n"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	store, err := newAuditStore(&pluginConfig.FlavourAuditStore{
+		Type:                 pluginConfig.FlavourAuditStorePostgreSQL,
+		ConnectionStringFile: connectionStringFile,
+		Driver:               "flavour-audit-recorder",
+		Table:                "flavour_audit",
+	}, Name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer store.(*postgreSQLStore).Close()
+
+	at := time.Unix(1700000000, 0)
+	quality := int64(80)
+	records := []AuditRecord{
+		{Time: at, Plugin: Name, Namespace: "default", Pod: "p1", UID: "uid1", Flavour: "gold", Node: "node1", NodeGroup: "zone-a", PlacementQuality: &quality},
+		{Time: at, Plugin: Name, Namespace: "default", Pod: "p2", UID: "uid2", Flavour: "gold", Node: "node2"},
+	}
+	for i := 0; i < 2; i++ {
+		if err := store.Store(context.Background(), records); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if auditDriver.dsn != "postgres://audit@db/audit" {
+		t.Errorf("unexpected connection string %q", auditDriver.dsn)
+	}
+	insert := "INSERT INTO flavour_audit (time, plugin, namespace, pod, uid, flavour, node, node_group, placement_quality) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)"
+	// The table is only created by the first batch.
+	wantStatements := []string{
+		"CREATE TABLE IF NOT EXISTS flavour_audit ( time TIMESTAMPTZ NOT NULL, plugin TEXT NOT NULL, namespace TEXT NOT NULL, pod TEXT NOT NULL, uid TEXT NOT NULL, flavour TEXT NOT NULL, node TEXT NOT NULL, node_group TEXT, placement_quality BIGINT )",
+		insert, insert, "COMMIT",
+		insert, insert, "COMMIT",
+	}
+	if diff := cmp.Diff(wantStatements, auditDriver.statements); diff != "" {
+		t.Errorf("unexpected statements (-want,+got):\n%s", diff)
+	}
+	wantArgs := [][]driver.Value{
+		{at, Name, "default", "p1", "uid1", "gold", "node1", "zone-a", int64(80)},
+		{at, Name, "default", "p2", "uid2", "gold", "node2", nil, nil},
+	}
+	if diff := cmp.Diff(wantArgs, auditDriver.args[1:3]); diff != "" {
+		t.Errorf("unexpected insert arguments (-want,+got):\n%s", diff)
+	}
+}
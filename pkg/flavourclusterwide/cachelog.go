@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+)
+
+// summaryTopNodes is the number of most loaded nodes listed per flavour in a cache summary.
+const summaryTopNodes = 3
+
+// logCache logs the cache after an update at the verbosity level, in full with logCacheContents and
+// summarized otherwise. Nothing is computed below the level. The caller holds the cache mutex.
+func (f *FlavourClusterWide) logCache(level int, message string) {
+	logger := f.logger.V(level)
+	if !logger.Enabled() {
+		return
+	}
+	if f.logCacheContents {
+		logger.Info(message, "labelName", f.labelName(), "cache", f.cache)
+		return
+	}
+	logger.Info(message, "labelName", f.labelName(), "summary", summarizeCache(f.cache, summaryTopNodes))
+}
+
+// summarizeCache returns the number of nodes of the cache and, per flavour, the total count and the
+// topN nodes hosting the most pods of the flavour. Its size does not depend on the number of nodes.
+func summarizeCache(cache map[string]map[string]int, topN int) string {
+	type nodeCount struct {
+		node  string
+		count int
+	}
+	totals := make(map[string]int)
+	perFlavour := make(map[string][]nodeCount)
+	for node, counts := range cache {
+		for flavour, count := range counts {
+			totals[flavour] += count
+			if count > 0 {
+				perFlavour[flavour] = append(perFlavour[flavour], nodeCount{node: node, count: count})
+			}
+		}
+	}
+
+	flavours := make([]string, 0, len(totals))
+	for flavour := range totals {
+		flavours = append(flavours, flavour)
+	}
+	sort.Strings(flavours)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d nodes", len(cache))
+	for _, flavour := range flavours {
+		fmt.Fprintf(&b, ", %s=%d", flavour, totals[flavour])
+		nodes := perFlavour[flavour]
+		if len(nodes) == 0 {
+			continue
+		}
+		sort.Slice(nodes, func(i, j int) bool {
+			if nodes[i].count != nodes[j].count {
+				return nodes[i].count > nodes[j].count
+			}
+			return nodes[i].node < nodes[j].node
+		})
+		if len(nodes) > topN {
+			nodes = nodes[:topN]
+		}
+		tops := make([]string, 0, len(nodes))
+		for _, n := range nodes {
+			tops = append(tops, fmt.Sprintf("%s=%d", n.node, n.count))
+		}
+		fmt.Fprintf(&b, " (%s)", strings.Join(tops, " "))
+	}
+	return b.String()
+}
+
+// dumpCache logs the full cache, one line per node in name order.
+func (f *FlavourClusterWide) dumpCache() {
+	f.cacheMutex.RLock()
+	defer f.cacheMutex.RUnlock()
+
+	nodes := make([]string, 0, len(f.cache))
+	for node := range f.cache {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	f.logger.Info("Dump of the cache", "labelName", f.labelName(), "nodes", len(nodes))
+	for _, node := range nodes {
+		f.logger.Info("Dump of the cache of node", "node", node, "counts", f.cache[node])
+	}
+}
+
+// watchDumpSignal dumps the full cache every time the process receives dumpSignal, until ctx is done.
+// This is the same signal on which kube-scheduler dumps its own cache, so both are dumped together.
+// Sending it requires access to the scheduler process, such as exec permissions on its pod.
+func (f *FlavourClusterWide) watchDumpSignal(ctx context.Context) {
+	if dumpSignal == nil {
+		return
+	}
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, dumpSignal)
+	f.runInBackground(func() {
+		defer signal.Stop(ch)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+				f.dumpCache()
+			}
+		}
+	})
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"k8s.io/klog/v2/textlogger"
+)
+
+func TestSummarizeCache(t *testing.T) {
+	tests := []struct {
+		name  string
+		cache map[string]map[string]int
+		topN  int
+		want  string
+	}{
+		{
+			name: "empty cache",
+			want: "0 nodes",
+		},
+		{
+			name: "top nodes by count then name",
+			cache: map[string]map[string]int{
+				"node1": {"gold": 2, "silver": 0},
+				"node2": {"gold": 5, "silver": 1},
+				"node3": {"gold": 2, "silver": 0},
+				"node4": {"gold": 1, "silver": 0},
+			},
+			topN: 2,
+			want: "4 nodes, gold=10 (node2=5 node1=2), silver=1 (node2=1)",
+		},
+		{
+			name: "flavour without pods",
+			cache: map[string]map[string]int{
+				"node1": {"gold": 0},
+			},
+			topN: 3,
+			want: "1 nodes, gold=0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarizeCache(tt.cache, tt.topN); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLogCache(t *testing.T) {
+	cache := map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 3}}
+	tests := []struct {
+		name             string
+		logCacheContents bool
+		verbosity        int
+		want             string
+	}{
+		{
+			name:      "summary",
+			verbosity: 5,
+			want:      `"Cache updated" labelName="flavour" summary="2 nodes, gold=4 (node2=3 node1=1)"`,
+		},
+		{
+			name:             "full contents",
+			logCacheContents: true,
+			verbosity:        5,
+			want:             `"Cache updated" labelName="flavour" cache={"node1":{"gold":1},"node2":{"gold":3}}`,
+		},
+		{
+			name:      "below the verbosity",
+			verbosity: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			f := newTestPlugin(nil, cache)
+			f.logger = textlogger.NewLogger(textlogger.NewConfig(textlogger.Output(&logs), textlogger.Verbosity(tt.verbosity)))
+			f.logCacheContents = tt.logCacheContents
+
+			f.logCache(5, "Cache updated")
+			if tt.want == "" {
+				if logs.Len() != 0 {
+					t.Errorf("expected nothing to be logged, got %q", logs.String())
+				}
+				return
+			}
+			if !strings.Contains(logs.String(), tt.want) {
+				t.Errorf("expected %q to be logged, got %q", tt.want, logs.String())
+			}
+		})
+	}
+}
+
+func TestDumpCache(t *testing.T) {
+	var logs bytes.Buffer
+	f := newTestPlugin(nil, map[string]map[string]int{"node2": {"gold": 3}, "node1": {"gold": 1}})
+	f.logger = bufferLogger(&logs)
+
+	f.dumpCache()
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	want := []string{
+		`"Dump of the cache" labelName="flavour" nodes=2`,
+		`"Dump of the cache of node" node="node1" counts={"gold":1}`,
+		`"Dump of the cache of node" node="node2" counts={"gold":3}`,
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %q", len(want), logs.String())
+	}
+	for i := range want {
+		if !strings.Contains(lines[i], want[i]) {
+			t.Errorf("expected line %d to contain %q, got %q", i, want[i], lines[i])
+		}
+	}
+}
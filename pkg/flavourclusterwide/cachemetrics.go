@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+)
+
+var (
+	cacheAgeDesc = metrics.NewDesc(
+		metrics.BuildFQName("", metricsSubsystem, "cache_age_seconds"),
+		"Seconds since the cache was last refreshed from the nodes and pods of the cluster.",
+		[]string{"plugin"}, nil, metrics.ALPHA, "")
+
+	nodeFlavourPodsDesc = metrics.NewDesc(
+		metrics.BuildFQName("", metricsSubsystem, "node_flavour_pods"),
+		"Number of pods of the flavour counted by the cache on the node, weighted by the count mode. Nodes and flavours beyond the label limits are aggregated under \"other\".",
+		[]string{"plugin", "node", "flavour"}, nil, metrics.ALPHA, "")
+)
+
+// cacheMetrics reports the cache of the live plugins when the metrics are scraped, rather than from
+// gauges set on every refresh, so that the series of removed nodes and flavours disappear with them.
+var cacheMetrics = &cacheCollector{plugins: map[string]*FlavourClusterWide{}}
+
+// cacheCollector collects the cache age and the per-node per-flavour counts of the plugins added to it.
+type cacheCollector struct {
+	metrics.BaseStableCollector
+
+	mutex sync.Mutex
+	// plugins are the live plugins by name. A plugin replaces the previous one of the same name, such as
+	// the plugin of a reloaded profile created before the previous one is closed.
+	plugins map[string]*FlavourClusterWide
+}
+
+var _ metrics.StableCollector = &cacheCollector{}
+
+func (c *cacheCollector) add(f *FlavourClusterWide) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.plugins[f.Name()] = f
+}
+
+// remove removes the plugin, unless it was already replaced by another one of the same name.
+func (c *cacheCollector) remove(f *FlavourClusterWide) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.plugins[f.Name()] == f {
+		delete(c.plugins, f.Name())
+	}
+}
+
+func (c *cacheCollector) DescribeWithStability(ch chan<- *metrics.Desc) {
+	ch <- cacheAgeDesc
+	ch <- nodeFlavourPodsDesc
+}
+
+func (c *cacheCollector) CollectWithStability(ch chan<- metrics.Metric) {
+	c.mutex.Lock()
+	plugins := make([]*FlavourClusterWide, 0, len(c.plugins))
+	for _, f := range c.plugins {
+		plugins = append(plugins, f)
+	}
+	c.mutex.Unlock()
+
+	for _, f := range plugins {
+		f.collectCacheMetrics(ch)
+	}
+}
+
+// collectCacheMetrics sends the cache age and the per-node per-flavour counts of the plugin, with the
+// node and flavour labels bounded by metricLabels. Nothing is sent before the cache is first built.
+func (f *FlavourClusterWide) collectCacheMetrics(ch chan<- metrics.Metric) {
+	f.cacheMutex.RLock()
+	if f.lastUpdated.IsZero() {
+		f.cacheMutex.RUnlock()
+		return
+	}
+	age := f.clock.Since(f.lastUpdated).Seconds()
+	counts := make(map[string]map[string]int, len(f.cache))
+	totals := make(map[string]int, len(f.cache))
+	for node, flavours := range f.cache {
+		counts[node] = make(map[string]int, len(flavours))
+		for flavour, count := range flavours {
+			counts[node][flavour] = count
+			totals[node] += count
+		}
+	}
+	f.cacheMutex.RUnlock()
+
+	ch <- metrics.NewLazyConstMetric(cacheAgeDesc, metrics.GaugeValue, age, f.Name())
+	nodeLabels := f.metricLabels.nodes(totals)
+	aggregated := map[[2]string]int{}
+	for node, flavours := range counts {
+		for flavour, count := range flavours {
+			aggregated[[2]string{nodeLabels[node], f.metricLabels.flavour(flavour)}] += count
+		}
+	}
+	for labels, count := range aggregated {
+		ch <- metrics.NewLazyConstMetric(nodeFlavourPodsDesc, metrics.GaugeValue, float64(count), f.Name(), labels[0], labels[1])
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/component-base/metrics"
+	metricstestutil "k8s.io/component-base/metrics/testutil"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestCacheMetrics(t *testing.T) {
+	f := newTestPlugin(nil, map[string]map[string]int{
+		"node1": {"gold": 3, "silver": 1},
+		"node2": {"gold": 1},
+		"node3": {"gold": 0, "silver": 1},
+	})
+	f.metricLabels = newMetricLabels(2, []string{"gold"})
+	f.clock.(*clocktesting.FakeClock).Step(30 * time.Second)
+	collector := &cacheCollector{plugins: map[string]*FlavourClusterWide{}}
+	collector.add(f)
+	registry := metrics.NewKubeRegistry()
+	registry.CustomMustRegister(collector)
+
+	// node3 ties with node2 but comes after it, and silver is not allowlisted.
+	want := `
+		# HELP flavourclusterwide_cache_age_seconds [ALPHA] Seconds since the cache was last refreshed from the nodes and pods of the cluster.
+		# TYPE flavourclusterwide_cache_age_seconds gauge
+		flavourclusterwide_cache_age_seconds{plugin="FlavourClusterWide"} 30
+		# HELP flavourclusterwide_node_flavour_pods [ALPHA] Number of pods of the flavour counted by the cache on the node, weighted by the count mode. Nodes and flavours beyond the label limits are aggregated under "other".
+		# TYPE flavourclusterwide_node_flavour_pods gauge
+		flavourclusterwide_node_flavour_pods{flavour="gold",node="node1",plugin="FlavourClusterWide"} 3
+		flavourclusterwide_node_flavour_pods{flavour="other",node="node1",plugin="FlavourClusterWide"} 1
+		flavourclusterwide_node_flavour_pods{flavour="gold",node="node2",plugin="FlavourClusterWide"} 1
+		flavourclusterwide_node_flavour_pods{flavour="gold",node="other",plugin="FlavourClusterWide"} 0
+		flavourclusterwide_node_flavour_pods{flavour="other",node="other",plugin="FlavourClusterWide"} 1
+	`
+	if err := metricstestutil.GatherAndCompare(registry, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+
+	// A closed plugin is no longer reported, unless another plugin of the same name replaced it.
+	replacement := newTestPlugin(nil, nil)
+	collector.add(replacement)
+	collector.remove(f)
+	if got := collector.plugins[Name]; got != replacement {
+		t.Errorf("expected the replacement plugin to be kept")
+	}
+	collector.remove(replacement)
+	if err := metricstestutil.GatherAndCompare(registry, strings.NewReader("")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRefreshMetrics(t *testing.T) {
+	RegisterMetrics()
+	nodes := []*v1.Node{makeWorker("node1")}
+	f := newTestPlugin(nodes, nil)
+	f.logger = logr.Discard()
+	store := &staticStore{nodes: []v1.Node{*nodes[0]}}
+	f.store = store
+	refreshes := func() (uint64, float64) {
+		t.Helper()
+		count, err := metricstestutil.GetHistogramMetricCount(cacheRefreshDuration.WithLabelValues(Name))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		errs, err := metricstestutil.GetCounterMetricValue(listErrors.WithLabelValues(Name))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return count, errs
+	}
+
+	count, errs := refreshes()
+	f.reconcile.Store(true)
+	f.updateCacheIfNeeded(context.Background())
+	store.err = errors.New("unavailable")
+	f.reconcile.Store(true)
+	f.updateCacheIfNeeded(context.Background())
+	// The failed reconcile is retried on the next refresh, then the valid cache is not refreshed.
+	store.err = nil
+	f.updateCacheIfNeeded(context.Background())
+	f.updateCacheIfNeeded(context.Background())
+	if gotCount, gotErrs := refreshes(); gotCount != count+3 || gotErrs != errs+1 {
+		t.Errorf("expected 3 more refreshes and 1 more list error, got %v refreshes (was %v) and %v list errors (was %v)",
+			gotCount, count, gotErrs, errs)
+	}
+}
+
+func TestRecordDecisions(t *testing.T) {
+	RegisterMetrics()
+	f := newTestPlugin(nil, nil)
+	decisions := func() map[string]float64 {
+		t.Helper()
+		values := map[string]float64{}
+		for _, decision := range []string{"100", "0", "between"} {
+			value, err := metricstestutil.GetCounterMetricValue(scoreDecisions.WithLabelValues(Name, decision))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			values[decision] = value
+		}
+		return values
+	}
+
+	before := decisions()
+	f.recordDecisions(framework.NodeScoreList{{Name: "node1", Score: 100}, {Name: "node2", Score: 0}, {Name: "node3", Score: 40}, {Name: "node4", Score: 100}})
+	after := decisions()
+	for decision, want := range map[string]float64{"100": 2, "0": 1, "between": 1} {
+		if got := after[decision] - before[decision]; got != want {
+			t.Errorf("expected %v more %q decisions, got %v", want, decision, got)
+		}
+	}
+}
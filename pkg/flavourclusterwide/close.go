@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"io"
+	"time"
+)
+
+// apiCallTimeout bounds every call of the plugin to the API server, so that an unresponsive API server
+// holds neither the cache lock during a rebuild, nor a PostBind, nor the gossip publisher for good.
+const apiCallTimeout = 30 * time.Second
+
+var _ io.Closer = &FlavourClusterWide{}
+
+// Close stops the background work of the plugin, started with the context passed to New, and waits for
+// it to return: the cache refresh, the PostBind queue worker, the snapshot gossip, the admin service, the
+// audit trail, the CloudEvents publisher and the dump signal watcher. The scheduler framework closes its plugins when the scheduler shuts down and
+// when a profile is reloaded. Cancelling the context passed to New stops the same work without waiting.
+// Close also stops reporting the cache of the plugin in the metrics and closes the connections to the
+// remote cache. Close always returns nil.
+func (f *FlavourClusterWide) Close() error {
+	cacheMetrics.remove(f)
+	if f.cancel != nil {
+		f.cancel()
+	}
+	f.background.Wait()
+	if closer, ok := f.store.(io.Closer); ok {
+		closer.Close()
+	}
+	return nil
+}
+
+// runInBackground runs fn in a goroutine that Close waits for. fn must return once the context of the
+// plugin is done.
+func (f *FlavourClusterWide) runInBackground(fn func()) {
+	f.background.Add(1)
+	go func() {
+		defer f.background.Done()
+		fn()
+	}()
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	testutil "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+func TestClose(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1")}
+	client := clientsetfake.NewSimpleClientset(nodes[0])
+	// The context of the scheduler is never cancelled: Close alone stops the background work.
+	args := &cfgv1.FlavourClusterWideArgs{PostBindQueueSize: ptr.To[int32](1), CloudEventsSink: ptr.To("http://127.0.0.1:1")}
+	f, err := NewWithOptions(context.Background(), args,
+		&fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)},
+		WithClient(client),
+		WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+		WithLogger(logr.Discard()),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForRefresh(t, f)
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		if err := f.Close(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// Closing again is a no-op.
+		if err := f.Close(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the background work to stop")
+	}
+	// Close waits for the background work, so no goroutine of it is left once it returns.
+	stacks := make([]byte, 1<<20)
+	stacks = stacks[:runtime.Stack(stacks, true)]
+	if strings.Contains(string(stacks), "(*cloudEventsPublisher).run") {
+		t.Errorf("the CloudEvents publisher is still running after Close:\n%s", stacks)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// CloudEventsSource is the source attribute of the CloudEvents published by the plugin. Named
+	// instances of the plugin publish with their own name instead of Name, see NewNamed.
+	CloudEventsSource = "/scheduler-plugins/" + Name
+	// BoundEventType is the type of the CloudEvents published when a flavoured pod is bound to a node.
+	// Their data is a BoundEventData.
+	BoundEventType = "io.x-k8s.scheduling.flavour.bound"
+	// FairnessExceededEventType is the type of the CloudEvents published when a bind takes a flavour
+	// beyond its fairness share of a node group. Their data is a FairnessExceededEventData.
+	FairnessExceededEventType = "io.x-k8s.scheduling.flavour.fairness-exceeded"
+
+	// cloudEventsQueueSize bounds the events waiting to be sent; events are dropped when it is full.
+	cloudEventsQueueSize = 1000
+	// cloudEventsTimeout bounds the delivery of an event to the sink.
+	cloudEventsTimeout = 5 * time.Second
+)
+
+// BoundEventData is the data of a BoundEventType CloudEvent.
+type BoundEventData struct {
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	UID       string `json:"uid"`
+	Flavour   string `json:"flavour"`
+	Node      string `json:"node"`
+	NodeGroup string `json:"nodeGroup,omitempty"`
+}
+
+// FairnessExceededEventData is the data of a FairnessExceededEventType CloudEvent. The shares are the
+// fractions of the admissions on the node group within the fairness window.
+type FairnessExceededEventData struct {
+	Flavour       string  `json:"flavour"`
+	NodeGroup     string  `json:"nodeGroup"`
+	ExpectedShare float64 `json:"expectedShare"`
+	AdmittedShare float64 `json:"admittedShare"`
+}
+
+// cloudEvent is a CloudEvent in the structured content mode of the HTTP binding.
+type cloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            any       `json:"data"`
+}
+
+// cloudEventsPublisher sends CloudEvents to the sink in the background, so that publishing never
+// delays scheduling. Delivery is best effort: failed events are logged and dropped.
+type cloudEventsPublisher struct {
+	sink   string
+	client *http.Client
+	logger klog.Logger
+	queue  chan cloudEvent
+}
+
+// newCloudEventsPublisher returns a publisher sending to the sink once run.
+func newCloudEventsPublisher(sink string, logger klog.Logger) *cloudEventsPublisher {
+	return &cloudEventsPublisher{
+		sink:   sink,
+		client: &http.Client{Timeout: cloudEventsTimeout},
+		logger: logger,
+		queue:  make(chan cloudEvent, cloudEventsQueueSize),
+	}
+}
+
+// publish queues the event, or drops it when the queue is full.
+func (p *cloudEventsPublisher) publish(event cloudEvent) {
+	select {
+	case p.queue <- event:
+	default:
+		p.logger.Info("Dropping CloudEvent, the queue is full", "id", event.ID, "type", event.Type)
+	}
+}
+
+// run sends the queued events until the context is done.
+func (p *cloudEventsPublisher) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-p.queue:
+			if err := p.send(ctx, event); err != nil {
+				p.logger.Error(err, "Error sending CloudEvent", "id", event.ID, "type", event.Type, "sink", p.sink)
+			}
+		}
+	}
+}
+
+func (p *cloudEventsPublisher) send(ctx context.Context, event cloudEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.sink, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json; charset=utf-8")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// publishEvent publishes an event of the given type about the subject, if a sink is configured.
+func (f *FlavourClusterWide) publishEvent(eventType, subject string, data any) {
+	if f.events == nil {
+		return
+	}
+	f.events.publish(cloudEvent{
+		SpecVersion:     "1.0",
+		ID:              string(uuid.NewUUID()),
+		Source:          "/scheduler-plugins/" + f.Name(),
+		Type:            eventType,
+		Subject:         subject,
+		Time:            f.clock.Now(),
+		DataContentType: "application/json",
+		Data:            data,
+	})
+}
+
+// publishBind publishes the bind of the flavoured pod to the node when the decision is sampled, and a
+// fairness violation when the bind takes the flavour beyond its share of the node group. Fairness
+// violations are anomalous and published whatever the sampling.
+// The cache mutex must be held by the caller.
+func (f *FlavourClusterWide) publishBind(pod *v1.Pod, flavour, nodeName string, sampled bool) {
+	if f.events == nil {
+		return
+	}
+	subject := pod.Namespace + "/" + pod.Name
+	group := ""
+	if len(f.fairnessShares) > 0 {
+		group = f.nodeGroup(nodeName)
+	}
+	if sampled {
+		f.publishEvent(BoundEventType, subject, BoundEventData{
+			Namespace: pod.Namespace,
+			Pod:       pod.Name,
+			UID:       string(pod.UID),
+			Flavour:   flavour,
+			Node:      nodeName,
+			NodeGroup: group,
+		})
+	}
+
+	if expected, actual := f.admissionShares(group, flavour, f.clock.Now()); actual > expected {
+		f.publishEvent(FairnessExceededEventType, subject, FairnessExceededEventData{
+			Flavour:       flavour,
+			NodeGroup:     group,
+			ExpectedShare: expected,
+			AdmittedShare: actual,
+		})
+	}
+}
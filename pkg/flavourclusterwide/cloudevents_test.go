@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestPostBindCloudEvents(t *testing.T) {
+	type received struct {
+		contentType string
+		event       map[string]any
+	}
+	events := make(chan received, 10)
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		events <- received{contentType: r.Header.Get("Content-Type"), event: event}
+	}))
+	defer sink.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nodes := []*v1.Node{makeNode("node1", map[string]string{v1.LabelTopologyZone: "zone-a"})}
+	f := newTestPlugin(nodes, map[string]map[string]int{"node1": {}})
+	f.events = newCloudEventsPublisher(sink.URL, logr.Discard())
+	go f.events.run(ctx)
+	f.fairnessShares = map[string]int32{"gold": 1, "silver": 1}
+	f.fairnessWindow = 10 * time.Minute
+	f.nodeGroupLabel = v1.LabelTopologyZone
+
+	f.PostBind(ctx, nil, makePod("default", "gold-0", "node1", flavoured("gold")), "node1")
+
+	want := []map[string]any{
+		{
+			"type":    BoundEventType,
+			"subject": "default/gold-0",
+			"data":    map[string]any{"namespace": "default", "pod": "gold-0", "uid": "", "flavour": "gold", "node": "node1", "nodeGroup": "zone-a"},
+		},
+		{
+			"type":    FairnessExceededEventType,
+			"subject": "default/gold-0",
+			"data":    map[string]any{"flavour": "gold", "nodeGroup": "zone-a", "expectedShare": 0.5, "admittedShare": 1.0},
+		},
+	}
+	for _, w := range want {
+		select {
+		case got := <-events:
+			if got.contentType != "application/cloudevents+json; charset=utf-8" {
+				t.Errorf("unexpected content type %q", got.contentType)
+			}
+			if got.event["specversion"] != "1.0" || got.event["source"] != CloudEventsSource || got.event["id"] == "" {
+				t.Errorf("unexpected context attributes in %v", got.event)
+			}
+			for _, attribute := range []string{"specversion", "source", "id", "time", "datacontenttype"} {
+				delete(got.event, attribute)
+			}
+			if diff := cmp.Diff(w, got.event); diff != "" {
+				t.Errorf("unexpected event (-want,+got):\n%s", diff)
+			}
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatalf("timed out waiting for event %v", w["type"])
+		}
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"math"
+	"slices"
+
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// The names of the terms of the balance score passed to a Combiner.
+const (
+	NodeBalanceTerm = "nodeBalance"
+	ZoneBalanceTerm = "zoneBalance"
+	TieBreakerTerm  = "tieBreaker"
+)
+
+// ScoreTerm is a term of the balance score of a node, between 0 and framework.MaxNodeScore, with its
+// configured weight.
+type ScoreTerm struct {
+	Name   string
+	Score  int64
+	Weight int32
+}
+
+// Combiner merges the terms of the balance score of a node into that score. The terms are passed in
+// the order NodeBalanceTerm, ZoneBalanceTerm, TieBreakerTerm, without those of weight 0, and there is
+// always at least one. The result must be between 0 and framework.MaxNodeScore, and the same terms must
+// give the same result, as the nodes are scored in parallel. Scheduler builds can provide their own
+// combiner with WithCombiner, and otherwise scoreCombiner selects a built-in one.
+type Combiner interface {
+	Combine(terms []ScoreTerm) int64
+}
+
+// newCombiner returns the built-in combiner selected by scoreCombiner.
+func newCombiner(combiner pluginConfig.FlavourScoreCombiner) Combiner {
+	switch combiner {
+	case pluginConfig.FlavourCombinerLexicographic:
+		return lexicographicCombiner{}
+	case pluginConfig.FlavourCombinerMaxMin:
+		return maxMinCombiner{}
+	default:
+		return weightedSumCombiner{}
+	}
+}
+
+// weightedSumCombiner averages the terms, weighted by their weights.
+type weightedSumCombiner struct{}
+
+func (weightedSumCombiner) Combine(terms []ScoreTerm) int64 {
+	var sum, total int64
+	for _, term := range terms {
+		sum += int64(term.Weight) * term.Score
+		total += int64(term.Weight)
+	}
+	return sum / total
+}
+
+// lexicographicCombiner orders the nodes by their terms of the largest weight first, and of smaller
+// weights in turn, equal weights keeping the order of the terms. The terms are read as the digits of a
+// number in base MaxNodeScore+1 scaled back to a score, so that a term only breaks the ties of the terms
+// before it, within the resolution of the score.
+type lexicographicCombiner struct{}
+
+func (lexicographicCombiner) Combine(terms []ScoreTerm) int64 {
+	ordered := slices.Clone(terms)
+	slices.SortStableFunc(ordered, func(a, b ScoreTerm) int {
+		return int(b.Weight - a.Weight)
+	})
+	var value, highest, place float64 = 0, 0, 1
+	for _, term := range ordered {
+		value += float64(term.Score) * place
+		highest += float64(framework.MaxNodeScore) * place
+		place /= float64(framework.MaxNodeScore + 1)
+	}
+	return int64(math.Round(value * float64(framework.MaxNodeScore) / highest))
+}
+
+// maxMinCombiner scores the nodes with their lowest term, so that the node whose worst term is the best
+// wins. The weights only select the terms.
+type maxMinCombiner struct{}
+
+func (maxMinCombiner) Combine(terms []ScoreTerm) int64 {
+	lowest := terms[0].Score
+	for _, term := range terms[1:] {
+		lowest = min(lowest, term.Score)
+	}
+	return lowest
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestCombiners(t *testing.T) {
+	terms := func(node, zone, tieBreaker int64) []ScoreTerm {
+		return []ScoreTerm{
+			{Name: NodeBalanceTerm, Score: node, Weight: 1},
+			{Name: ZoneBalanceTerm, Score: zone, Weight: 2},
+			{Name: TieBreakerTerm, Score: tieBreaker, Weight: 1},
+		}
+	}
+	tests := []struct {
+		name     string
+		combiner pluginConfig.FlavourScoreCombiner
+		terms    []ScoreTerm
+		want     int64
+	}{
+		{name: "weighted sum", combiner: pluginConfig.FlavourCombinerWeightedSum, terms: terms(100, 50, 0), want: 50},
+		{name: "max-min", combiner: pluginConfig.FlavourCombinerMaxMin, terms: terms(100, 50, 20), want: 20},
+		{name: "lexicographic best", combiner: pluginConfig.FlavourCombinerLexicographic, terms: terms(100, 100, 100), want: 100},
+		{name: "lexicographic worst", combiner: pluginConfig.FlavourCombinerLexicographic, terms: terms(0, 0, 0), want: 0},
+		// The zone balance term weighs the most and comes first.
+		{name: "lexicographic first term", combiner: pluginConfig.FlavourCombinerLexicographic, terms: terms(0, 99, 0), want: 98},
+		{name: "lexicographic later terms", combiner: pluginConfig.FlavourCombinerLexicographic, terms: terms(100, 99, 100), want: 99},
+		{name: "lexicographic single term", combiner: pluginConfig.FlavourCombinerLexicographic, terms: terms(30, 0, 0)[:1], want: 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newCombiner(tt.combiner).Combine(tt.terms); got != tt.want {
+				t.Errorf("expected score %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScoreCombiner(t *testing.T) {
+	zone := func(name, zone string) *v1.Node {
+		return makeNode(name, map[string]string{WorkerNodeLabelSelector: "", v1.LabelTopologyZone: zone})
+	}
+	nodes := []*v1.Node{zone("node1", "zone-a"), zone("node2", "zone-a"), zone("node3", "zone-b")}
+	// node1 is the least loaded node, and zone-b the least loaded zone.
+	cache := map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 2},
+		"node3": {"gold": 1},
+	}
+	weights := pluginConfig.FlavourScoreWeights{NodeBalance: 2, ZoneBalance: 1}
+	tests := []struct {
+		name     string
+		combiner pluginConfig.FlavourScoreCombiner
+		want     map[string]int64
+	}{
+		{
+			name:     "weighted sum",
+			combiner: pluginConfig.FlavourCombinerWeightedSum,
+			want:     map[string]int64{"node1": 66, "node2": 0, "node3": 33},
+		},
+		{
+			name:     "lexicographic",
+			combiner: pluginConfig.FlavourCombinerLexicographic,
+			want:     map[string]int64{"node1": 99, "node2": 0, "node3": 1},
+		},
+		{
+			name:     "max-min",
+			combiner: pluginConfig.FlavourCombinerMaxMin,
+			want:     map[string]int64{"node1": 0, "node2": 0, "node3": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.nodeGroupLabel = v1.LabelTopologyZone
+			f.weights = weights
+			f.combiner = newCombiner(tt.combiner)
+			got := scoreNodes(t, f, makePod("default", "p", "", flavoured("gold")))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"sort"
+	"sync"
+
+	fwk "k8s.io/kube-scheduler/framework"
+)
+
+// comparisonStateKey is the key in CycleState to the scores of both strategies in the cycle, see stateKey.
+const comparisonStateKey = "Comparison"
+
+// comparedNode holds the count of the flavour on a scored node and its score under both strategies.
+type comparedNode struct {
+	count           int
+	score           int64
+	comparisonScore int64
+}
+
+// comparisonState collects the scored nodes of a scheduling cycle. Score runs concurrently on the
+// nodes, hence the mutex.
+type comparisonState struct {
+	mu    sync.Mutex
+	nodes map[string]comparedNode
+}
+
+// Clone the comparison state. It collects the whole cycle, so the state itself is returned.
+func (s *comparisonState) Clone() fwk.StateData {
+	return s
+}
+
+// startComparison prepares the cycle state to collect the scores of both strategies. It is a no-op
+// without a comparison strategy.
+func (f *FlavourClusterWide) startComparison(state fwk.CycleState) {
+	if f.comparisonStrategy == "" || state == nil {
+		return
+	}
+	state.Write(f.stateKey(comparisonStateKey), &comparisonState{nodes: make(map[string]comparedNode)})
+}
+
+// recordComparison records the scores of the node under both strategies.
+func (f *FlavourClusterWide) recordComparison(state fwk.CycleState, node string, count int, score, comparisonScore int64) {
+	s := f.readComparison(state)
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodes[node] = comparedNode{count: count, score: score, comparisonScore: comparisonScore}
+}
+
+// finishComparison exports how the strategies compare once the nodes are scored.
+func (f *FlavourClusterWide) finishComparison(state fwk.CycleState) {
+	s := f.readComparison(state)
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.nodes) == 0 {
+		return
+	}
+
+	strategy, comparison := string(f.scoringStrategy), string(f.comparisonStrategy)
+	best := bestNodes(s.nodes, func(n comparedNode) int64 { return n.score })
+	comparisonBest := bestNodes(s.nodes, func(n comparedNode) int64 { return n.comparisonScore })
+
+	strategyComparisons.WithLabelValues(f.Name(), strategy, comparison).Inc()
+	if !intersect(best, comparisonBest) {
+		strategyDivergences.WithLabelValues(f.Name(), strategy, comparison).Inc()
+	}
+	hypotheticalSkew.WithLabelValues(f.Name(), strategy).Observe(float64(skewAfter(s.nodes, best[0])))
+	hypotheticalSkew.WithLabelValues(f.Name(), comparison).Observe(float64(skewAfter(s.nodes, comparisonBest[0])))
+}
+
+// readComparison returns the comparison state of the cycle, or nil when there is none.
+func (f *FlavourClusterWide) readComparison(state fwk.CycleState) *comparisonState {
+	if state == nil {
+		return nil
+	}
+	c, err := state.Read(f.stateKey(comparisonStateKey))
+	if err != nil {
+		return nil
+	}
+	s, _ := c.(*comparisonState)
+	return s
+}
+
+// bestNodes returns the sorted names of the nodes with the highest score.
+func bestNodes(nodes map[string]comparedNode, score func(comparedNode) int64) []string {
+	var best []string
+	var highest int64
+	for name, node := range nodes {
+		switch s := score(node); {
+		case len(best) == 0 || s > highest:
+			best, highest = []string{name}, s
+		case s == highest:
+			best = append(best, name)
+		}
+	}
+	sort.Strings(best)
+	return best
+}
+
+// intersect returns true if the sorted lists of names share a name.
+func intersect(a, b []string) bool {
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			return true
+		case a[i] < b[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return false
+}
+
+// skewAfter returns the difference between the most and least loaded nodes once a pod is placed on
+// the given node.
+func skewAfter(nodes map[string]comparedNode, placed string) int {
+	lowest, highest := -1, -1
+	for name, node := range nodes {
+		count := node.count
+		if name == placed {
+			count++
+		}
+		if lowest == -1 || count < lowest {
+			lowest = count
+		}
+		highest = max(highest, count)
+	}
+	return highest - lowest
+}
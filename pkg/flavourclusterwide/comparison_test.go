@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/component-base/metrics/testutil"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestBestNodes(t *testing.T) {
+	nodes := map[string]comparedNode{
+		"node1": {count: 1, score: 100, comparisonScore: 0},
+		"node2": {count: 1, score: 100, comparisonScore: 50},
+		"node3": {count: 4, score: 0, comparisonScore: 100},
+	}
+	best := bestNodes(nodes, func(n comparedNode) int64 { return n.score })
+	if diff := cmp.Diff([]string{"node1", "node2"}, best); diff != "" {
+		t.Errorf("unexpected best nodes (-want,+got):\n%s", diff)
+	}
+	comparisonBest := bestNodes(nodes, func(n comparedNode) int64 { return n.comparisonScore })
+	if intersect(best, comparisonBest) {
+		t.Errorf("expected %v and %v to diverge", best, comparisonBest)
+	}
+	if !intersect(best, []string{"node0", "node2"}) {
+		t.Errorf("expected %v to share node2", best)
+	}
+	if got := skewAfter(nodes, "node1"); got != 3 {
+		t.Errorf("expected a skew of 3 after placing on node1, got %d", got)
+	}
+	if got := skewAfter(nodes, "node3"); got != 4 {
+		t.Errorf("expected a skew of 4 after placing on node3, got %d", got)
+	}
+}
+
+func TestScoreComparison(t *testing.T) {
+	RegisterMetrics()
+	counter := func(strategy, comparison pluginConfig.FlavourScoringStrategy) (float64, float64) {
+		t.Helper()
+		comparisons, err := testutil.GetCounterMetricValue(strategyComparisons.WithLabelValues(Name, string(strategy), string(comparison)))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		divergences, err := testutil.GetCounterMetricValue(strategyDivergences.WithLabelValues(Name, string(strategy), string(comparison)))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return comparisons, divergences
+	}
+
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	f := newTestPlugin(nodes, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 2}, "node3": {"gold": 5}})
+	f.scoringStrategy = pluginConfig.FlavourScoringSpread
+	f.comparisonStrategy = pluginConfig.FlavourScoringVarianceReduction
+	pod := makePod("default", "p", "", flavoured("gold"))
+
+	comparisons, divergences := counter(f.scoringStrategy, f.comparisonStrategy)
+	state := framework.NewCycleState()
+	if status := f.PreScore(context.Background(), state, pod, nil); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	nodeInfos, err := f.handle.SnapshotSharedLister().NodeInfos().List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, nodeInfo := range nodeInfos {
+		if _, status := f.Score(context.Background(), state, pod, nodeInfo); !status.IsSuccess() {
+			t.Fatalf("unexpected status: %v", status)
+		}
+	}
+	if status := f.NormalizeScore(context.Background(), state, pod, nil); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+
+	// Both strategies pick node1 as the least loaded node.
+	gotComparisons, gotDivergences := counter(f.scoringStrategy, f.comparisonStrategy)
+	if gotComparisons != comparisons+1 || gotDivergences != divergences {
+		t.Errorf("expected one more comparison and no more divergence, got %v comparisons (was %v) and %v divergences (was %v)",
+			gotComparisons, comparisons, gotDivergences, divergences)
+	}
+
+	// A cycle where the strategies prefer different nodes is a divergence.
+	state = framework.NewCycleState()
+	f.startComparison(state)
+	f.recordComparison(state, "node1", 1, 100, 0)
+	f.recordComparison(state, "node2", 2, 0, 100)
+	f.finishComparison(state)
+	if _, got := counter(f.scoringStrategy, f.comparisonStrategy); got != divergences+1 {
+		t.Errorf("expected one more divergence, got %v (was %v)", got, divergences)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"path/filepath"
+
+	v1 "k8s.io/api/core/v1"
+	resourcehelper "k8s.io/component-helpers/resource"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+const (
+	// mebibyte is the unit of the memory requests counted by the MemoryRequests and ResourceWeighted
+	// count modes.
+	mebibyte = 1 << 20
+	// mebibytesPerMilliCPU is the memory weighing as much as a millicore with the ResourceWeighted
+	// count mode, so that a CPU weighs as much as 4GiB.
+	mebibytesPerMilliCPU = 4
+)
+
+// countPod counts every pod as 1, as with the Pods count mode.
+func countPod(*v1.Pod) int {
+	return 1
+}
+
+// podWeight returns what the pod adds to the count of its node and flavour with the count mode: 1 with
+// the Pods mode, and its effective requests otherwise, in millicores, MiB, or millicores plus a quarter
+// of the MiB. Every pod weighs at least 1, so that pods without requests are still balanced. The
+// requests of the sidecar containers are left out, see withoutSidecars.
+func (f *FlavourClusterWide) podWeight(pod *v1.Pod) int {
+	if f.countMode == "" || f.countMode == pluginConfig.FlavourCountPods {
+		return 1
+	}
+	if len(f.sidecarContainers) > 0 {
+		pod = withoutSidecars(pod, f.sidecarContainers)
+	}
+	requests := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+	var weight int64
+	switch f.countMode {
+	case pluginConfig.FlavourCountCPURequests:
+		weight = requests.Cpu().MilliValue()
+	case pluginConfig.FlavourCountMemoryRequests:
+		weight = requests.Memory().Value() / mebibyte
+	case pluginConfig.FlavourCountResourceWeighted:
+		weight = requests.Cpu().MilliValue() + requests.Memory().Value()/mebibyte/mebibytesPerMilliCPU
+	}
+	return int(max(weight, 1))
+}
+
+// withoutSidecars returns the pod without its containers and init containers whose name matches one of
+// the patterns, or the pod itself when none does. The pod is not modified.
+func withoutSidecars(pod *v1.Pod, patterns []string) *v1.Pod {
+	containers, droppedContainers := dropSidecars(pod.Spec.Containers, patterns)
+	initContainers, droppedInitContainers := dropSidecars(pod.Spec.InitContainers, patterns)
+	if !droppedContainers && !droppedInitContainers {
+		return pod
+	}
+	stripped := *pod
+	stripped.Spec.Containers = containers
+	stripped.Spec.InitContainers = initContainers
+	return &stripped
+}
+
+// dropSidecars returns the containers whose name matches none of the patterns, and whether any matched.
+func dropSidecars(containers []v1.Container, patterns []string) ([]v1.Container, bool) {
+	kept := make([]v1.Container, 0, len(containers))
+	for _, container := range containers {
+		if !isSidecar(container.Name, patterns) {
+			kept = append(kept, container)
+		}
+	}
+	return kept, len(kept) < len(containers)
+}
+
+// isSidecar returns true if the container name matches one of the patterns, validated with the args.
+func isSidecar(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// requesting sets the requests of the single container of the pod.
+func requesting(pod *v1.Pod, cpu, memory string) *v1.Pod {
+	pod.Spec.Containers = []v1.Container{{
+		Name: "main",
+		Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(memory),
+		}},
+	}}
+	return pod
+}
+
+func TestCountMode(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	// node1 runs two small gold pods, node2 a large one and one without requests.
+	pods := []v1.Pod{
+		*requesting(makePod("default", "small1", "node1", flavoured("gold")), "100m", "256Mi"),
+		*requesting(makePod("default", "small2", "node1", flavoured("gold")), "100m", "256Mi"),
+		*requesting(makePod("default", "large", "node2", flavoured("gold")), "2", "1Gi"),
+		*makePod("default", "besteffort", "node2", flavoured("gold")),
+	}
+	tests := []struct {
+		name       string
+		mode       pluginConfig.FlavourCountMode
+		wantCache  map[string]map[string]int
+		wantScores map[string]int64
+	}{
+		{
+			name:       "pods",
+			mode:       pluginConfig.FlavourCountPods,
+			wantCache:  map[string]map[string]int{"node1": {"gold": 2}, "node2": {"gold": 2}},
+			wantScores: map[string]int64{"node1": 100, "node2": 100},
+		},
+		{
+			name:       "cpu requests",
+			mode:       pluginConfig.FlavourCountCPURequests,
+			wantCache:  map[string]map[string]int{"node1": {"gold": 200}, "node2": {"gold": 2001}},
+			wantScores: map[string]int64{"node1": 100, "node2": 0},
+		},
+		{
+			name:       "memory requests",
+			mode:       pluginConfig.FlavourCountMemoryRequests,
+			wantCache:  map[string]map[string]int{"node1": {"gold": 512}, "node2": {"gold": 1025}},
+			wantScores: map[string]int64{"node1": 100, "node2": 0},
+		},
+		{
+			name:       "resource weighted",
+			mode:       pluginConfig.FlavourCountResourceWeighted,
+			wantCache:  map[string]map[string]int{"node1": {"gold": 328}, "node2": {"gold": 2257}},
+			wantScores: map[string]int64{"node1": 100, "node2": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, nil)
+			f.countMode = tt.mode
+			f.cache = buildSnapshot([]v1.Node{*nodes[0], *nodes[1]}, pods, f.labelName(), f.podWeight)
+			expectCache(t, f, tt.wantCache)
+
+			pod := requesting(makePod("default", "p", "", flavoured("gold")), "500m", "512Mi")
+			got := scoreNodes(t, f, pod)
+			if diff := cmp.Diff(tt.wantScores, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+
+			// Uncounting the pod takes back the weight counting it added.
+			p := f.podPlacement(pod, "node1", "gold")
+			f.count(pod.UID, p)
+			if f.cache["node1"]["gold"] != tt.wantCache["node1"]["gold"]+p.weight {
+				t.Errorf("expected node1 to count %d gold, got %d", tt.wantCache["node1"]["gold"]+p.weight, f.cache["node1"]["gold"])
+			}
+			f.uncount(pod.UID, p)
+			expectCache(t, f, tt.wantCache)
+		})
+	}
+}
+
+func TestSidecarContainers(t *testing.T) {
+	always := v1.ContainerRestartPolicyAlways
+	container := func(name, cpu string) v1.Container {
+		return v1.Container{Name: name, Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)}}}
+	}
+	meshed := makePod("default", "meshed", "node1", flavoured("gold"))
+	meshed.Spec.Containers = []v1.Container{container("main", "100m"), container("istio-proxy", "500m")}
+	logSidecar := container("log-sidecar", "200m")
+	logSidecar.RestartPolicy = &always
+	meshed.Spec.InitContainers = []v1.Container{logSidecar}
+	sidecarsOnly := makePod("default", "sidecars", "node1", flavoured("gold"))
+	sidecarsOnly.Spec.Containers = []v1.Container{container("istio-proxy", "500m")}
+
+	tests := []struct {
+		name     string
+		pod      *v1.Pod
+		patterns []string
+		want     int
+	}{
+		{
+			name: "no patterns",
+			pod:  meshed,
+			want: 800,
+		},
+		{
+			name:     "sidecars and native sidecars left out",
+			pod:      meshed,
+			patterns: []string{"istio-proxy", "*-sidecar"},
+			want:     100,
+		},
+		{
+			name:     "no matching container",
+			pod:      meshed,
+			patterns: []string{"envoy"},
+			want:     800,
+		},
+		{
+			name:     "only sidecars",
+			pod:      sidecarsOnly,
+			patterns: []string{"istio-*"},
+			want:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nil, nil)
+			f.countMode = pluginConfig.FlavourCountCPURequests
+			f.sidecarContainers = tt.patterns
+			if got := f.podWeight(tt.pod); got != tt.want {
+				t.Errorf("expected a weight of %d, got %d", tt.want, got)
+			}
+		})
+	}
+	if len(meshed.Spec.Containers) != 2 || len(meshed.Spec.InitContainers) != 1 {
+		t.Errorf("expected the pod to be left unmodified, got %d containers and %d init containers",
+			len(meshed.Spec.Containers), len(meshed.Spec.InitContainers))
+	}
+}
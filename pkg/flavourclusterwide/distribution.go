@@ -0,0 +1,280 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+)
+
+// distributionStateKey is the key in CycleState to the distribution of the flavour taken by PreScore,
+// see stateKey.
+const distributionStateKey = "Distribution"
+
+// rankDistribution is the distribution of a flavour among the nodes in scope of one lifecycle rank.
+type rankDistribution struct {
+	// counts are the weighted counts of the nodes hosting the flavour, and minPods the smallest of
+	// them, or -1 when there are none.
+	counts  []int
+	minPods int
+	// groups are the weighted counts per node group, only taken when groups are balanced.
+	groups map[string]int
+	// totals are the counts of all flavours per node, only taken when the tie-breaker is weighed.
+	totals []int
+	// tiers is the distribution across the topology tiers, taken from the weighted counts of tierNodes
+	// when the pod is balanced across tiers.
+	tiers     *tierDistribution
+	tierNodes map[string]int
+}
+
+// distributionState is the distribution of the flavour of the pod being scheduled, taken once per
+// cycle so that every node is scored against the same counts, whatever the cache updates and rebuilds
+// in between. It holds everything Score reads from the cache, so that Score never reads the cache
+// itself and the scores of a cycle are deterministic.
+type distributionState struct {
+	now    time.Time
+	strict bool
+	// inScope returns true for the nodes that can host the pod, whatever their lifecycle.
+	inScope func(string) bool
+	known   sets.Set[string]
+	gated   sets.Set[string]
+	// counts are the plain counts of the flavour per node, weighted the weighted ones and totals the
+	// counts of all flavours, only taken when the tie-breaker is weighed.
+	counts   map[string]int
+	weighted map[string]int
+	totals   map[string]int
+	ranks    map[int]*rankDistribution
+	// labelKeys are the distributions of the further label keys of the pod, see takeLabelKeys.
+	labelKeys []labelKeyDistribution
+	// tierPaths are the paths of the groups of every node, only taken when the pod is balanced across
+	// topology tiers, see takeTiers.
+	tierPaths map[string][]string
+	// ratioCounts are the counts of the flavours of ratios per node, only taken when the flavour has a
+	// target ratio, see ratioScore. ratios are the target ratios in effect, which a FlavourPolicy may
+	// retune before Score.
+	ratioCounts map[string]map[string]int
+	ratios      map[string]int32
+	// fairness are the fairness factors of the flavour per node group, only taken with fairness
+	// shares. Groups without admissions are missing, their factor is 1.
+	fairness map[string]float64
+}
+
+// Clone the distribution state. It is never modified after it is taken, so the state itself is returned.
+func (s *distributionState) Clone() fwk.StateData {
+	return s
+}
+
+// fairnessFactor returns the fairness factor of the flavour on the nodes of the group, see
+// FlavourClusterWide.fairnessFactor.
+func (s *distributionState) fairnessFactor(group string) float64 {
+	if factor, ok := s.fairness[group]; ok {
+		return factor
+	}
+	return 1
+}
+
+// rank returns the distribution of the lifecycle rank, empty when no node in scope has that rank.
+func (s *distributionState) rank(rank int) *rankDistribution {
+	if d, ok := s.ranks[rank]; ok {
+		return d
+	}
+	return &rankDistribution{minPods: -1}
+}
+
+// startDistribution takes the distribution of the flavour of the pod for the cycle, after the feasible
+// nodes and the volume topology it is restricted to.
+func (f *FlavourClusterWide) startDistribution(state fwk.CycleState, pod *v1.Pod) {
+	state.Write(f.stateKey(distributionStateKey), f.takeDistribution(state, pod))
+}
+
+// distribution returns the distribution of the flavour taken by PreScore, or nil when there is none.
+func (f *FlavourClusterWide) distribution(state fwk.CycleState) *distributionState {
+	if state == nil {
+		return nil
+	}
+	c, err := state.Read(f.stateKey(distributionStateKey))
+	if err != nil {
+		return nil
+	}
+	s, ok := c.(*distributionState)
+	if !ok {
+		return nil
+	}
+	return s
+}
+
+// takeDistribution returns the distribution of the flavour of the pod among the nodes in the cache.
+func (f *FlavourClusterWide) takeDistribution(state fwk.CycleState, pod *v1.Pod) *distributionState {
+	flavour := f.flavourOf(pod)
+
+	f.cacheMutex.RLock()
+	defer f.cacheMutex.RUnlock()
+
+	// Nodes filtered out in this cycle, such as tainted or full nodes, are never candidates. When they
+	// are not known, the nodes outside of the node selector and required node affinity of the pod are not.
+	inScope := func(string) bool { return true }
+	if feasible := f.feasibleNodes(state); feasible != nil {
+		inScope = feasible.Has
+	} else if matching := f.affinityNodes(pod); matching != nil {
+		inScope = matching.Has
+	}
+	// Nodes on which the pending volumes of the pod cannot be provisioned are never candidates.
+	if allowed := f.volumeTopologyNodes(state); allowed != nil {
+		filterScope := inScope
+		inScope = func(node string) bool {
+			return allowed.Has(node) && filterScope(node)
+		}
+	}
+	// Pods rescheduling after a drain are spread strictly among the nodes that remain, see drainOrigin.
+	now := f.clock.Now()
+	strict := f.drainOrigin(flavour, now)
+	if strict {
+		f.logger.V(4).Info("Pod follows a node drain, spreading strictly", "pod", klog.KObj(pod), "flavour", flavour)
+		draining := f.drainingNodes
+		drainScope := inScope
+		inScope = func(node string) bool {
+			return !draining.Has(node) && drainScope(node)
+		}
+	}
+
+	s := &distributionState{
+		now:      now,
+		strict:   strict,
+		inScope:  inScope,
+		known:    sets.New[string](),
+		gated:    f.gatedNodes,
+		counts:   make(map[string]int, len(f.cache)),
+		weighted: make(map[string]int, len(f.cache)),
+		ranks:    make(map[int]*rankDistribution),
+	}
+
+	// With a lifecycle fallback chain, a node only competes for balance with the nodes
+	// of the same lifecycle preference rank.
+	chain, hasChain := f.lifecyclePreferences[flavour]
+	var lifecycles map[string]string
+	if hasChain {
+		lifecycles = f.nodeLabelValues(f.nodeLifecycleLabel)
+	}
+	// The zone balance and tie-breaker terms are only taken when they are weighed. A pod with a topology
+	// key, its own or the configured one, is balanced across the groups of that label alone.
+	override := f.topologyKey(pod)
+	var groups map[string]string
+	if f.weights.ZoneBalance > 0 || balancesGroups(override) {
+		groupKey := f.nodeGroupLabel
+		if balancesGroups(override) {
+			groupKey = override
+		}
+		groups = f.nodeLabelValues(groupKey)
+	}
+	if f.weights.TieBreaker > 0 {
+		s.totals = make(map[string]int, len(f.cache))
+	}
+	// Pods with a topology key are balanced across the groups of that key rather than across the tiers.
+	if len(f.tiers) > 0 && override == "" {
+		s.tierPaths = f.tierPaths()
+	}
+	if ratios := f.ratios(); ratios[flavour] > 0 {
+		s.ratios = ratios
+		s.ratioCounts = make(map[string]map[string]int, len(f.cache))
+	}
+	if len(f.fairnessShares) > 0 {
+		s.fairness = make(map[string]float64, len(f.admissions))
+		for group := range f.admissions {
+			s.fairness[group] = f.fairnessFactor(group, flavour, now)
+		}
+	}
+
+	for node, nodeCounts := range f.cache {
+		s.known.Insert(node)
+		s.counts[node] = nodeCounts[flavour]
+		s.weighted[node] = f.weightedCount(node, flavour, now)
+		total := 0
+		for _, count := range nodeCounts {
+			total += count
+		}
+		if s.totals != nil {
+			s.totals[node] = total
+		}
+		if s.ratioCounts != nil {
+			s.ratioCounts[node] = make(map[string]int, len(s.ratios))
+			for listed := range s.ratios {
+				s.ratioCounts[node][listed] = nodeCounts[listed]
+			}
+		}
+		if !inScope(node) {
+			continue
+		}
+
+		rank := 0
+		if hasChain {
+			rank = lifecycleRank(chain, lifecycles[node])
+		}
+		d, ok := s.ranks[rank]
+		if !ok {
+			d = &rankDistribution{minPods: -1}
+			if groups != nil {
+				d.groups = make(map[string]int)
+			}
+			if s.tierPaths != nil {
+				d.tierNodes = make(map[string]int)
+			}
+			s.ranks[rank] = d
+		}
+		if s.totals != nil {
+			d.totals = append(d.totals, total)
+		}
+		if _, exists := nodeCounts[flavour]; exists {
+			count := s.weighted[node]
+			d.counts = append(d.counts, count)
+			if d.minPods == -1 || count < d.minPods {
+				d.minPods = count
+			}
+			if groups != nil {
+				d.groups[groups[node]] += count
+			}
+			if d.tierNodes != nil {
+				d.tierNodes[node] = count
+			}
+		}
+	}
+	if s.tierPaths != nil {
+		for _, d := range s.ranks {
+			d.tiers = f.takeTiers(d.tierNodes, s.tierPaths)
+		}
+	}
+	s.labelKeys = f.takeLabelKeys(pod, inScope, s.known)
+	return s
+}
+
+// nodeLabelValues returns the value of the label key of every node in the scheduler snapshot.
+func (f *FlavourClusterWide) nodeLabelValues(key string) map[string]string {
+	values := make(map[string]string)
+	nodeInfos, err := f.handle.SnapshotSharedLister().NodeInfos().List()
+	if err != nil {
+		f.logger.Error(err, "Error listing nodes from snapshot")
+		return values
+	}
+	for _, nodeInfo := range nodeInfos {
+		node := nodeInfo.Node()
+		values[node.Name] = node.Labels[key]
+	}
+	return values
+}
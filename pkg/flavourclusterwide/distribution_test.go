@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestScoreDistribution(t *testing.T) {
+	ctx := context.Background()
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	f := newTestPlugin(nodes, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 0},
+		"node3": {"gold": 0},
+	})
+	pod := makePod("default", "p", "", flavoured("gold"))
+	state := framework.NewCycleState()
+	if status := f.PreScore(ctx, state, pod, nil); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+
+	// Another pod of the flavour lands on node2 while the nodes are scored.
+	f.cacheMutex.Lock()
+	f.cache["node2"]["gold"]++
+	f.cacheMutex.Unlock()
+
+	scoreWith := func(state *framework.CycleState) map[string]int64 {
+		got := make(map[string]int64)
+		for _, node := range nodes {
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(node)
+			score, status := f.Score(ctx, state, pod, nodeInfo)
+			if !status.IsSuccess() {
+				t.Fatalf("unexpected status: %v", status)
+			}
+			got[node.Name] = score
+		}
+		return got
+	}
+	// The cycle is scored against the distribution taken by PreScore.
+	if diff := cmp.Diff(map[string]int64{"node1": 0, "node2": 100, "node3": 100}, scoreWith(state)); diff != "" {
+		t.Errorf("unexpected scores within the cycle (-want,+got):\n%s", diff)
+	}
+	// The next cycle sees the new pod.
+	if diff := cmp.Diff(map[string]int64{"node1": 0, "node2": 0, "node3": 100}, scoreWith(framework.NewCycleState())); diff != "" {
+		t.Errorf("unexpected scores without PreScore (-want,+got):\n%s", diff)
+	}
+}
+
+func TestScoreDistributionAcrossRebuild(t *testing.T) {
+	ctx := context.Background()
+	nodes := []*v1.Node{
+		makeNode("node1", map[string]string{WorkerNodeLabelSelector: "", v1.LabelTopologyZone: "zone-a"}),
+		makeNode("node2", map[string]string{WorkerNodeLabelSelector: "", v1.LabelTopologyZone: "zone-b"}),
+	}
+	f := newTestPlugin(nodes, map[string]map[string]int{
+		"node1": {"gold": 1, "silver": 1},
+		"node2": {"gold": 0, "silver": 1},
+	})
+	f.targetRatios = map[string]int32{"gold": 1, "silver": 1}
+	f.fairnessShares = map[string]int32{"gold": 1, "silver": 1}
+	f.fairnessWindow = time.Hour
+	f.nodeGroupLabel = v1.LabelTopologyZone
+	pod := makePod("default", "p", "", flavoured("gold"))
+	state := framework.NewCycleState()
+	if status := f.PreScore(ctx, state, pod, nil); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+
+	// The cache is rebuilt while the nodes are scored: the gold pod moved from node1 to node2, and gold
+	// was admitted beyond its share of zone-a.
+	f.cacheMutex.Lock()
+	f.cache = map[string]map[string]int{
+		"node1": {"gold": 0, "silver": 1},
+		"node2": {"gold": 1, "silver": 1},
+	}
+	f.admissions = map[string]map[string][]time.Time{"zone-a": {"gold": {f.clock.Now(), f.clock.Now()}}}
+	f.cacheMutex.Unlock()
+
+	scoreWith := func(state *framework.CycleState) map[string]int64 {
+		got := make(map[string]int64)
+		for _, node := range nodes {
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(node)
+			score, status := f.Score(ctx, state, pod, nodeInfo)
+			if !status.IsSuccess() {
+				t.Fatalf("unexpected status: %v", status)
+			}
+			got[node.Name] = score
+		}
+		return got
+	}
+	// The ratios and fairness factors of the cycle are the ones of the distribution taken by PreScore.
+	if diff := cmp.Diff(map[string]int64{"node1": 83, "node2": 100}, scoreWith(state)); diff != "" {
+		t.Errorf("unexpected scores within the cycle (-want,+got):\n%s", diff)
+	}
+	// The next cycle is scored against the rebuilt cache.
+	if diff := cmp.Diff(map[string]int64{"node1": 50, "node2": 83}, scoreWith(framework.NewCycleState())); diff != "" {
+		t.Errorf("unexpected scores of the next cycle (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+const domainCountsStateKey = "DomainCounts"
+
+var _ = framework.PreFilterPlugin(&FlavourClusterWide{})
+
+// domainCountsState holds the counts of the flavour of the pod per topology domain of the topology key,
+// taken once per cycle by PreFilter.
+type domainCountsState struct {
+	counts map[string]int
+	key    string
+}
+
+// Clone the domain counts state. It is never modified after it is taken, so the state itself is returned.
+func (s *domainCountsState) Clone() fwk.StateData {
+	return s
+}
+
+// PreFilter takes the counts of the flavour of the pod per topology domain from the cache when the
+// flavour has a domain cap, and skips Filter when the flavour has neither a domain nor a node cap.
+func (f *FlavourClusterWide) PreFilter(ctx context.Context, state fwk.CycleState, pod *v1.Pod, nodes []fwk.NodeInfo) (*framework.PreFilterResult, *fwk.Status) {
+	flavour := f.flavourOf(pod)
+	if flavour == "" {
+		return nil, fwk.NewStatus(fwk.Skip)
+	}
+	limit, key := f.domainCap(flavour)
+	if f.nodeCap() == 0 && limit == 0 {
+		return nil, fwk.NewStatus(fwk.Skip)
+	}
+	if limit > 0 {
+		state.Write(f.stateKey(domainCountsStateKey), &domainCountsState{counts: f.domainCounts(flavour, key, nodes), key: key})
+	}
+	return nil, nil
+}
+
+// PreFilterExtensions returns nil: the domain counts are taken from the cache, which the victims of a
+// preemption still count on until they are gone.
+func (f *FlavourClusterWide) PreFilterExtensions() framework.PreFilterExtensions {
+	return nil
+}
+
+// domainCounts returns the counts of the flavour in the cache per topology domain of the topology key,
+// summed over the nodes. Nodes without the label form one domain.
+func (f *FlavourClusterWide) domainCounts(flavour, key string, nodeInfos []fwk.NodeInfo) map[string]int {
+	f.cacheMutex.RLock()
+	defer f.cacheMutex.RUnlock()
+	counts := make(map[string]int)
+	for _, nodeInfo := range nodeInfos {
+		node := nodeInfo.Node()
+		counts[node.Labels[key]] += f.cache[node.Name][flavour]
+	}
+	return counts
+}
+
+// domainCountsOf returns the domain counts taken by PreFilter, or takes them from the scheduler snapshot
+// for the node alone when the plugin is not enabled at the preFilter extension point, or when a
+// FlavourPolicy changed the topology key since.
+func (f *FlavourClusterWide) domainCountsOf(state fwk.CycleState, flavour, key string) map[string]int {
+	if state != nil {
+		if c, err := state.Read(f.stateKey(domainCountsStateKey)); err == nil {
+			if s, ok := c.(*domainCountsState); ok && s.key == key {
+				return s.counts
+			}
+		}
+	}
+	nodeInfos, err := f.handle.SnapshotSharedLister().NodeInfos().List()
+	if err != nil {
+		f.logger.Error(err, "Error listing nodes from snapshot")
+		return nil
+	}
+	return f.domainCounts(flavour, key, nodeInfos)
+}
+
+// filterDomain rejects the node when its domain of the topology key already hosts limit pods of the
+// flavour. In
+// shadow mode, the rejection is only logged.
+func (f *FlavourClusterWide) filterDomain(state fwk.CycleState, pod *v1.Pod, flavour string, nodeInfo fwk.NodeInfo, limit int, key string) *fwk.Status {
+	domain := nodeInfo.Node().Labels[key]
+	count := f.domainCountsOf(state, flavour, key)[domain]
+	if count < limit {
+		return nil
+	}
+	if f.shadowMode {
+		f.logger.V(4).Info("Shadow filter of node", "pod", klog.KObj(pod), "node", klog.KObj(nodeInfo.Node()), "flavour", flavour, "count", count, "topologyKey", key, "domain", domain)
+		return nil
+	}
+	// The reason is the same for every node, so that the scheduler aggregates it in the pod events.
+	return fwk.NewStatus(fwk.Unschedulable, fmt.Sprintf("node(s) in a %s domain that reached the maximum of %d pods of flavour %s", key, limit, flavour))
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestFilterDomainCap(t *testing.T) {
+	zone := func(name, zone string) *v1.Node {
+		return makeNode(name, map[string]string{WorkerNodeLabelSelector: "", v1.LabelTopologyZone: zone})
+	}
+	nodes := []*v1.Node{zone("node1", "zone-a"), zone("node2", "zone-a"), zone("node3", "zone-b"), makeWorker("node4")}
+	// zone-a hosts 3 gold pods, zone-b 1, and the node without a zone 2.
+	cache := map[string]map[string]int{
+		"node1": {"gold": 2, "silver": 1},
+		"node2": {"gold": 1, "silver": 0},
+		"node3": {"gold": 1, "silver": 0},
+		"node4": {"gold": 2, "silver": 0},
+	}
+	tests := []struct {
+		name          string
+		flavour       string
+		shadowMode    bool
+		skipPreFilter bool
+		wantSkip      bool
+		want          map[string]fwk.Code
+	}{
+		{
+			name:    "capped flavour",
+			flavour: "gold",
+			want:    map[string]fwk.Code{"node1": fwk.Unschedulable, "node2": fwk.Unschedulable, "node3": fwk.Success, "node4": fwk.Success},
+		},
+		{
+			name:          "without PreFilter",
+			flavour:       "gold",
+			skipPreFilter: true,
+			want:          map[string]fwk.Code{"node1": fwk.Unschedulable, "node2": fwk.Unschedulable, "node3": fwk.Success, "node4": fwk.Success},
+		},
+		{
+			name:       "shadow mode",
+			flavour:    "gold",
+			shadowMode: true,
+			want:       map[string]fwk.Code{"node1": fwk.Success, "node2": fwk.Success, "node3": fwk.Success, "node4": fwk.Success},
+		},
+		{
+			name:     "flavour without a cap",
+			flavour:  "silver",
+			wantSkip: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newTestPlugin(nodes, cache)
+			f.logger = logr.Discard()
+			f.domainCaps = map[string]int32{"gold": 3}
+			f.capTopologyKey = v1.LabelTopologyZone
+			f.shadowMode = tt.shadowMode
+
+			nodeInfos, err := f.handle.SnapshotSharedLister().NodeInfos().List()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			pod := makePod("default", "p", "", flavoured(tt.flavour))
+			var state fwk.CycleState
+			if !tt.skipPreFilter {
+				state = framework.NewCycleState()
+				_, status := f.PreFilter(ctx, state, pod, nodeInfos)
+				if status.IsSkip() != tt.wantSkip {
+					t.Fatalf("expected skip %v, got status %v", tt.wantSkip, status)
+				}
+				if tt.wantSkip {
+					return
+				}
+			}
+			got := make(map[string]fwk.Code)
+			for _, nodeInfo := range nodeInfos {
+				got[nodeInfo.Node().Name] = f.Filter(ctx, state, pod, nodeInfo).Code()
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected codes (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	schedutil "k8s.io/kubernetes/pkg/scheduler/util"
+)
+
+var _ = framework.EnqueueExtensions(&FlavourClusterWide{})
+
+// EventsToRegister returns the events that may make a pod rejected by Filter schedulable: a pod of its
+// flavour leaving a node, the flavour of the pod itself changing, a node joining or being selected, and,
+// with node update annotations or conditions, a node update completing. With enforceFlavourQuotas, the
+// pods rejected by Permit are also queued when a pod of their flavour terminates or a FlavourQuota changes.
+// With flavourPolicy, the pods rejected by Filter are also queued when the FlavourPolicy changes.
+func (f *FlavourClusterWide) EventsToRegister(_ context.Context) ([]fwk.ClusterEventWithHint, error) {
+	nodeActions := fwk.Add | fwk.UpdateNodeLabel
+	if f.gatesUpdates() {
+		nodeActions |= fwk.UpdateNodeAnnotation | fwk.UpdateNodeCondition
+	}
+	events := []fwk.ClusterEventWithHint{
+		{Event: fwk.ClusterEvent{Resource: fwk.Pod, ActionType: fwk.Update | fwk.Delete}, QueueingHintFn: f.isSchedulableAfterPodChange},
+		{Event: fwk.ClusterEvent{Resource: fwk.Node, ActionType: nodeActions}, QueueingHintFn: f.isSchedulableAfterNodeChange},
+	}
+	if f.quotaLister != nil {
+		events = append(events, fwk.ClusterEventWithHint{Event: fwk.ClusterEvent{Resource: flavourQuotaResource, ActionType: fwk.All}})
+	}
+	if f.policyArgs != nil {
+		events = append(events, fwk.ClusterEventWithHint{Event: fwk.ClusterEvent{Resource: flavourPolicyResource, ActionType: fwk.All}})
+	}
+	return events, nil
+}
+
+// isSchedulableAfterPodChange queues the pod when its own flavour label changed, or when a pod of its
+// flavour that counted on a node is deleted, completes, starts terminating or changes flavour, or, with
+// enforceFlavourQuotas, stops taking from the quotas of the flavour.
+func (f *FlavourClusterWide) isSchedulableAfterPodChange(logger klog.Logger, pod *v1.Pod, oldObj, newObj interface{}) (fwk.QueueingHint, error) {
+	original, modified, err := schedutil.As[*v1.Pod](oldObj, newObj)
+	if err != nil {
+		return fwk.Queue, err
+	}
+
+	if modified != nil && modified.UID == pod.UID {
+		if original == nil || original.Labels[f.labelName()] != modified.Labels[f.labelName()] {
+			logger.V(5).Info("pod flavour changed, it may be schedulable now", "pod", klog.KObj(pod))
+			return fwk.Queue, nil
+		}
+		return fwk.QueueSkip, nil
+	}
+
+	flavour := f.flavourOf(pod)
+	if flavour == "" {
+		return fwk.QueueSkip, nil
+	}
+	if f.countsOnNode(original, flavour) && !f.countsOnNode(modified, flavour) {
+		logger.V(5).Info("pod of the same flavour left its node, the pod may be schedulable now", "pod", klog.KObj(pod), "node", original.Spec.NodeName)
+		return fwk.Queue, nil
+	}
+	if f.quotaLister != nil && f.chargesQuota(original, flavour) && !f.chargesQuota(modified, flavour) {
+		logger.V(5).Info("pod of the same flavour terminated, the quota of the flavour may let the pod in now", "pod", klog.KObj(pod), "node", original.Spec.NodeName)
+		return fwk.Queue, nil
+	}
+	return fwk.QueueSkip, nil
+}
+
+// countsOnNode returns true when the pod is bound and counts against the cap of the flavour on its node.
+func (f *FlavourClusterWide) countsOnNode(pod *v1.Pod, flavour string) bool {
+	return pod != nil && pod.Spec.NodeName != "" && f.flavourOf(pod) == flavour &&
+		isActivePod(pod, f.excludedPodPhases)
+}
+
+// isSchedulableAfterNodeChange queues the pod when a node is added, as it hosts no pods of the flavour
+// yet, when a node is labelled so that nodeLabelSelector selects it, or when the update of a selected
+// node completes and it rejoins the flavour distribution. The pods of the flavours with a domain cap
+// are also queued when a node moves to another topology domain.
+func (f *FlavourClusterWide) isSchedulableAfterNodeChange(logger klog.Logger, pod *v1.Pod, oldObj, newObj interface{}) (fwk.QueueingHint, error) {
+	original, modified, err := schedutil.As[*v1.Node](oldObj, newObj)
+	if err != nil {
+		return fwk.Queue, err
+	}
+	if modified == nil {
+		return fwk.QueueSkip, nil
+	}
+	if original == nil || !f.selectsNode(original) && f.selectsNode(modified) {
+		logger.V(5).Info("node joined, the pod may be schedulable now", "pod", klog.KObj(pod), "node", klog.KObj(modified))
+		return fwk.Queue, nil
+	}
+	if f.selectsNode(modified) && f.isUpdating(original) && !f.isUpdating(modified) {
+		logger.V(5).Info("node update completed, the pod may be schedulable now", "pod", klog.KObj(pod), "node", klog.KObj(modified))
+		return fwk.Queue, nil
+	}
+	if limit, key := f.domainCap(f.flavourOf(pod)); limit > 0 && original.Labels[key] != modified.Labels[key] {
+		logger.V(5).Info("node moved to another topology domain, the pod may be schedulable now", "pod", klog.KObj(pod), "node", klog.KObj(modified))
+		return fwk.Queue, nil
+	}
+	return fwk.QueueSkip, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	schedlisters "sigs.k8s.io/scheduler-plugins/pkg/generated/listers/scheduling/v1alpha1"
+)
+
+func TestIsSchedulableAfterPodChange(t *testing.T) {
+	pending := uidPod("pending", "", "gold")
+	relabelled := pending.DeepCopy()
+	relabelled.Labels["flavour"] = "silver"
+	bound := uidPod("p1", "node1", "gold")
+	completed := bound.DeepCopy()
+	completed.Status.Phase = v1.PodSucceeded
+	silver := bound.DeepCopy()
+	silver.Labels["flavour"] = "silver"
+	failed := bound.DeepCopy()
+	failed.Status.Phase = v1.PodFailed
+
+	tests := []struct {
+		name   string
+		oldObj interface{}
+		newObj interface{}
+		// quotas enforces the flavour quotas, and counts the pods of every phase.
+		quotas bool
+		want   fwk.QueueingHint
+	}{
+		{
+			name:   "pod of the flavour deleted",
+			oldObj: bound,
+			want:   fwk.Queue,
+		},
+		{
+			name:   "pod of another flavour deleted",
+			oldObj: uidPod("p2", "node1", "silver"),
+			want:   fwk.QueueSkip,
+		},
+		{
+			name:   "pending pod of the flavour deleted",
+			oldObj: uidPod("p3", "", "gold"),
+			want:   fwk.QueueSkip,
+		},
+		{
+			name:   "pod of the flavour completed",
+			oldObj: bound,
+			newObj: completed,
+			want:   fwk.Queue,
+		},
+		{
+			name:   "pod of the flavour failed, still counted",
+			oldObj: bound,
+			newObj: failed,
+			quotas: true,
+			want:   fwk.Queue,
+		},
+		{
+			name:   "pod of the flavour changed flavour",
+			oldObj: bound,
+			newObj: silver,
+			want:   fwk.Queue,
+		},
+		{
+			name:   "pod of the flavour updated",
+			oldObj: bound,
+			newObj: bound.DeepCopy(),
+			want:   fwk.QueueSkip,
+		},
+		{
+			name:   "pod changed flavour",
+			oldObj: pending,
+			newObj: relabelled,
+			want:   fwk.Queue,
+		},
+		{
+			name:   "pod updated",
+			oldObj: pending,
+			newObj: pending.DeepCopy(),
+			want:   fwk.QueueSkip,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nil, nil)
+			f.excludedPodPhases = cfgv1.DefaultExcludedPodPhases
+			if tt.quotas {
+				f.excludedPodPhases = nil
+				f.quotaLister = schedlisters.NewFlavourQuotaLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+			}
+			got, err := f.isSchedulableAfterPodChange(klog.Background(), pending, tt.oldObj, tt.newObj)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIsSchedulableAfterNodeChange(t *testing.T) {
+	plain := makeNode("node1", nil)
+	updatingPlain := makeNode("node1", nil)
+	updatingPlain.Annotations = map[string]string{"weave.works/kured-reboot-in-progress": "node1"}
+	updating := makeWorker("node1")
+	updating.Annotations = updatingPlain.Annotations
+	tests := []struct {
+		name   string
+		oldObj interface{}
+		newObj interface{}
+		want   fwk.QueueingHint
+	}{
+		{
+			name:   "node added",
+			newObj: plain,
+			want:   fwk.Queue,
+		},
+		{
+			name:   "node labelled as a worker",
+			oldObj: plain,
+			newObj: makeWorker("node1"),
+			want:   fwk.Queue,
+		},
+		{
+			name:   "worker relabelled",
+			oldObj: makeWorker("node1"),
+			newObj: makeNode("node1", map[string]string{WorkerNodeLabelSelector: "", "gpu": "true"}),
+			want:   fwk.QueueSkip,
+		},
+		{
+			name:   "node relabelled",
+			oldObj: plain,
+			newObj: makeNode("node1", map[string]string{"gpu": "true"}),
+			want:   fwk.QueueSkip,
+		},
+		{
+			name:   "worker moved to another zone",
+			oldObj: makeNode("node1", map[string]string{WorkerNodeLabelSelector: "", v1.LabelTopologyZone: "zone-a"}),
+			newObj: makeNode("node1", map[string]string{WorkerNodeLabelSelector: "", v1.LabelTopologyZone: "zone-b"}),
+			want:   fwk.Queue,
+		},
+		{
+			name:   "worker update completed",
+			oldObj: updating,
+			newObj: makeWorker("node1"),
+			want:   fwk.Queue,
+		},
+		{
+			name:   "worker update started",
+			oldObj: makeWorker("node1"),
+			newObj: updating,
+			want:   fwk.QueueSkip,
+		},
+		{
+			name:   "node update completed",
+			oldObj: updatingPlain,
+			newObj: plain,
+			want:   fwk.QueueSkip,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nil, nil)
+			f.domainCaps = map[string]int32{"gold": 3}
+			f.capTopologyKey = v1.LabelTopologyZone
+			f.updateAnnotations = map[string]string{"weave.works/kured-reboot-in-progress": ""}
+			pod := uidPod("pending", "", "gold")
+			got, err := f.isSchedulableAfterNodeChange(klog.Background(), pod, tt.oldObj, tt.newObj)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	schedconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/scheme"
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/pkg/flavourclusterwide"
+)
+
+func worker(name string) *v1.Node {
+	return &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   name,
+		Labels: map[string]string{flavourclusterwide.WorkerNodeLabelSelector: ""},
+	}}
+}
+
+func flavouredPod(name, nodeName, label, flavour string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: map[string]string{label: flavour}},
+		Spec:       v1.PodSpec{NodeName: nodeName},
+	}
+}
+
+// This example creates a plugin instance outside of a scheduler profile, with its own name, client and
+// logger, as a scheduler build embedding the plugin would.
+func ExampleNewWithOptions() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := clientsetfake.NewSimpleClientset(worker("node1"), worker("node2"))
+	plugin, err := flavourclusterwide.NewWithOptions(ctx,
+		&cfgv1.FlavourClusterWideArgs{
+			LabelName:       ptr.To("team"),
+			ScoringStrategy: cfgv1.FlavourScoringProportional,
+		},
+		nil,
+		flavourclusterwide.WithName("TeamClusterWide"),
+		flavourclusterwide.WithClient(client),
+		flavourclusterwide.WithLogger(logr.Discard()),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(plugin.Name())
+	// Output: TeamClusterWide
+}
+
+// This example decodes the plugin args from a scheduler configuration, which defaults them, and creates
+// the plugin with them.
+func ExampleNewWithOptions_decodeArgs() {
+	data := []byte(`
+apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: flavour-scheduler
+  pluginConfig:
+  - name: FlavourClusterWide
+    args:
+      labelName: tier
+      scoringStrategy: VarianceReduction
+`)
+	obj, _, err := scheme.Codecs.UniversalDecoder().Decode(data, nil, nil)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	args := obj.(*schedconfig.KubeSchedulerConfiguration).Profiles[0].PluginConfig[0].Args.(*config.FlavourClusterWideArgs)
+	fmt.Println(args.LabelName, args.ScoringStrategy, args.CacheTTLSeconds)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	plugin, err := flavourclusterwide.NewWithOptions(ctx, args, nil,
+		flavourclusterwide.WithClient(clientsetfake.NewSimpleClientset()),
+		flavourclusterwide.WithLogger(logr.Discard()),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(plugin.Name())
+	// Output:
+	// tier VarianceReduction 60
+	// FlavourClusterWide
+}
+
+// This example creates a plugin instance listing nodes and pods with a fake clientset, as unit tests of
+// code embedding the plugin would, and stops its background work once done.
+func ExampleNewWithClient() {
+	client := clientsetfake.NewSimpleClientset(worker("node1"), flavouredPod("p1", "node1", "flavour", "gold"))
+	plugin, err := flavourclusterwide.NewWithClient(context.Background(), nil, nil, client)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer plugin.Close()
+	fmt.Println(plugin.Name())
+	// Output: FlavourClusterWide
+}
+
+// This example simulates where the plugin would place a pending pod of the gold flavour on three
+// nodes hosting 2, 1 and 0 gold pods, as capacity planning tools and dry runs of a configuration do.
+func ExampleSimulatePlacement() {
+	nodes := []*v1.Node{worker("node1"), worker("node2"), worker("node3")}
+	pods := []*v1.Pod{
+		flavouredPod("p1", "node1", "flavour", "gold"),
+		flavouredPod("p2", "node1", "flavour", "gold"),
+		flavouredPod("p3", "node2", "flavour", "gold"),
+	}
+	placement, err := flavourclusterwide.SimulatePlacement(context.Background(),
+		&cfgv1.FlavourClusterWideArgs{ScoringStrategy: cfgv1.FlavourScoringProportional},
+		nodes, pods, flavouredPod("pending", "", "flavour", "gold"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(placement.Node)
+	for _, node := range []string{"node1", "node2", "node3"} {
+		fmt.Printf("%s: %d\n", node, placement.Scores[node])
+	}
+	// Output:
+	// node3
+	// node1: 0
+	// node2: 50
+	// node3: 100
+}
+
+// This example counts the pods per node and flavour as the plugin cache does. Nodes get an explicit 0
+// for the flavours they do not host, and pending pods are not counted.
+func ExampleBuildSnapshot() {
+	nodes := []v1.Node{*worker("node1"), *worker("node2")}
+	pods := []v1.Pod{
+		*flavouredPod("p1", "node1", "flavour", "gold"),
+		*flavouredPod("p2", "node1", "flavour", "gold"),
+		*flavouredPod("p3", "node2", "flavour", "silver"),
+		*flavouredPod("p4", "", "flavour", "bronze"),
+	}
+	snapshot := flavourclusterwide.BuildSnapshot(nodes, pods, "flavour")
+	fmt.Println(snapshot["node1"])
+	fmt.Println(snapshot["node2"])
+	// Output:
+	// map[gold:2 silver:0]
+	// map[gold:0 silver:1]
+}
+
+// This example lists the snapshot the scheduler scores with from the API server, as tooling reporting
+// on the distribution does.
+func ExampleListSnapshot() {
+	client := clientsetfake.NewSimpleClientset(
+		worker("node1"), worker("node2"),
+		flavouredPod("p1", "node1", "flavour", "gold"),
+		flavouredPod("p2", "node2", "flavour", "gold"),
+		flavouredPod("p3", "node2", "flavour", "gold"),
+	)
+	snapshot, err := flavourclusterwide.ListSnapshot(context.Background(), client, "flavour")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, node := range []string{"node1", "node2"} {
+		fmt.Printf("%s: %d\n", node, snapshot[node]["gold"])
+	}
+	// Output:
+	// node1: 1
+	// node2: 2
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// groupAdmissions returns the admission times of the flavoured pods bound after since, per node group
+// and flavour. Nodes are grouped by the value of groupLabel; pods on unknown nodes are ignored.
+func groupAdmissions(nodes []v1.Node, pods []v1.Pod, labelName, groupLabel string, since time.Time) map[string]map[string][]time.Time {
+	groups := make(map[string]string, len(nodes))
+	for i := range nodes {
+		groups[nodes[i].Name] = nodes[i].Labels[groupLabel]
+	}
+
+	admissions := make(map[string]map[string][]time.Time)
+	for node, flavours := range recentPlacements(pods, labelName, since) {
+		group, known := groups[node]
+		if !known {
+			continue
+		}
+		if _, exists := admissions[group]; !exists {
+			admissions[group] = make(map[string][]time.Time)
+		}
+		for flavour, times := range flavours {
+			admissions[group][flavour] = append(admissions[group][flavour], times...)
+		}
+	}
+	return admissions
+}
+
+// fairnessFactor returns the factor applied to the score of the flavour on the nodes of the group.
+// It is 1 while the flavour was admitted on the group at most its share of the admissions within the
+// fairness window, and the ratio between its share and its actual admissions otherwise.
+// The cache mutex must be held by the caller.
+func (f *FlavourClusterWide) fairnessFactor(group, flavour string, now time.Time) float64 {
+	expected, actual := f.admissionShares(group, flavour, now)
+	if actual <= expected {
+		return 1
+	}
+	return expected / actual
+}
+
+// admissionShares returns the share of the admissions on the group expected for the flavour and its
+// actual share within the fairness window. Both are 0 for flavours without a share or when nothing
+// was admitted.
+// The cache mutex must be held by the caller.
+func (f *FlavourClusterWide) admissionShares(group, flavour string, now time.Time) (float64, float64) {
+	share, hasShare := f.fairnessShares[flavour]
+	if !hasShare {
+		return 0, 0
+	}
+
+	var totalShares, admitted, total int
+	for sharedFlavour, s := range f.fairnessShares {
+		totalShares += int(s)
+		count := 0
+		for _, admittedAt := range f.admissions[group][sharedFlavour] {
+			if now.Sub(admittedAt) < f.fairnessWindow {
+				count++
+			}
+		}
+		if sharedFlavour == flavour {
+			admitted = count
+		}
+		total += count
+	}
+	if total == 0 {
+		return 0, 0
+	}
+	return float64(share) / float64(totalShares), float64(admitted) / float64(total)
+}
+
+// recordAdmission accounts a pod of the flavour bound to the node for the fairness arbiter.
+// The cache mutex must be held by the caller.
+func (f *FlavourClusterWide) recordAdmission(nodeName, flavour string) {
+	if len(f.fairnessShares) == 0 {
+		return
+	}
+	group := f.nodeGroup(nodeName)
+	if f.admissions == nil {
+		f.admissions = make(map[string]map[string][]time.Time)
+	}
+	if _, exists := f.admissions[group]; !exists {
+		f.admissions[group] = make(map[string][]time.Time)
+	}
+	f.admissions[group][flavour] = append(f.admissions[group][flavour], f.clock.Now())
+}
+
+// nodeGroup returns the value of the node group label of the node, or "" when the node is unknown.
+func (f *FlavourClusterWide) nodeGroup(nodeName string) string {
+	nodeInfo, err := f.handle.SnapshotSharedLister().NodeInfos().Get(nodeName)
+	if err != nil {
+		return ""
+	}
+	return nodeInfo.Node().Labels[f.nodeGroupLabel]
+}
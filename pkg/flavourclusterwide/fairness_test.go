@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGroupAdmissions(t *testing.T) {
+	now := time.Now()
+	createdAt := func(pod *v1.Pod, created time.Time) v1.Pod {
+		pod.CreationTimestamp = metav1.NewTime(created)
+		return *pod
+	}
+	nodes := []v1.Node{
+		*makeNode("node1", map[string]string{v1.LabelTopologyZone: "a"}),
+		*makeNode("node2", map[string]string{v1.LabelTopologyZone: "a"}),
+		*makeNode("node3", map[string]string{v1.LabelTopologyZone: "b"}),
+	}
+	pods := []v1.Pod{
+		createdAt(makePod("default", "p1", "node1", flavoured("gold")), now.Add(-time.Minute)),
+		createdAt(makePod("default", "p2", "node2", flavoured("gold")), now.Add(-2*time.Minute)),
+		createdAt(makePod("default", "p3", "node3", flavoured("silver")), now.Add(-time.Minute)),
+		createdAt(makePod("default", "old", "node3", flavoured("gold")), now.Add(-time.Hour)),
+		createdAt(makePod("default", "unknown", "master", flavoured("gold")), now.Add(-time.Minute)),
+	}
+
+	got := groupAdmissions(nodes, pods, "flavour", v1.LabelTopologyZone, now.Add(-10*time.Minute))
+	if len(got["a"]["gold"]) != 2 || len(got["b"]["silver"]) != 1 || len(got["b"]["gold"]) != 0 || len(got) != 2 {
+		t.Errorf("unexpected admissions: %v", got)
+	}
+}
+
+func TestScoreFairness(t *testing.T) {
+	nodes := []*v1.Node{
+		makeNode("node1", map[string]string{v1.LabelTopologyZone: "a"}),
+		makeNode("node2", map[string]string{v1.LabelTopologyZone: "b"}),
+	}
+	cache := map[string]map[string]int{
+		"node1": {"gold": 1, "silver": 1, "bronze": 1},
+		"node2": {"gold": 1, "silver": 1, "bronze": 1},
+	}
+	newPlugin := func() *FlavourClusterWide {
+		f := newTestPlugin(nodes, cache)
+		f.fairnessShares = map[string]int32{"gold": 1, "silver": 1}
+		f.fairnessWindow = 10 * time.Minute
+		f.nodeGroupLabel = v1.LabelTopologyZone
+		return f
+	}
+
+	f := newPlugin()
+	now := f.clock.Now()
+	f.admissions = map[string]map[string][]time.Time{
+		"a": {
+			"gold":   {now, now, now},
+			"silver": {now},
+		},
+		// Admissions older than the window are not accounted for.
+		"b": {"gold": {now.Add(-time.Hour), now.Add(-time.Hour)}},
+	}
+
+	tests := []struct {
+		flavour string
+		want    map[string]int64
+	}{
+		// gold got 3/4 of the admissions in zone a for a 1/2 share.
+		{flavour: "gold", want: map[string]int64{"node1": 67, "node2": 100}},
+		{flavour: "silver", want: map[string]int64{"node1": 100, "node2": 100}},
+		// Flavours without a share are not biased.
+		{flavour: "bronze", want: map[string]int64{"node1": 100, "node2": 100}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.flavour, func(t *testing.T) {
+			got := scoreNodes(t, f, makePod("default", "p", "", flavoured(tt.flavour)))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("PostBind records admissions per node group", func(t *testing.T) {
+		f := newPlugin()
+		f.PostBind(context.Background(), nil, makePod("default", "p", "", flavoured("gold")), "node2")
+		if got := len(f.admissions["b"]["gold"]); got != 1 {
+			t.Errorf("expected 1 gold admission in zone b, got %d", got)
+		}
+	})
+}
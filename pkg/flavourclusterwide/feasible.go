@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	fwk "k8s.io/kube-scheduler/framework"
+)
+
+// feasibleNodesStateKey is the key in CycleState to the nodes that passed the Filter plugins of the
+// cycle, see stateKey.
+const feasibleNodesStateKey = "FeasibleNodes"
+
+// feasibleNodesState holds the names of the nodes PreScore was called with.
+type feasibleNodesState struct {
+	nodes sets.Set[string]
+}
+
+// Clone the feasible nodes state. It is never modified after PreScore, so the state itself is returned.
+func (s *feasibleNodesState) Clone() fwk.StateData {
+	return s
+}
+
+// startFeasibleNodes restricts the nodes in scope of the cycle to those that passed the Filter
+// plugins. The pod cannot land on the other nodes, for instance tainted or full ones, so a minimum
+// computed with them would leave every feasible node without the full score. Nothing is written
+// without nodes, as when PreScore is called outside of a scheduling cycle.
+func (f *FlavourClusterWide) startFeasibleNodes(state fwk.CycleState, nodes []fwk.NodeInfo) {
+	if len(nodes) == 0 {
+		return
+	}
+	feasible := sets.New[string]()
+	for _, nodeInfo := range nodes {
+		feasible.Insert(nodeInfo.Node().Name)
+	}
+	state.Write(f.stateKey(feasibleNodesStateKey), &feasibleNodesState{nodes: feasible})
+}
+
+// feasibleNodes returns the nodes that passed the Filter plugins of the cycle, or nil when they are
+// not known.
+func (f *FlavourClusterWide) feasibleNodes(state fwk.CycleState) sets.Set[string] {
+	if state == nil {
+		return nil
+	}
+	c, err := state.Read(f.stateKey(feasibleNodesStateKey))
+	if err != nil {
+		return nil
+	}
+	s, ok := c.(*feasibleNodesState)
+	if !ok {
+		return nil
+	}
+	return s.nodes
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestScoreFeasibleNodes(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	// node1 is the least loaded node. Only node2 and node3 are scored, as when node1 is tainted.
+	cache := map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 2},
+		"node3": {"gold": 1},
+	}
+
+	tests := []struct {
+		name     string
+		feasible []*v1.Node
+		want     map[string]int64
+	}{
+		{
+			name:     "all nodes feasible",
+			feasible: nodes,
+			want:     map[string]int64{"node2": 0, "node3": 0},
+		},
+		{
+			name:     "least loaded node filtered out",
+			feasible: nodes[1:],
+			want:     map[string]int64{"node2": 0, "node3": 100},
+		},
+		{
+			name: "feasible nodes unknown",
+			want: map[string]int64{"node2": 0, "node3": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			pod := makePod("default", "p", "", flavoured("gold"))
+
+			var feasible []fwk.NodeInfo
+			for _, node := range tt.feasible {
+				nodeInfo := framework.NewNodeInfo()
+				nodeInfo.SetNode(node)
+				feasible = append(feasible, nodeInfo)
+			}
+			state := framework.NewCycleState()
+			if status := f.PreScore(context.Background(), state, pod, feasible); !status.IsSuccess() {
+				t.Fatalf("unexpected status: %v", status)
+			}
+			got := make(map[string]int64)
+			for _, node := range nodes[1:] {
+				nodeInfo := framework.NewNodeInfo()
+				nodeInfo.SetNode(node)
+				score, status := f.Score(context.Background(), state, pod, nodeInfo)
+				if !status.IsSuccess() {
+					t.Fatalf("unexpected status: %v", status)
+				}
+				got[node.Name] = score
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+var _ = framework.FilterPlugin(&FlavourClusterWide{})
+
+// Filter rejects the node when its topology domain already hosts the domain cap of the flavour of the
+// pod, see filterDomain, or when it already hosts maxPodsPerNode pods of the flavour. The pods of the node
+// are counted from the scheduler's node snapshot rather than from the cache, so that the pods assumed in
+// earlier cycles count before the cache is updated, and so that preemption sees the node with its
+// victims removed. Completed and terminating pods do not count, as in the cache. In shadow mode, the
+// rejection is only logged.
+func (f *FlavourClusterWide) Filter(ctx context.Context, state fwk.CycleState, pod *v1.Pod, nodeInfo fwk.NodeInfo) *fwk.Status {
+	flavour := f.flavourOf(pod)
+	if flavour == "" {
+		return nil
+	}
+	if limit, key := f.domainCap(flavour); limit > 0 {
+		if status := f.filterDomain(state, pod, flavour, nodeInfo, int(limit), key); status != nil {
+			return status
+		}
+	}
+	maxPodsPerNode := f.nodeCap()
+	if maxPodsPerNode == 0 {
+		return nil
+	}
+
+	count := 0
+	for _, podInfo := range nodeInfo.GetPods() {
+		p := podInfo.GetPod()
+		if f.flavourOf(p) == flavour && isActivePod(p, f.excludedPodPhases) {
+			count++
+		}
+	}
+	if count < maxPodsPerNode {
+		return nil
+	}
+	if f.shadowMode {
+		f.logger.V(4).Info("Shadow filter of node", "pod", klog.KObj(pod), "node", klog.KObj(nodeInfo.Node()), "flavour", flavour, "count", count)
+		return nil
+	}
+	// The reason is the same for every node, so that the scheduler aggregates it in the pod events.
+	return fwk.NewStatus(fwk.Unschedulable, fmt.Sprintf("node(s) reached the maximum of %d pods of flavour %s", maxPodsPerNode, flavour))
+}
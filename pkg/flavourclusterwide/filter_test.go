@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+)
+
+func TestFilter(t *testing.T) {
+	completed := makePod("default", "done", "node1", flavoured("gold"))
+	completed.Status.Phase = v1.PodSucceeded
+	hosted := []*v1.Pod{
+		makePod("default", "p1", "node1", flavoured("gold")),
+		makePod("default", "p2", "node1", flavoured("gold")),
+		makePod("default", "p3", "node1", flavoured("silver")),
+		makePod("default", "plain", "node1", nil),
+		completed,
+	}
+
+	tests := []struct {
+		name           string
+		maxPodsPerNode int
+		shadowMode     bool
+		pod            *v1.Pod
+		want           fwk.Code
+	}{
+		{
+			name: "no maximum",
+			pod:  makePod("default", "p", "", flavoured("gold")),
+			want: fwk.Success,
+		},
+		{
+			name:           "below the maximum",
+			maxPodsPerNode: 3,
+			pod:            makePod("default", "p", "", flavoured("gold")),
+			want:           fwk.Success,
+		},
+		{
+			name:           "at the maximum",
+			maxPodsPerNode: 2,
+			pod:            makePod("default", "p", "", flavoured("gold")),
+			want:           fwk.Unschedulable,
+		},
+		{
+			name:           "other flavour",
+			maxPodsPerNode: 2,
+			pod:            makePod("default", "p", "", flavoured("silver")),
+			want:           fwk.Success,
+		},
+		{
+			name:           "pod without flavour",
+			maxPodsPerNode: 1,
+			pod:            makePod("default", "p", "", nil),
+			want:           fwk.Success,
+		},
+		{
+			name:           "shadow mode",
+			maxPodsPerNode: 2,
+			shadowMode:     true,
+			pod:            makePod("default", "p", "", flavoured("gold")),
+			want:           fwk.Success,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nil, nil)
+			f.logger = logr.Discard()
+			f.maxPodsPerNode = tt.maxPodsPerNode
+			f.shadowMode = tt.shadowMode
+			f.excludedPodPhases = cfgv1.DefaultExcludedPodPhases
+
+			nodeInfo := framework.NewNodeInfo(hosted...)
+			nodeInfo.SetNode(makeWorker("node1"))
+			if got := f.Filter(context.Background(), nil, tt.pod, nodeInfo).Code(); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
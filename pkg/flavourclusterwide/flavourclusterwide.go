@@ -2,246 +2,1010 @@
 // of pods with specific "flavour" labels across the cluster. The goal is to balance the number of pods with
 // different flavours (gold, silver, bronze) across all nodes.
 //
-// The FlavourClusterWide plugin implements the framework.ScorePlugin and framework.PostBindPlugin interfaces.
+// The FlavourClusterWide plugin implements the framework.QueueSortPlugin, framework.FilterPlugin,
+// framework.EnqueueExtensions, framework.PreScorePlugin, framework.ScorePlugin, framework.ReservePlugin,
+// framework.PermitPlugin and framework.PostBindPlugin interfaces.
 // It maintains a cache of pod counts per flavour for each node, which is periodically updated by querying the
 // Kubernetes API. The cache is protected by a mutex to ensure thread safety.
 //
 // The plugin provides the following methods:
-// - New: Initializes a new instance of the FlavourClusterWide plugin.
-// - Name: Returns the name of the plugin.
-// - updateCacheIfNeeded: Checks if the cache needs to be updated based on the last update time and updates it if necessary.
-// - PostBind: Updates the cache when a pod is bound to a node.
-// - Score: Scores a node based on the number of pods with the same flavour already running on the node.
-// - ScoreExtensions: Returns the ScoreExtensions interface for the plugin.
-// - NormalizeScore: Normalizes the scores of nodes (not implemented in this example).
+//   - New: Initializes a new instance of the FlavourClusterWide plugin.
+//   - Name: Returns the name of the plugin.
+//   - Less: Sorts the pods of the same priority by the scarcity of their flavour, when enabled at the QueueSort
+//     extension point.
+//   - PreFilter: Takes the counts of the flavour of the pod per topology domain for the domain caps, and skips
+//     Filter for the flavours without caps.
+//   - Filter: Rejects the nodes already hosting maxPodsPerFlavourPerNode pods of the flavour of the pod, and
+//     the nodes of the topology domains that reached the cap of the flavour, when set.
+//   - EventsToRegister: Requeues the pods rejected by Filter when a pod of their flavour leaves its node, their
+//     flavour changes or a node joins.
+//   - PostFilter: Preempts the pods of less important flavours on the best node for the pods fitting no node,
+//     when flavourPriorities is set.
+//   - PreScore: Counts the pending and forecast pods of the same flavour when the batch lookahead or
+//     forecasting is enabled, restricts the nodes to the feasible ones and to the topologies of pending
+//     volumes, takes the distribution of the flavour for the cycle and starts the overhead accounting.
+//   - updateCacheIfNeeded: Checks if the cache needs to be updated based on the last update time and updates it
+//     if necessary, from the background refresh started by New.
+//   - publishSnapshot: Publishes the changes of the cache of the active replica to the gossip ConfigMap, which
+//     applySnapshot applies on the standby replicas.
+//   - applyPolicy: Retunes the flavour label, target ratios, caps and topology keys with the FlavourPolicy
+//     named by flavourPolicy, when set, rebuilding the cache when the label changes.
+//   - Reserve: Counts the pod on its node as soon as it is reserved, and Unreserve rolls the count back.
+//   - Permit: Holds the pods that would exceed a FlavourQuota of their flavour until the quota lets them in,
+//     when enforceFlavourQuotas is set.
+//   - PostBind: Updates the cache when a pod is bound to a node, and records the quality of its placement.
+//   - Score: Scores a node based on the number of pods with the same flavour already running on the node.
+//   - ScoreExtensions: Returns the ScoreExtensions interface for the plugin.
+//   - NormalizeScore: Scales the scores so that the best node gets the maximum score, spreads the completion
+//     indices of indexed Jobs across the best nodes, records the scores for the placement quality, the overhead
+//     of the cycle and compares the strategies.
 package flavourclusterwide
 
 import (
 	"context"
 	"fmt"
-	"log"
+	"math"
+	"slices"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/preemption"
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+	"k8s.io/utils/clock"
 
 	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
 	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	schedlisters "sigs.k8s.io/scheduler-plugins/pkg/generated/listers/scheduling/v1alpha1"
 )
 
 const Name = "FlavourClusterWide"
 
 const defaultLabelName = "flavour"
 
+// defaultCacheTTL is the cache TTL when cacheTTLSeconds is 0.
+const defaultCacheTTL = time.Minute
+
 type FlavourClusterWide struct {
-	handle      framework.Handle
-	client      *kubernetes.Clientset
-	cache       map[string]map[string]int
-	cacheMutex  sync.RWMutex
-	lastUpdated time.Time
-	labelName   string
+	// name is the name of the plugin instance, see NewNamed.
+	name   string
+	handle framework.Handle
+	client kubernetes.Interface
+	// store lists the nodes and pods the cache is rebuilt from, see updateCacheIfNeeded.
+	store           flavourStore
+	informerFactory informers.SharedInformerFactory
+	logger          klog.Logger
+	clock           clock.WithTicker
+	cache           map[string]map[string]int
+	cacheMutex      sync.RWMutex
+	lastUpdated     time.Time
+	// cacheTTL is how long the cache is scored with before it is refreshed, see updateCacheIfNeeded.
+	cacheTTL time.Duration
+	// refreshRequests wakes the background refresh up before the end of its period, see startCacheRefresh.
+	refreshRequests chan struct{}
+	// cancel stops the background work, which background tracks, see Close.
+	cancel     context.CancelFunc
+	background sync.WaitGroup
+	// revision fingerprints the objects the cache was last built from, see snapshotRevision.
+	revision uint64
+	// label is the flavour label the cache is keyed on, which a FlavourPolicy may change, see relabel.
+	label atomic.Pointer[flavourLabel]
+	// nodeLifecycleLabel and lifecyclePreferences configure the per-flavour node lifecycle fallback chains.
+	nodeLifecycleLabel   string
+	lifecyclePreferences map[string][]string
+	// batchLookahead bounds the pending same-flavour pods listed from podLister and planned with the pod.
+	// podLister also lists the pods of the informer snapshot, see verifyCache.
+	batchLookahead int32
+	podLister      corelisters.PodLister
+	// pvcLister and storageClassLister restrict the nodes in scope to the allowed topologies of the
+	// pod's pending volumes, see startVolumeTopology. Both are nil without an informer factory.
+	pvcLister          corelisters.PersistentVolumeClaimLister
+	storageClassLister storagelisters.StorageClassLister
+	// scoringStrategy selects how a node is scored against the counts of the nodes in scope.
+	scoringStrategy pluginConfig.FlavourScoringStrategy
+	// recentWindow and recentWeightPercent configure age-weighted counting over recentPlacements,
+	// see weightedCount.
+	recentWindow        time.Duration
+	recentWeightPercent int32
+	recentPlacements    map[string]map[string][]time.Time
+	// fairnessShares, fairnessWindow and nodeGroupLabel configure the fairness arbiter over admissions,
+	// see fairnessFactor.
+	fairnessShares map[string]int32
+	fairnessWindow time.Duration
+	nodeGroupLabel string
+	admissions     map[string]map[string][]time.Time
+	// annotateNodeClass enables the NodeClassAnnotation of bound flavoured pods.
+	annotateNodeClass bool
+	// annotatePlacementQuality enables the PlacementQualityAnnotation of bound flavoured pods.
+	annotatePlacementQuality bool
+	// recorder records the scoring events of bound flavoured pods, nil unless RecordScoringEvents is
+	// enabled, see recordScoringEvent.
+	recorder events.EventRecorder
+	// countMode is what the cache counts per node and flavour, see podWeight.
+	countMode pluginConfig.FlavourCountMode
+	// sidecarContainers are the patterns of the container names left out of the counted requests.
+	sidecarContainers []string
+	// overhead tracks the time spent in the scheduling cycles, nil when there is no overhead budget.
+	overhead *overheadTracker
+	// shadowMode logs the scores and returns the same neutral score for every node.
+	shadowMode bool
+	// comparisonStrategy is computed alongside scoringStrategy and compared with it, see finishComparison.
+	comparisonStrategy pluginConfig.FlavourScoringStrategy
+	// events publishes bind decisions and fairness violations, nil when there is no CloudEvents sink.
+	events *cloudEventsPublisher
+	// audit stores the records of the binds in the audit store, nil without an audit store.
+	audit *auditTrail
+	// inPlaceRebuildThreshold is the estimated cache size above which rebuilds reconcile the cache in place.
+	inPlaceRebuildThreshold int
+	// readinessSelector and readinessConditions gate the worker nodes that are part of the flavour
+	// distribution, see gateNodes. A nil selector selects every node.
+	readinessSelector   labels.Selector
+	readinessConditions []v1.NodeConditionType
+	// updateAnnotations and updateConditions mark the nodes being updated, which the readiness gate
+	// leaves out, see isUpdating.
+	updateAnnotations map[string]string
+	updateConditions  []v1.NodeConditionType
+	// gatedNodes are the worker nodes left out of the last rebuild by the readiness gate.
+	gatedNodes sets.Set[string]
+	// weights weighs the node balance, zone balance and tie-breaker terms of the balance score.
+	weights pluginConfig.FlavourScoreWeights
+	// logCacheContents logs the full cache on updates instead of a summary, see logCache.
+	logCacheContents bool
+	// scaleDownWindow is how long the flavours of a draining node are spread strictly, see drainOrigin.
+	// drainingNodes and drainedFlavours are recorded on cache rebuilds, see recordDrains.
+	scaleDownWindow time.Duration
+	drainingNodes   sets.Set[string]
+	drainedFlavours map[string]time.Time
+	// sampling samples the recorded bind decisions, nil when every decision is recorded.
+	sampling *decisionSampler
+	// schedulerName is the scheduler whose pods are counted, see ownPods. Empty counts the pods of
+	// every scheduler.
+	schedulerName string
+	// namespaces is the namespaces whose pods are counted and scored, see flavourOf. Nil accounts for
+	// every namespace.
+	namespaces *namespaceScope
+	// tiers are the tiers of node groups the flavours are balanced across, and nodeTolerance the
+	// tolerance of the nodes below them, see tierScore. No tiers balance the flavours with the weights.
+	tiers         []pluginConfig.FlavourTopologyTier
+	nodeTolerance int32
+	// targetRatios are the target proportions of the flavours scored by the mix of their node, see
+	// ratioScore.
+	targetRatios map[string]int32
+	// balancedSlots counts the nodes at the minimum of every flavour as of the last cache rebuild,
+	// see Less.
+	balancedSlots map[string]int
+	// forecaster predicts the arrivals of every flavour within forecastHorizon, nil when forecasting
+	// is disabled.
+	forecaster      DemandForecaster
+	forecastHorizon time.Duration
+	// nodeLister lists the nodes of the informer snapshot the cache is built from or verified against,
+	// nil when neither the informer cache nor the verification is enabled.
+	nodeLister corelisters.NodeLister
+	// unknownNodeScoring selects how the nodes missing from the cache are scored.
+	unknownNodeScoring pluginConfig.FlavourUnknownNodeScoring
+	// informerCache builds the cache from the informers and keeps it current with their events, see
+	// startInformerCache. counted records where every pod is counted, nil with the polled cache without
+	// an informer factory, see startPodEndHandler.
+	informerCache bool
+	counted       map[types.UID]placement
+	// versions records the resourceVersion of the last pod event applied to the informer cache, and the
+	// deleted pods, nil without the informer cache, see acceptEvent.
+	versions map[types.UID]podVersion
+	// verifyInformerCache verifies the cache against the informers, see verifyCache.
+	verifyInformerCache bool
+	// overrides are the flavour pauses and caps set through the admin service, nil when it is disabled.
+	overrides *adminOverrides
+	// excludedPodPhases are the phases of the pods left out of the cache, with the terminating pods.
+	excludedPodPhases []v1.PodPhase
+	// reserved records where the pods counted by Reserve are counted until they are bound or unreserved.
+	reserved map[types.UID]placement
+	// indices records the pod counted for each completion index of the indexed Jobs, see replaceAttempt.
+	indices map[jobIndex]types.UID
+	// bindQueue queues the bind updates applied by a worker instead of PostBind, nil when PostBind applies
+	// them, see startPostBindQueue. reconcile is set when an update was dropped, and rebuilds the cache
+	// on the next refresh, which is requested right away, even if the listing is unchanged.
+	bindQueue              chan bindUpdate
+	postBindOverflowPolicy pluginConfig.FlavourPostBindOverflowPolicy
+	reconcile              atomic.Bool
+	// maxPodsPerNode is the number of pods of a flavour above which Filter rejects a node, 0 when unlimited.
+	maxPodsPerNode int
+	// domainCaps are the numbers of pods of the flavours above which Filter rejects the nodes of a topology
+	// domain of capTopologyKey, see filterDomain.
+	domainCaps     map[string]int32
+	capTopologyKey string
+	// metricLabels bounds the flavour and node label values of the metrics.
+	metricLabels *metricLabels
+	// defaultTopologyKey is the topology key of the pods without TopologyKeyAnnotation, see topologyKey.
+	defaultTopologyKey string
+	// gossip shares the cache with the other replicas, nil when there is no gossip ConfigMap.
+	gossip *snapshotGossip
+	// nodeSelector selects the nodes the flavours are balanced across, see selectsNode.
+	nodeSelector labels.Selector
+	// combiner merges the weighted terms of the balance score, see combineTerms.
+	combiner Combiner
+	// quotaLister lists the FlavourQuota objects enforced by Permit, nil unless enforceFlavourQuotas is
+	// set, and quotaSynced reports whether their informer synced. quotaWait is how long the pods exceeding
+	// a quota wait in Permit.
+	quotaLister schedlisters.FlavourQuotaLister
+	quotaSynced cache.InformerSynced
+	quotaWait   time.Duration
+	// podGroupLister lists the PodGroup objects whose members are admitted to the quotas together, see
+	// gangOf.
+	podGroupLister schedlisters.PodGroupLister
+	// permitted charges the pods allowed by Permit to the quotas of their flavour until the informer shows
+	// them bound, see quotaUsage, and holds the reservations of the members of the pod groups not admitted
+	// yet, see admitGang. quotaMutex guards it and serializes the quota decisions.
+	permitted  map[types.UID]quotaCharge
+	quotaMutex sync.Mutex
+	// tuning is the tuning of the FlavourPolicy in effect, which replaces targetRatios, maxPodsPerNode,
+	// domainCaps, capTopologyKey and defaultTopologyKey, nil without a policy, see ratios. policyArgs are
+	// the args the policies are validated with, nil unless flavourPolicy is set.
+	tuning     atomic.Pointer[flavourTuning]
+	policyArgs *pluginConfig.FlavourClusterWideArgs
+	// flavourRanks are the ranks of the flavours of flavourPriorities, 0 for the most important, and
+	// preemption preempts the pods of the less important flavours in PostFilter, nil without flavour
+	// priorities, see PostFilter.
+	flavourRanks map[string]int
+	preemption   *preemption.Evaluator
 }
 
+var _ = framework.QueueSortPlugin(&FlavourClusterWide{})
+var _ = framework.PreScorePlugin(&FlavourClusterWide{})
 var _ = framework.ScorePlugin(&FlavourClusterWide{})
 var _ = framework.PostBindPlugin(&FlavourClusterWide{})
 
-func New(_ context.Context, obj runtime.Object, h framework.Handle) (framework.Plugin, error) {
-	config, err := rest.InClusterConfig()
-	if err != nil {
-		return nil, fmt.Errorf("error getting cluster configuration: %v", err)
+// New initializes a new plugin and returns it. It is the factory registered with the scheduler framework.
+func New(ctx context.Context, obj runtime.Object, h framework.Handle) (framework.Plugin, error) {
+	return NewWithOptions(ctx, obj, h)
+}
+
+// NewNamed returns the factory of a plugin instance registered under the given name, so that several
+// instances with their own args, such as one balancing a flavour label and one a team label, can be
+// enabled in the same scheduler profile. Every instance has its own cache, cycle state and metric labels.
+func NewNamed(name string) frameworkruntime.PluginFactory {
+	return func(ctx context.Context, obj runtime.Object, h framework.Handle) (framework.Plugin, error) {
+		return NewWithOptions(ctx, obj, h, WithName(name))
 	}
+}
 
-	clientset, err := kubernetes.NewForConfig(config)
+// NewWithClient initializes a new plugin listing the nodes and pods with the given client, such as a fake
+// clientset in unit tests. It is NewWithOptions with WithClient.
+func NewWithClient(ctx context.Context, obj runtime.Object, h framework.Handle, client kubernetes.Interface) (*FlavourClusterWide, error) {
+	return NewWithOptions(ctx, obj, h, WithClient(client))
+}
+
+// NewWithOptions initializes a new plugin with the given options, for scheduler builds embedding the
+// plugin with their own client, informers, logger or clock.
+func NewWithOptions(ctx context.Context, obj runtime.Object, h framework.Handle, opts ...Option) (*FlavourClusterWide, error) {
+	args, err := getArgs(obj)
 	if err != nil {
-		return nil, fmt.Errorf("error creating Kubernetes client: %v", err)
+		return nil, err
 	}
 
-	labelName := defaultLabelName
-	if obj != nil {
-		// Try to cast to v1 args first (most common case)
-		if args, ok := obj.(*cfgv1.FlavourClusterWideArgs); ok {
-			if args.LabelName != nil && *args.LabelName != "" {
-				labelName = *args.LabelName
-			}
-		} else if args, ok := obj.(*pluginConfig.FlavourClusterWideArgs); ok {
-			if args.LabelName != "" {
-				labelName = args.LabelName
-			}
+	options := &pluginOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.client == nil && h != nil {
+		options.client = h.ClientSet()
+	}
+	if options.client == nil {
+		options.client, err = newClient()
+		if err != nil {
+			return nil, err
 		}
 	}
+	if options.informerFactory == nil && h != nil {
+		options.informerFactory = h.SharedInformerFactory()
+	}
+	if options.name == "" {
+		options.name = Name
+	}
+	if options.logger.GetSink() == nil {
+		options.logger = klog.FromContext(ctx).WithValues("plugin", options.name)
+	}
+	if options.clock == nil {
+		options.clock = clock.RealClock{}
+	}
 
-	return &FlavourClusterWide{
-		handle:      h,
-		client:      clientset,
-		cache:       make(map[string]map[string]int),
-		cacheMutex:  sync.RWMutex{},
-		lastUpdated: time.Time{},
-		labelName:   labelName,
-	}, nil
-}
+	labelName := args.LabelName
+	if labelName == "" {
+		labelName = defaultLabelName
+	}
+	cacheTTL := time.Duration(args.CacheTTLSeconds) * time.Second
+	if cacheTTL == 0 {
+		cacheTTL = defaultCacheTTL
+	}
 
-func (f *FlavourClusterWide) Name() string {
-	return Name
-}
+	var podLister corelisters.PodLister
+	if args.BatchLookahead > 0 {
+		if options.informerFactory == nil {
+			return nil, fmt.Errorf("batchLookahead requires an informer factory")
+		}
+		podLister = options.informerFactory.Core().V1().Pods().Lister()
+	}
+	var nodeLister corelisters.NodeLister
+	if args.VerifyInformerCache {
+		if options.informerFactory == nil {
+			return nil, fmt.Errorf("verifyInformerCache requires an informer factory")
+		}
+		nodeLister = options.informerFactory.Core().V1().Nodes().Lister()
+		podLister = options.informerFactory.Core().V1().Pods().Lister()
+	}
+	if args.InformerCache {
+		if options.informerFactory == nil {
+			return nil, fmt.Errorf("informerCache requires an informer factory")
+		}
+		nodeLister = options.informerFactory.Core().V1().Nodes().Lister()
+		podLister = options.informerFactory.Core().V1().Pods().Lister()
+	}
+	if args.EnforceFlavourQuotas {
+		if options.informerFactory == nil {
+			return nil, fmt.Errorf("enforceFlavourQuotas requires an informer factory")
+		}
+		podLister = options.informerFactory.Core().V1().Pods().Lister()
+	}
+	var pvcLister corelisters.PersistentVolumeClaimLister
+	var storageClassLister storagelisters.StorageClassLister
+	if options.informerFactory != nil {
+		pvcLister = options.informerFactory.Core().V1().PersistentVolumeClaims().Lister()
+		storageClassLister = options.informerFactory.Storage().V1().StorageClasses().Lister()
+	}
 
-// updateCacheIfNeeded checks if the cache needs to be updated based on the last update time.
-// If the cache is still valid (updated within the last minute), returns without updating.
-// Otherwise, it fetches the list of nodes and pods from the Kubernetes API, filters them based on specific labels,
-// and updates the cache with the count of pods per flavour dynamically discovered from pod labels.
-// The cache is protected by a mutex to ensure thread safety.
-func (f *FlavourClusterWide) updateCacheIfNeeded() {
-	f.cacheMutex.Lock()
-	defer f.cacheMutex.Unlock()
+	RegisterMetrics()
 
-	if time.Since(f.lastUpdated) < 1*time.Minute {
-		log.Printf("Cache is still valid, not updating")
-		return
+	nodeSelector, err := labels.Parse(args.NodeLabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing nodeLabelSelector: %v", err)
+	}
+	var readinessSelector labels.Selector
+	if args.NodeReadinessSelector != "" {
+		readinessSelector, err = labels.Parse(args.NodeReadinessSelector)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing nodeReadinessSelector: %v", err)
+		}
+	}
+	var readinessConditions []v1.NodeConditionType
+	for _, condition := range args.NodeReadinessConditions {
+		readinessConditions = append(readinessConditions, v1.NodeConditionType(condition))
+	}
+	var updateConditions []v1.NodeConditionType
+	for _, condition := range args.NodeUpdateConditions {
+		updateConditions = append(updateConditions, v1.NodeConditionType(condition))
 	}
 
-	ctx := context.TODO()
+	var forecaster DemandForecaster
+	if args.ForecastHorizonSeconds > 0 {
+		forecaster = options.forecaster
+		if forecaster == nil {
+			forecaster = newEWMAForecaster(time.Duration(args.ForecastHorizonSeconds) * time.Second)
+		}
+	}
 
-	nodes, err := f.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{
-		LabelSelector: "node-role.kubernetes.io/worker",
-	})
-	if err != nil {
-		log.Printf("Error listing nodes: %v", err)
-		return
+	var schedulerName string
+	if args.IgnoreOtherSchedulers {
+		schedulerName = profileName(h)
 	}
 
-	// Query pods that have the label (any value)
-	labelSelector := f.labelName
-	pods, err := f.client.CoreV1().Pods("").List(ctx, metav1.ListOptions{
-		LabelSelector: labelSelector,
-	})
-	if err != nil {
-		log.Printf("Error listing pods: %v", err)
-		return
+	combiner := options.combiner
+	if combiner == nil {
+		combiner = newCombiner(args.ScoreCombiner)
 	}
 
-	newCache := make(map[string]map[string]int)
-	discoveredFlavours := make(map[string]bool)
+	var overhead *overheadTracker
+	if args.OverheadBudgetMilliseconds > 0 {
+		overhead = &overheadTracker{budget: time.Duration(args.OverheadBudgetMilliseconds) * time.Millisecond}
+	}
 
-	// First pass: discover all unique flavour values from pods
-	for _, pod := range pods.Items {
-		if pod.Spec.NodeName == "" {
-			continue
+	f := &FlavourClusterWide{
+		name:                     options.name,
+		handle:                   h,
+		client:                   options.client,
+		informerFactory:          options.informerFactory,
+		logger:                   options.logger,
+		clock:                    options.clock,
+		cache:                    make(map[string]map[string]int),
+		cacheMutex:               sync.RWMutex{},
+		lastUpdated:              time.Time{},
+		cacheTTL:                 cacheTTL,
+		nodeLifecycleLabel:       args.NodeLifecycleLabel,
+		lifecyclePreferences:     args.LifecyclePreferences,
+		batchLookahead:           args.BatchLookahead,
+		podLister:                podLister,
+		pvcLister:                pvcLister,
+		storageClassLister:       storageClassLister,
+		scoringStrategy:          args.ScoringStrategy,
+		recentWindow:             time.Duration(args.RecentPlacementWindowSeconds) * time.Second,
+		recentWeightPercent:      args.RecentPlacementWeightPercent,
+		fairnessShares:           args.FairnessShares,
+		fairnessWindow:           time.Duration(args.FairnessWindowSeconds) * time.Second,
+		nodeGroupLabel:           args.NodeGroupLabel,
+		annotateNodeClass:        args.AnnotateNodeClass,
+		overhead:                 overhead,
+		shadowMode:               args.ShadowMode,
+		comparisonStrategy:       args.ComparisonStrategy,
+		inPlaceRebuildThreshold:  int(args.InPlaceRebuildThreshold),
+		readinessSelector:        readinessSelector,
+		readinessConditions:      readinessConditions,
+		updateAnnotations:        args.NodeUpdateAnnotations,
+		updateConditions:         updateConditions,
+		weights:                  args.Weights,
+		logCacheContents:         args.LogCacheContents,
+		scaleDownWindow:          time.Duration(args.ScaleDownWindowSeconds) * time.Second,
+		sampling:                 newDecisionSampler(args.DecisionSamplePercent),
+		schedulerName:            schedulerName,
+		namespaces:               newNamespaceScope(args.Namespaces),
+		forecaster:               forecaster,
+		forecastHorizon:          time.Duration(args.ForecastHorizonSeconds) * time.Second,
+		nodeLister:               nodeLister,
+		unknownNodeScoring:       args.UnknownNodeScoring,
+		informerCache:            args.InformerCache,
+		verifyInformerCache:      args.VerifyInformerCache,
+		excludedPodPhases:        args.ExcludedPodPhases,
+		postBindOverflowPolicy:   args.PostBindOverflowPolicy,
+		maxPodsPerNode:           int(args.MaxPodsPerFlavourPerNode),
+		defaultTopologyKey:       args.TopologyKey,
+		nodeSelector:             nodeSelector,
+		combiner:                 combiner,
+		targetRatios:             args.TargetRatios,
+		annotatePlacementQuality: args.AnnotatePlacementQuality,
+		countMode:                args.CountMode,
+		sidecarContainers:        args.SidecarContainers,
+		domainCaps:               args.MaxPodsPerTopologyDomain,
+		capTopologyKey:           args.CapTopologyKey,
+		metricLabels:             newMetricLabels(args.MetricsLabelCap, args.MetricsFlavours),
+	}
+	f.store = apiStore{client: options.client}
+	if args.RecordScoringEvents && h != nil {
+		f.recorder = h.EventRecorder()
+	}
+	if f.informerCache {
+		f.store = listerStore{nodes: nodeLister, pods: podLister}
+	}
+	if args.RemoteCache != nil {
+		if f.store, err = newRemoteCache(args.RemoteCache, f.store, f.Name(), f.logger); err != nil {
+			return nil, fmt.Errorf("error creating the remote cache: %v", err)
 		}
-		flavour := pod.Labels[f.labelName]
-		if flavour != "" {
-			discoveredFlavours[flavour] = true
+	}
+	// The background work stops when ctx is done or the plugin is closed, whichever comes first.
+	ctx, f.cancel = context.WithCancel(ctx)
+	auditStore := options.auditStore
+	if auditStore == nil && args.AuditStore != nil {
+		if auditStore, err = newAuditStore(args.AuditStore, f.Name()); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("error creating the audit store: %v", err)
 		}
 	}
-
-	// Initialize cache for all nodes with discovered flavours
-	for _, node := range nodes.Items {
-		newCache[node.Name] = make(map[string]int)
-		for flavour := range discoveredFlavours {
-			newCache[node.Name][flavour] = 0
+	if auditStore != nil {
+		f.audit = newAuditTrail(auditStore, f.Name(), f.logger)
+		f.runInBackground(func() { f.audit.run(ctx) })
+	}
+	if args.CloudEventsSink != "" {
+		f.events = newCloudEventsPublisher(args.CloudEventsSink, f.logger)
+		f.runInBackground(func() { f.events.run(ctx) })
+	}
+	f.label.Store(newFlavourLabel(labelName, args.LabelKeys))
+	if f.capTopologyKey == "" {
+		f.capTopologyKey = v1.LabelTopologyZone
+	}
+	f.tiers, f.nodeTolerance = splitTopologyTiers(args.TopologyTiers)
+	if f.informerCache {
+		if err := f.startInformerCache(options.informerFactory); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("error registering the informer cache event handlers: %v", err)
+		}
+	} else if options.informerFactory != nil {
+		if err := f.startPodEndHandler(options.informerFactory); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("error registering the pod end event handlers: %v", err)
 		}
 	}
-
-	// Second pass: count pods per node and flavour
-	for _, pod := range pods.Items {
-		if pod.Spec.NodeName == "" {
-			continue
+	if f.gatesUpdates() && options.informerFactory != nil {
+		if err := f.startNodeUpdateHandler(options.informerFactory); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("error registering the node update event handlers: %v", err)
 		}
-		node := pod.Spec.NodeName
-		flavour := pod.Labels[f.labelName]
-		if flavour == "" {
-			continue
+	}
+	if args.EnforceFlavourQuotas {
+		if err := f.startFlavourQuotas(ctx, options.informerFactory, options.quotaFactory, time.Duration(args.QuotaWaitSeconds)*time.Second); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("error starting the flavour quotas: %v", err)
 		}
+	}
+	if args.FlavourPolicy != "" {
+		if err := f.startFlavourPolicy(ctx, args.FlavourPolicy, args, options.quotaFactory); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("error starting the flavour policy: %v", err)
+		}
+	}
+	if len(args.FlavourPriorities) > 0 {
+		if h == nil || h.SharedInformerFactory() == nil {
+			f.Close()
+			return nil, fmt.Errorf("flavourPriorities requires a framework handle with an informer factory")
+		}
+		f.flavourRanks = make(map[string]int, len(args.FlavourPriorities))
+		for rank, flavour := range args.FlavourPriorities {
+			f.flavourRanks[flavour] = rank
+		}
+		f.preemption = preemption.NewEvaluator(f.Name(), h, &flavourPreemptor{f: f}, false)
+	}
+	if args.PostBindQueueSize > 0 {
+		f.startPostBindQueue(ctx, args.PostBindQueueSize)
+	}
+	if args.AdminAddress != "" {
+		f.overrides = newAdminOverrides()
+		if err := f.serveAdmin(ctx, args.AdminAddress, args.AdminTokenFile, args.AdminTLSCertFile, args.AdminTLSKeyFile); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	if args.SnapshotGossipConfigMap != "" {
+		if err := f.startSnapshotGossip(ctx, args.SnapshotGossipConfigMap); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("error starting the snapshot gossip: %v", err)
+		}
+	}
+	f.watchDumpSignal(ctx)
+	// The cache built from, or verified against, the informers is only refreshed once they are synced.
+	var synced []cache.InformerSynced
+	if f.informerCache || f.verifyInformerCache {
+		synced = append(synced, options.informerFactory.Core().V1().Nodes().Informer().HasSynced,
+			options.informerFactory.Core().V1().Pods().Informer().HasSynced)
+	}
+	f.startCacheRefresh(ctx, synced...)
+	cacheMetrics.add(f)
+	return f, nil
+}
 
-		if _, exists := newCache[node]; !exists {
-			newCache[node] = make(map[string]int)
+// profileName returns the name of the scheduler profile of the handle, which pods select with their
+// spec.schedulerName, or the default scheduler name when the handle does not tell it.
+func profileName(h framework.Handle) string {
+	if p, ok := h.(interface{ ProfileName() string }); ok && p.ProfileName() != "" {
+		return p.ProfileName()
+	}
+	return v1.DefaultSchedulerName
+}
+
+// newClient returns a client for the in-cluster configuration or, out of a cluster, such as in the
+// scheduler simulator or integration tests, for the kubeconfig of the KUBECONFIG environment variable or
+// the home directory, following the kubectl loading rules.
+func newClient() (kubernetes.Interface, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+		config, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("error getting cluster configuration: not running in a cluster, and %v", err)
 		}
-		if _, exists := newCache[node][flavour]; !exists {
-			newCache[node][flavour] = 0
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kubernetes client: %v", err)
+	}
+	return client, nil
+}
+
+// getArgs returns the validated internal args of the plugin. v1 args are defaulted and converted
+// first, and a nil object yields the default args. The args of named instances are not decoded by
+// the scheduler, whose scheme only knows FlavourClusterWideArgs, and are decoded as v1 args here.
+func getArgs(obj runtime.Object) (*pluginConfig.FlavourClusterWideArgs, error) {
+	if obj == nil {
+		obj = &cfgv1.FlavourClusterWideArgs{}
+	}
+
+	if unknown, ok := obj.(*runtime.Unknown); ok {
+		versioned := &cfgv1.FlavourClusterWideArgs{}
+		if err := frameworkruntime.DecodeInto(unknown, versioned); err != nil {
+			return nil, err
 		}
+		obj = versioned
+	}
 
-		newCache[node][flavour]++
+	var args *pluginConfig.FlavourClusterWideArgs
+	switch in := obj.(type) {
+	case *cfgv1.FlavourClusterWideArgs:
+		versioned := in.DeepCopy()
+		cfgv1.SetDefaults_FlavourClusterWideArgs(versioned)
+		args = &pluginConfig.FlavourClusterWideArgs{}
+		if err := cfgv1.Convert_v1_FlavourClusterWideArgs_To_config_FlavourClusterWideArgs(versioned, args, nil); err != nil {
+			return nil, err
+		}
+	case *pluginConfig.FlavourClusterWideArgs:
+		args = in
+	default:
+		return nil, fmt.Errorf("want args to be of type FlavourClusterWideArgs, got %T", obj)
 	}
 
-	f.cache = newCache
-	f.lastUpdated = time.Now()
-	log.Printf("Cache recreated from API with label '%s': %v", f.labelName, f.cache)
+	if err := validation.ValidateFlavourClusterWideArgs(args, nil); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+func (f *FlavourClusterWide) Name() string {
+	return f.name
+}
+
+// stateKey qualifies a CycleState key with the name of the plugin instance, so that the instances of a
+// profile do not overwrite each other's state.
+func (f *FlavourClusterWide) stateKey(key string) fwk.StateKey {
+	return fwk.StateKey(key + f.name)
+}
+
+// updateCacheIfNeeded checks if the cache needs to be updated based on the last update time.
+// If the cache is still valid (updated within the cache TTL) and no bind update was dropped since, returns without updating.
+// Otherwise, it fetches the list of nodes and pods from its store, the Kubernetes API by default, filtered on
+// specific labels, and rebuilds the cache with buildSnapshot unless none of the listed objects changed since
+// the last rebuild. With informerCache, the store lists the nodes and pods from the informers, and the cache is kept
+// current with their events between the rebuilds, see startInformerCache.
+// With a remote cache, the listing is shared with the other replicas and can be up to the TTL of the remote
+// cache old, see redisStore.
+// Otherwise, the pods that end are uncounted between the rebuilds when an informer factory is available,
+// see startPodEndHandler.
+// With ignoreOtherSchedulers, the pods of other schedulers are left out.
+// Terminating pods and pods in the excluded phases are not counted either.
+// With verifyInformerCache, the cache is verified against the informers: right after the rebuild when it
+// is polled, and right before it, when the counts kept current with the informer events are replaced.
+// The cache is protected by a mutex to ensure thread safety. It is called by the background refresh, see
+// startCacheRefresh, and by the admin service, never by the scheduling cycles. The API calls are bounded
+// by apiCallTimeout, as the cache lock is held while they run, and cancelled with ctx.
+func (f *FlavourClusterWide) updateCacheIfNeeded(ctx context.Context) {
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+
+	reconcile := f.reconcile.Swap(false)
+	if !reconcile && f.clock.Since(f.lastUpdated) < f.cacheTTL {
+		f.logger.V(5).Info("Cache is still valid, not updating")
+		return
+	}
+	f.rebuildCache(ctx, reconcile)
+}
+
+// rebuildCache lists the nodes and pods from the store and rebuilds the cache from them, see
+// updateCacheIfNeeded. Unless reconcile is set, the rebuild is skipped when the listing is unchanged
+// since the last one. With reconcile, the cache may have drifted from the listing, as when a bind update
+// was dropped, so it is rebuilt whatever the listing, and a failed listing requests it again. The cache
+// mutex must be held by the caller.
+func (f *FlavourClusterWide) rebuildCache(ctx context.Context, reconcile bool) {
+	start := time.Now()
+	defer func() {
+		cacheRefreshDuration.WithLabelValues(f.Name()).Observe(time.Since(start).Seconds())
+	}()
+	nodes, pods, err := f.store.List(ctx, f.labelName(), f.nodeSelector)
+	if err != nil {
+		if reconcile {
+			f.reconcile.Store(true)
+		}
+		listErrors.WithLabelValues(f.Name()).Inc()
+		f.logger.Error(err, "Error refreshing cache")
+		return
+	}
+
+	// Skip the rebuild when no node or flavoured pod changed since the last one.
+	revision := snapshotRevision(nodes, pods)
+	if !reconcile && !f.lastUpdated.IsZero() && revision == f.revision {
+		f.lastUpdated = f.clock.Now()
+		f.logger.V(5).Info("Cache is unchanged since last refresh, not rebuilding")
+		return
+	}
+	if f.informerCache && f.verifyInformerCache && !f.lastUpdated.IsZero() {
+		f.verifyCache(ctx, "events")
+	}
+	// The versions of every listed pod are recorded, whether it is counted or not, see podVersions.
+	listedPods := pods
+	if f.schedulerName != "" {
+		pods = ownPods(pods, f.schedulerName)
+	}
+	if f.namespaces != nil {
+		pods = scopedPods(pods, f.namespaces)
+	}
+	if f.scaleDownWindow > 0 {
+		f.recordCordoned(nodes)
+	}
+	nodes, pods = SchedulableNodes(nodes, pods)
+	listed := nodes
+	nodes, pods = f.gateNodes(nodes, pods)
+	f.gatedNodes = gatedNodeNames(listed, nodes)
+	// Terminated and terminating pods no longer use their node, but they were admitted on it.
+	admitted := pods
+	pods = activePods(pods, f.excludedPodPhases)
+
+	// Large caches are reconciled in place rather than rebuilt next to the current one, bounding the
+	// peak memory of the rebuild.
+	if f.cache != nil && estimatedSnapshotSize(f.cache, nodes) > f.inPlaceRebuildThreshold {
+		reconcileSnapshot(f.cache, nodes, pods, f.labelName(), f.podWeight)
+	} else {
+		f.cache = buildSnapshot(nodes, pods, f.labelName(), f.podWeight)
+	}
+	if f.recentWindow > 0 {
+		f.recentPlacements = recentPlacements(pods, f.labelName(), f.clock.Now().Add(-f.recentWindow))
+	}
+	if len(f.fairnessShares) > 0 {
+		f.admissions = groupAdmissions(nodes, admitted, f.labelName(), f.nodeGroupLabel, f.clock.Now().Add(-f.fairnessWindow))
+	}
+	if f.scaleDownWindow > 0 {
+		f.recordDrains(nodes)
+	}
+	if f.counted != nil {
+		f.counted = f.countedPods(pods)
+		f.indices = indexOwners(f.counted)
+	}
+	if f.versions != nil {
+		f.versions = f.podVersions(listedPods)
+	}
+	f.recountReserved(pods)
+	f.balancedSlots = countBalancedSlots(f.cache)
+	if !f.informerCache && f.verifyInformerCache {
+		f.verifyCache(ctx, "polled")
+	}
+	f.revision = revision
+	f.lastUpdated = f.clock.Now()
+	f.logCache(4, "Cache recreated from API")
 }
 
 // PostBind is a method of the FlavourClusterWide struct that is called after a pod is bound to a node.
 // It updates the cache with the count of pods per flavour dynamically, adding new flavours as they are discovered.
-// If the pod does not have the configured label, the method returns immediately.
+// If the pod does not have the configured label, or its namespace is not accounted for, the method returns immediately.
+// Pods already counted by Reserve or, with the informer cache, from the informer events are not counted again.
+// When enabled, the pod is also annotated with the capacity class of the node and with the quality of its placement.
+// The placement quality, the normalized score of the node, is recorded in the placement quality histogram.
+// With a PostBind queue, the updates are queued for a background worker, see enqueueBind.
+// With scoring events, an Event on the pod explains the choice of the node, see scoringExplanation.
+// With decision sampling, the annotations and events are only recorded for sampled or anomalous binds.
+// With an audit store, every bind is also written to the store, see recordAudit.
 // The cache is protected by a mutex to ensure thread safety.
-func (f *FlavourClusterWide) PostBind(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodeName string) {
+func (f *FlavourClusterWide) PostBind(ctx context.Context, state fwk.CycleState, pod *v1.Pod, nodeName string) {
 
-	flavour := pod.Labels[f.labelName]
+	flavour := f.flavourOf(pod)
 	if flavour == "" {
 		return
 	}
 
+	update := bindUpdate{pod: pod, nodeName: nodeName, flavour: flavour}
+	update.quality, update.scored = f.placementQuality(state, nodeName)
+	if f.recorder != nil {
+		update.explanation = f.scoringExplanation(state, flavour, nodeName)
+	}
+	if f.bindQueue != nil {
+		f.enqueueBind(ctx, update)
+		return
+	}
+	f.applyBind(ctx, update)
+}
+
+// applyBind records the bind of the pod: it counts the pod in the cache unless it is already counted,
+// and records its placement, admission, placement quality, annotations, event and audit record.
+func (f *FlavourClusterWide) applyBind(ctx context.Context, update bindUpdate) {
+	pod, nodeName, flavour := update.pod, update.nodeName, update.flavour
+	sampled := f.sampleDecision(pod.UID, flavour, nodeName)
+	if update.scored {
+		placementQualities.WithLabelValues(f.Name()).Observe(float64(update.quality))
+	}
+	annotations := make(map[string]string)
+	if f.annotateNodeClass && sampled {
+		if class, ok := f.nodeClass(pod, nodeName); ok {
+			annotations[NodeClassAnnotation] = class
+		}
+	}
+	if f.annotatePlacementQuality && sampled && update.scored {
+		annotations[PlacementQualityAnnotation] = strconv.FormatInt(update.quality, 10)
+	}
+	f.annotatePod(ctx, pod, annotations)
+	if sampled {
+		f.recordScoringEvent(pod, update.explanation)
+	}
+	if f.forecaster != nil {
+		f.forecaster.Observe(flavour, f.clock.Now())
+	}
+
 	f.cacheMutex.Lock()
 	defer f.cacheMutex.Unlock()
 
-	if _, exists := f.cache[nodeName]; !exists {
-		f.cache[nodeName] = make(map[string]int)
+	// The bind may already have been counted by Reserve or, with the informer cache, from the informer.
+	if _, counted := f.counted[pod.UID]; !f.bindReserved(pod) && !counted {
+		f.count(pod.UID, f.podPlacement(pod, nodeName, flavour))
+	}
+	if f.recentWindow > 0 {
+		if f.recentPlacements == nil {
+			f.recentPlacements = make(map[string]map[string][]time.Time)
+		}
+		if _, exists := f.recentPlacements[nodeName]; !exists {
+			f.recentPlacements[nodeName] = make(map[string][]time.Time)
+		}
+		f.recentPlacements[nodeName][flavour] = append(f.recentPlacements[nodeName][flavour], f.clock.Now())
+	}
+	f.recordAdmission(nodeName, flavour)
+	f.publishBind(pod, flavour, nodeName, sampled)
+	f.recordAudit(update)
+	f.logCache(5, "Cache updated")
+}
+
+// Score scores the node from 0 to 100 by how the placement of the pod there would balance its flavour
+// across the cluster. Score only reads the distribution of the flavour taken by PreScore, never the cache,
+// so that every node of the cycle is scored against the same cache generation.
+//
+// The nodes in scope are the nodes that passed the Filter plugins of the cycle, when PreScore recorded them,
+// and otherwise the nodes matching the node selector and required node affinity of the pod, restricted to
+// the topologies allowed for its pending WaitForFirstConsumer volumes. With lifecycle preferences for the
+// flavour, a node only competes with the nodes of its lifecycle rank, and its score is folded into the band
+// of that rank.
+//
+// Against the counts of the flavour on those nodes, the scoring strategy gives the score: Spread gives 100
+// to the nodes hosting the fewest pods of the flavour and 0 to the others, or to every node receiving pods
+// of the batch in proportion to its share with a batch lookahead; VarianceReduction scores inversely to the
+// variance of the counts after placement; Proportional decreases linearly from the least to the most
+// loaded node and BinPack increases linearly. A pod following a node drain is scored with Spread among the
+// nodes that are not draining. The terms weighed by weights, the balance across the groups of a topology
+// key or of topology tiers (see tierScore), a target ratio (see ratioScore) and further label keys replace
+// or refine this node balance score, and fairness shares lower it on the node groups where the flavour was
+// admitted more than its share.
+//
+// NormalizeScore then scales the scores of the cycle so that the best node gets 100. Nodes missing from the
+// cache are scored as nodes without pods, or keep half of the maximum score, left out of the scaling, with
+// the Neutral unknown node scoring. Flavours paused through the admin service score 0 on every node, and
+// capped flavours on the nodes at their cap. In shadow mode, the score is logged and 0 is returned for every
+// node, and with a comparison strategy the node is also scored with it for finishComparison. Pods without
+// the configured label, or whose namespace is not accounted for, are not scored.
+func (f *FlavourClusterWide) Score(ctx context.Context, state fwk.CycleState, pod *v1.Pod, nodeInfo fwk.NodeInfo) (int64, *fwk.Status) {
+	defer f.trackOverhead(state, f.clock.Now())
+
+	logger := klog.FromContext(klog.NewContext(ctx, f.logger)).WithValues("ExtensionPoint", "Score")
+	nodeName := nodeInfo.Node().Name
+	if !f.namespaces.accounts(pod.Namespace) {
+		return 0, fwk.NewStatus(fwk.Success, fmt.Sprintf("Namespace %s is not accounted for, scoring is not applied", pod.Namespace))
+	}
+	flavour := f.flavourOf(pod)
+	if flavour == "" {
+		return 0, fwk.NewStatus(fwk.Success, fmt.Sprintf("Pod does not have the '%s' label, scoring is not applied", f.labelName()))
+	}
+	if f.overrides.isPaused(flavour) {
+		return 0, fwk.NewStatus(fwk.Success, fmt.Sprintf("Scoring of flavour %s is paused", flavour))
 	}
 
-	// Dynamically add the flavour if it doesn't exist yet
-	if _, exists := f.cache[nodeName][flavour]; !exists {
-		f.cache[nodeName][flavour] = 0
-		// Also ensure this flavour exists in all other nodes for consistency
-		for node := range f.cache {
-			if _, nodeHasFlavour := f.cache[node][flavour]; !nodeHasFlavour {
-				f.cache[node][flavour] = 0
+	// The distribution is taken once per cycle by PreScore, and for the node alone without it.
+	dist := f.distribution(state)
+	if dist == nil {
+		dist = f.takeDistribution(state, pod)
+	}
+	now, strict := dist.now, dist.strict
+
+	// A node missing from the cache, such as a node added since the last rebuild, is either scored as a
+	// node without pods, which then wins over every known node, or gets a neutral score. Nodes gated
+	// out by the readiness gate are known and never win.
+	unknown := !dist.known.Has(nodeName) && !dist.gated.Has(nodeName)
+	if unknown {
+		unknownNodeScores.WithLabelValues(f.Name(), string(f.unknownNodeScoring)).Inc()
+		if f.unknownNodeScoring == pluginConfig.FlavourUnknownNodeNeutral {
+			logger.V(4).Info("Node is not in the cache, giving it a neutral score", "pod", klog.KObj(pod), "node", nodeName)
+			if f.shadowMode {
+				return 0, fwk.NewStatus(fwk.Success, "")
 			}
+			f.recordNeutralNode(state, nodeName)
+			return framework.MaxNodeScore / 2, fwk.NewStatus(fwk.Success, "")
 		}
 	}
 
-	f.cache[nodeName][flavour]++
-	log.Printf("Cache updated with label '%s': %v", f.labelName, f.cache)
-}
+	// With a lifecycle fallback chain, a node only competes for balance with the nodes
+	// of the same lifecycle preference rank.
+	chain, hasChain := f.lifecyclePreferences[flavour]
+	rank := 0
+	if hasChain {
+		rank = lifecycleRank(chain, nodeInfo.Node().Labels[f.nodeLifecycleLabel])
+	}
+	ranked := dist.rank(rank)
+	counts, minPods := ranked.counts, ranked.minPods
+	if unknown && dist.inScope(nodeName) {
+		// The counts are shared by the nodes scored in parallel.
+		counts = append(slices.Clone(counts), 0)
+		minPods = 0
+	}
 
-// Score evaluates a given pod and node to determine a score based on the distribution of pods with the same flavour label across the cluster.
-// It returns a score of 100 if the pod's flavour is the least common on the specified node, otherwise it returns 0.
-// If the pod does not have the configured label, scoring is not applied and a status message is returned.
-func (f *FlavourClusterWide) Score(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
+	if f.overrides.isCapped(flavour, dist.counts[nodeName], now) {
+		logger.V(5).Info("Node reached the cap override of flavour", "pod", klog.KObj(pod), "node", nodeName, "flavour", flavour)
+		return 0, fwk.NewStatus(fwk.Success, "")
+	}
 
-	flavour := pod.Labels[f.labelName]
-	if flavour == "" {
-		return 0, framework.NewStatus(framework.Success, fmt.Sprintf("Pod does not have the '%s' label, scoring is not applied", f.labelName))
+	podCount := dist.weighted[nodeName]
+	if podCount == minPods {
+		logger.V(6).Info("Pod flavour is the least common on node", "pod", klog.KObj(pod), "node", nodeName, "flavour", flavour, "count", podCount)
 	}
 
-	f.updateCacheIfNeeded()
+	// A pod with a topology key, its own or the configured one, is balanced across the groups of that label alone.
+	override := f.topologyKey(pod)
+	groupKey := f.nodeGroupLabel
+	if balancesGroups(override) {
+		groupKey = override
+	}
+	var zoneCounts []int
+	for _, count := range ranked.groups {
+		zoneCounts = append(zoneCounts, count)
+	}
+	zoneCount := ranked.groups[nodeInfo.Node().Labels[groupKey]]
+	var tieBreaker int64
+	if f.weights.TieBreaker > 0 {
+		tieBreaker = tieBreakerScore(ranked.totals, dist.totals[nodeName])
+	}
 
-	f.cacheMutex.RLock()
-	defer f.cacheMutex.RUnlock()
+	step := f.placementStep(pod)
 
-	minPods := -1
-	for _, nodeCounts := range f.cache {
-		if count, exists := nodeCounts[flavour]; exists {
-			if minPods == -1 || count < minPods {
-				minPods = count
-			}
+	strategyScore := func(strategy pluginConfig.FlavourScoringStrategy) int64 {
+		if strict {
+			strategy = pluginConfig.FlavourScoringSpread
+		}
+		var score int64
+		switch {
+		case !strict && balancesGroups(override):
+			score = balanceScore(strategy, zoneCounts, zoneCount, 1, step)
+		case !strict && override == "" && dist.ratios[flavour] > 0:
+			score = ratioScore(dist.ratios, dist.ratioCounts[nodeName], flavour)
+		case !strict && override == "" && len(f.tiers) > 0:
+			score = f.tierScore(strategy, ranked.tiers, dist.tierPaths[nodeName], podCount, f.batchSize(state), step, unknown && dist.inScope(nodeName))
+		case !strict && override == "" && (f.weights.ZoneBalance > 0 || f.weights.TieBreaker > 0):
+			score = f.combineTerms(balanceScore(strategy, counts, podCount, f.batchSize(state), step),
+				balanceScore(strategy, zoneCounts, zoneCount, 1, step), tieBreaker)
+		default:
+			score = balanceScore(strategy, counts, podCount, f.batchSize(state), step)
+		}
+		if !strict {
+			score = f.combineLabelKeys(strategy, score, dist.labelKeys, nodeName, unknown && dist.inScope(nodeName))
 		}
+		if len(f.fairnessShares) > 0 {
+			factor := dist.fairnessFactor(nodeInfo.Node().Labels[f.nodeGroupLabel])
+			score = int64(math.Round(float64(score) * factor))
+		}
+		if hasChain {
+			score = lifecycleScore(rank, len(chain), score)
+		}
+		return score
 	}
 
-	podCount := f.cache[nodeName][flavour]
-
-	if podCount == minPods {
-		log.Printf("Pod %s with flavour %s is the least common in node %s", pod.Name, flavour, nodeName)
-		return 100, framework.NewStatus(framework.Success, "")
+	score := strategyScore(f.scoringStrategy)
+	if f.comparisonStrategy != "" {
+		f.recordComparison(state, nodeName, dist.counts[nodeName], score, strategyScore(f.comparisonStrategy))
+	}
+	if f.shadowMode {
+		logger.V(4).Info("Shadow score of node", "pod", klog.KObj(pod), "node", nodeName, "flavour", flavour, "score", score)
+		return 0, fwk.NewStatus(fwk.Success, "")
 	}
 
-	return 0, framework.NewStatus(framework.Success, "")
+	return score, fwk.NewStatus(fwk.Success, "")
 }
 
 func (f *FlavourClusterWide) ScoreExtensions() framework.ScoreExtensions {
 	return f
 }
 
-func (f *FlavourClusterWide) NormalizeScore(ctx context.Context, state *framework.CycleState, pod *v1.Pod, scores framework.NodeScoreList) *framework.Status {
-	return nil
+// NormalizeScore scales the scores so that the best node gets the maximum score, whatever the fairness
+// factors, lifecycle bands and caps that lowered them, and leaves them as they are when every node
+// scores 0. The nodes given the neutral score are left out of the scaling, see normalizeScores. It
+// records the normalized scores for the placement quality, see placementQuality, and in the score
+// decisions metric, and closes the overhead accounting and the strategy comparison of the cycle.
+func (f *FlavourClusterWide) NormalizeScore(ctx context.Context, state fwk.CycleState, pod *v1.Pod, scores framework.NodeScoreList) *fwk.Status {
+	start := f.clock.Now()
+	status := f.normalizeScores(state, scores)
+	f.recordScores(state, scores)
+	f.recordDecisions(scores)
+	spreadIndex(pod, scores)
+	f.trackOverhead(state, start)
+	f.finishOverhead(state)
+	f.finishComparison(state)
+	return status
 }
@@ -0,0 +1,803 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	metricstestutil "k8s.io/component-base/metrics/testutil"
+	"k8s.io/klog/v2"
+	"k8s.io/klog/v2/textlogger"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	testutil "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+// fakeHandle only provides the scheduler snapshot to the plugin.
+type fakeHandle struct {
+	framework.Handle
+	lister framework.SharedLister
+}
+
+func (h *fakeHandle) SnapshotSharedLister() framework.SharedLister {
+	return h.lister
+}
+
+// newTestPlugin returns a plugin whose cache is already populated and fresh, so that
+// scoring never reaches out to the API server.
+func newTestPlugin(nodes []*v1.Node, cache map[string]map[string]int) *FlavourClusterWide {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	f := &FlavourClusterWide{
+		name:         Name,
+		handle:       &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)},
+		logger:       klog.Background(),
+		clock:        fakeClock,
+		cache:        cache,
+		lastUpdated:  fakeClock.Now(),
+		cacheTTL:     defaultCacheTTL,
+		nodeSelector: workerSelector(),
+		combiner:     weightedSumCombiner{},
+		metricLabels: newMetricLabels(cfgv1.DefaultMetricsLabelCap, nil),
+	}
+	f.label.Store(newFlavourLabel("flavour", nil))
+	return f
+}
+
+// bufferLogger returns a logger writing every verbosity level to w, for the tests checking the logs.
+func bufferLogger(w io.Writer) klog.Logger {
+	return textlogger.NewLogger(textlogger.NewConfig(textlogger.Output(w), textlogger.Verbosity(10)))
+}
+
+// workerSelector returns the default node selector, which selects the worker nodes.
+func workerSelector() labels.Selector {
+	selector, err := labels.Parse(WorkerNodeLabelSelector)
+	if err != nil {
+		panic(err)
+	}
+	return selector
+}
+
+func scoreNodes(t *testing.T, f *FlavourClusterWide, pod *v1.Pod) map[string]int64 {
+	t.Helper()
+	scores := make(map[string]int64)
+	nodeInfos, err := f.handle.SnapshotSharedLister().NodeInfos().List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, nodeInfo := range nodeInfos {
+		score, status := f.Score(context.Background(), nil, pod, nodeInfo)
+		if !status.IsSuccess() {
+			t.Fatalf("unexpected status: %v", status)
+		}
+		scores[nodeInfo.Node().Name] = score
+	}
+	return scores
+}
+
+func TestGetArgs(t *testing.T) {
+	customLabel := "tier"
+	tests := []struct {
+		name      string
+		obj       runtime.Object
+		wantLabel string
+		wantErr   bool
+	}{
+		{name: "nil args default", obj: nil, wantLabel: "flavour"},
+		{name: "v1 args are defaulted", obj: &cfgv1.FlavourClusterWideArgs{}, wantLabel: "flavour"},
+		{name: "v1 args", obj: &cfgv1.FlavourClusterWideArgs{LabelName: &customLabel}, wantLabel: "tier"},
+		{name: "internal args", obj: &pluginConfig.FlavourClusterWideArgs{LabelName: "tier"}, wantLabel: "tier"},
+		{name: "invalid internal args", obj: &pluginConfig.FlavourClusterWideArgs{LabelName: "not valid"}, wantErr: true},
+		{name: "wrong type", obj: &pluginConfig.CoschedulingArgs{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := getArgs(tt.obj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && args.LabelName != tt.wantLabel {
+				t.Errorf("expected label %q, got %q", tt.wantLabel, args.LabelName)
+			}
+		})
+	}
+}
+
+func TestNewWithOptions(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	client := clientsetfake.NewSimpleClientset(
+		nodes[0], nodes[1],
+		makePod("default", "p1", "node1", flavoured("gold")),
+	)
+	var logs bytes.Buffer
+	h := &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f, err := NewWithOptions(ctx, nil, h,
+		WithClient(client),
+		WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+		WithLogger(bufferLogger(&logs)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForRefresh(t, f)
+
+	got := scoreNodes(t, f, makePod("default", "p2", "", flavoured("gold")))
+	want := map[string]int64{"node1": 0, "node2": framework.MaxNodeScore}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+	}
+	if !strings.Contains(logs.String(), "Cache recreated from API") {
+		t.Errorf("expected the cache refresh to be logged to the injected logger, got %q", logs.String())
+	}
+}
+
+func TestNewContextLogger(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1")}
+	client := clientsetfake.NewSimpleClientset(nodes[0])
+	var logs bytes.Buffer
+	ctx, cancel := context.WithCancel(klog.NewContext(context.Background(), bufferLogger(&logs)))
+	defer cancel()
+
+	f, err := NewWithOptions(ctx, nil, &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)},
+		WithName("TeamClusterWide"),
+		WithClient(client),
+		WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForRefresh(t, f)
+	f.Close()
+
+	want := `"Cache recreated from API" plugin="TeamClusterWide"`
+	if !strings.Contains(logs.String(), want) {
+		t.Errorf("expected %q to be logged to the logger of the context, got %q", want, logs.String())
+	}
+}
+
+// clientHandle is a fakeHandle with the client of the scheduler.
+type clientHandle struct {
+	*fakeHandle
+	client kubernetes.Interface
+}
+
+func (h *clientHandle) ClientSet() kubernetes.Interface {
+	return h.client
+}
+
+func TestNewWithHandleClient(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	client := clientsetfake.NewSimpleClientset(nodes[0], nodes[1], makePod("default", "p1", "node1", flavoured("gold")))
+	h := &clientHandle{fakeHandle: &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)}, client: client}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f, err := NewWithOptions(ctx, nil, h,
+		WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+		WithLogger(logr.Discard()),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForRefresh(t, f)
+	expectCache(t, f, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 0}})
+}
+
+func TestNewClientFromKubeconfig(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://test.example.com:6443
+contexts:
+- name: test
+  context:
+    cluster: test
+current-context: test
+`), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Out of a cluster, the client falls back to the kubeconfig.
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	t.Setenv("KUBECONFIG", kubeconfig)
+
+	client, err := newClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := client.CoreV1().RESTClient().Get().URL().Host; got != "test.example.com:6443" {
+		t.Errorf("expected the server of the kubeconfig, got %q", got)
+	}
+}
+
+// profileHandle is a fakeHandle of a named scheduler profile.
+type profileHandle struct {
+	*fakeHandle
+	profile string
+}
+
+func (h *profileHandle) ProfileName() string {
+	return h.profile
+}
+
+func TestIgnoreOtherSchedulers(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	scheduledBy := func(name, nodeName, schedulerName string) *v1.Pod {
+		pod := makePod("default", name, nodeName, flavoured("gold"))
+		pod.Spec.SchedulerName = schedulerName
+		return pod
+	}
+	tests := []struct {
+		name    string
+		ignore  bool
+		profile string
+		want    map[string]int64
+	}{
+		{
+			// node1 hosts 2 pods of another scheduler and node2 1 pod of the profile.
+			name:    "pods of every scheduler counted",
+			profile: "flavour-scheduler",
+			want:    map[string]int64{"node1": 0, "node2": 100},
+		},
+		{
+			name:    "pods of other schedulers ignored",
+			ignore:  true,
+			profile: "flavour-scheduler",
+			want:    map[string]int64{"node1": 100, "node2": 0},
+		},
+		{
+			// Without a profile name, the pods of the default scheduler are counted.
+			name:   "default scheduler",
+			ignore: true,
+			want:   map[string]int64{"node1": 0, "node2": 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := clientsetfake.NewSimpleClientset(
+				nodes[0], nodes[1],
+				scheduledBy("p1", "node1", v1.DefaultSchedulerName),
+				scheduledBy("p2", "node1", ""),
+				scheduledBy("p3", "node2", "flavour-scheduler"),
+			)
+			h := &profileHandle{fakeHandle: &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)}, profile: tt.profile}
+			args := &cfgv1.FlavourClusterWideArgs{IgnoreOtherSchedulers: &tt.ignore}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			f, err := NewWithOptions(ctx, args, h,
+				WithClient(client),
+				WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+				WithLogger(logr.Discard()),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			waitForRefresh(t, f)
+			got := scoreNodes(t, f, scheduledBy("p4", "", tt.profile))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExcludedPodPhases(t *testing.T) {
+	inPhase := func(name, nodeName string, phase v1.PodPhase) *v1.Pod {
+		pod := makePod("default", name, nodeName, flavoured("gold"))
+		pod.Status.Phase = phase
+		return pod
+	}
+	terminating := inPhase("p4", "node1", v1.PodRunning)
+	terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	tests := []struct {
+		name     string
+		excluded []v1.PodPhase
+		want     map[string]map[string]int
+	}{
+		{
+			name: "completed pods excluded by default",
+			want: map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 1}},
+		},
+		{
+			name:     "failed pods excluded",
+			excluded: []v1.PodPhase{v1.PodFailed},
+			want:     map[string]map[string]int{"node1": {"gold": 2}, "node2": {"gold": 1}},
+		},
+		{
+			// Terminating pods are excluded whatever the phases.
+			name:     "every phase counted",
+			excluded: []v1.PodPhase{},
+			want:     map[string]map[string]int{"node1": {"gold": 3}, "node2": {"gold": 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := clientsetfake.NewSimpleClientset(
+				makeWorker("node1"), makeWorker("node2"),
+				inPhase("p1", "node1", v1.PodRunning),
+				inPhase("p2", "node1", v1.PodSucceeded),
+				inPhase("p3", "node1", v1.PodFailed),
+				terminating,
+				inPhase("p5", "node2", v1.PodPending),
+			)
+			f, err := NewWithOptions(context.Background(), &cfgv1.FlavourClusterWideArgs{ExcludedPodPhases: tt.excluded}, nil,
+				WithClient(client),
+				WithLogger(logr.Discard()),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			f.updateCacheIfNeeded(context.Background())
+			expectCache(t, f, tt.want)
+		})
+	}
+}
+
+func TestNodeLabelSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector *string
+		want     map[string]map[string]int
+	}{
+		{
+			name: "schedulable worker nodes by default",
+			want: map[string]map[string]int{"node1": {}},
+		},
+		{
+			name:     "nodes of another role",
+			selector: ptr.To("node-role.kubernetes.io/compute"),
+			want:     map[string]map[string]int{"node2": {}},
+		},
+		{
+			name:     "every schedulable node",
+			selector: ptr.To(""),
+			want:     map[string]map[string]int{"node1": {}, "node2": {}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cordoned := makeWorker("node3")
+			cordoned.Spec.Unschedulable = true
+			client := clientsetfake.NewSimpleClientset(
+				makeWorker("node1"),
+				makeNode("node2", map[string]string{"node-role.kubernetes.io/compute": ""}),
+				cordoned,
+			)
+			f, err := NewWithOptions(context.Background(), &cfgv1.FlavourClusterWideArgs{NodeLabelSelector: tt.selector}, nil,
+				WithClient(client),
+				WithLogger(logr.Discard()),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			f.updateCacheIfNeeded(context.Background())
+			expectCache(t, f, tt.want)
+		})
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespaces cfgv1.FlavourNamespaces
+		want       map[string]map[string]int
+		// wantScored is whether the pod of namespace tenant-a is scored.
+		wantScored bool
+	}{
+		{
+			name:       "every namespace by default",
+			want:       map[string]map[string]int{"node1": {"gold": 2}, "node2": {"gold": 1}},
+			wantScored: true,
+		},
+		{
+			name:       "included namespaces",
+			namespaces: cfgv1.FlavourNamespaces{Include: []string{"tenant-a", "tenant-c"}},
+			want:       map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 0}},
+			wantScored: true,
+		},
+		{
+			name:       "excluded namespaces",
+			namespaces: cfgv1.FlavourNamespaces{Exclude: []string{"kube-system", "tenant-b"}},
+			want:       map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 0}},
+			wantScored: true,
+		},
+		{
+			name:       "namespace of the pod excluded",
+			namespaces: cfgv1.FlavourNamespaces{Include: []string{"tenant-b"}},
+			want:       map[string]map[string]int{"node1": {"gold": 0}, "node2": {"gold": 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+			client := clientsetfake.NewSimpleClientset(
+				nodes[0], nodes[1],
+				makePod("kube-system", "p1", "node1", flavoured("gold")),
+				makePod("tenant-a", "p2", "node1", flavoured("gold")),
+				makePod("tenant-b", "p3", "node2", flavoured("gold")),
+			)
+			h := &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)}
+			f, err := NewWithOptions(context.Background(), &cfgv1.FlavourClusterWideArgs{Namespaces: tt.namespaces}, h,
+				WithClient(client),
+				WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+				WithLogger(logr.Discard()),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			f.updateCacheIfNeeded(context.Background())
+			expectCache(t, f, tt.want)
+
+			scores := scoreNodes(t, f, makePod("tenant-a", "p4", "", flavoured("gold")))
+			if scored := scores["node1"] != scores["node2"]; scored != tt.wantScored {
+				t.Errorf("expected the pod to be scored %v, got scores %v", tt.wantScored, scores)
+			}
+		})
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	type step struct {
+		advance   time.Duration
+		wantLists int
+	}
+	tests := []struct {
+		name  string
+		args  *cfgv1.FlavourClusterWideArgs
+		steps []step
+	}{
+		{
+			name: "default TTL",
+			args: &cfgv1.FlavourClusterWideArgs{},
+			steps: []step{
+				{advance: 0, wantLists: 1},
+				{advance: 30 * time.Second, wantLists: 1},
+				{advance: 29 * time.Second, wantLists: 1},
+				{advance: 2 * time.Second, wantLists: 2},
+				{advance: time.Second, wantLists: 2},
+			},
+		},
+		{
+			name: "configured TTL",
+			args: &cfgv1.FlavourClusterWideArgs{CacheTTLSeconds: ptr.To[int64](10)},
+			steps: []step{
+				{advance: 0, wantLists: 1},
+				{advance: 9 * time.Second, wantLists: 1},
+				{advance: 2 * time.Second, wantLists: 2},
+				{advance: 10 * time.Second, wantLists: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+			client := clientsetfake.NewSimpleClientset(nodes[0], nodes[1])
+			var podLists atomic.Int32
+			client.PrependReactor("list", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				podLists.Add(1)
+				return false, nil, nil
+			})
+			fakeClock := clocktesting.NewFakeClock(time.Now())
+			h := &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			f, err := NewWithOptions(ctx, tt.args, h,
+				WithClient(client),
+				WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+				WithLogger(logr.Discard()),
+				WithClock(fakeClock),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			pod := makePod("default", "p", "", flavoured("gold"))
+			waitForRefresh(t, f)
+
+			// Scoring only reads the cache, which the refresh rebuilds once the TTL expired.
+			for i, step := range tt.steps {
+				fakeClock.Step(step.advance)
+				f.updateCacheIfNeeded(ctx)
+				scoreNodes(t, f, pod)
+				if got := int(podLists.Load()); got != step.wantLists {
+					t.Errorf("step %d: expected %d pod lists, got %d", i, step.wantLists, got)
+				}
+			}
+		})
+	}
+}
+
+func TestScoreLifecyclePreferences(t *testing.T) {
+	lifecycleLabel := cfgv1.DefaultNodeLifecycleLabel
+	nodes := []*v1.Node{
+		makeNode("od1", map[string]string{lifecycleLabel: "on-demand"}),
+		makeNode("od2", map[string]string{lifecycleLabel: "on-demand"}),
+		makeNode("spot1", map[string]string{lifecycleLabel: "spot"}),
+		makeNode("spot2", map[string]string{lifecycleLabel: "spot"}),
+	}
+	cache := map[string]map[string]int{
+		"od1":   {"gold": 2, "silver": 1, "bronze": 0},
+		"od2":   {"gold": 1, "silver": 0, "bronze": 0},
+		"spot1": {"gold": 0, "silver": 3, "bronze": 1},
+		"spot2": {"gold": 0, "silver": 2, "bronze": 0},
+	}
+	preferences := map[string][]string{
+		"gold":   {"on-demand"},
+		"silver": {"spot", "on-demand"},
+	}
+
+	tests := []struct {
+		name    string
+		flavour string
+		want    map[string]int64
+	}{
+		{
+			// Balanced within on-demand nodes only, even though spot nodes host fewer gold pods.
+			name:    "single preferred lifecycle",
+			flavour: "gold",
+			want:    map[string]int64{"od1": 50, "od2": 99, "spot1": 49, "spot2": 49},
+		},
+		{
+			name:    "fallback chain",
+			flavour: "silver",
+			want:    map[string]int64{"od1": 33, "od2": 65, "spot1": 66, "spot2": 98},
+		},
+		{
+			name:    "flavour without preferences is balanced over all nodes",
+			flavour: "bronze",
+			want:    map[string]int64{"od1": 100, "od2": 100, "spot1": 0, "spot2": 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.nodeLifecycleLabel = lifecycleLabel
+			f.lifecyclePreferences = preferences
+
+			pod := makePod("default", "p", "", flavoured(tt.flavour))
+			got := scoreNodes(t, f, pod)
+			for node, want := range tt.want {
+				if got[node] != want {
+					t.Errorf("node %s: expected score %d, got %d", node, want, got[node])
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		scores []int64
+		// neutral are the nodes Score gave the neutral score.
+		neutral []string
+		want    []int64
+	}{
+		{name: "best node below the maximum", scores: []int64{30, 15, 0}, want: []int64{100, 50, 0}},
+		{name: "best node at the maximum", scores: []int64{100, 40, 0}, want: []int64{100, 40, 0}},
+		{name: "every node at 0", scores: []int64{0, 0}, want: []int64{0, 0}},
+		{name: "neutral node left out of the scaling", scores: []int64{30, 15, 50}, neutral: []string{"node3"}, want: []int64{100, 50, 50}},
+		{name: "every known node at 0 next to a neutral node", scores: []int64{0, 50, 0}, neutral: []string{"node2"}, want: []int64{0, 50, 0}},
+		{name: "neutral nodes alone", scores: []int64{50, 50}, neutral: []string{"node1", "node2"}, want: []int64{50, 50}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nil, map[string]map[string]int{})
+			f.unknownNodeScoring = pluginConfig.FlavourUnknownNodeNeutral
+			state := framework.NewCycleState()
+			f.startNeutralNodes(state)
+			for _, node := range tt.neutral {
+				f.recordNeutralNode(state, node)
+			}
+			scores := make(framework.NodeScoreList, len(tt.scores))
+			for i, score := range tt.scores {
+				scores[i] = framework.NodeScore{Name: fmt.Sprintf("node%d", i+1), Score: score}
+			}
+			if status := f.NormalizeScore(context.Background(), state, makePod("default", "p", "", flavoured("gold")), scores); !status.IsSuccess() {
+				t.Fatalf("unexpected status: %v", status)
+			}
+			got := make([]int64, len(scores))
+			for i := range scores {
+				got[i] = scores[i].Score
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScoreUnknownNodes(t *testing.T) {
+	// node3 joined the cluster after the last rebuild.
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	cache := map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 2},
+	}
+	tests := []struct {
+		scoring pluginConfig.FlavourUnknownNodeScoring
+		want    map[string]int64
+	}{
+		{
+			// The known nodes are scored among themselves.
+			scoring: pluginConfig.FlavourUnknownNodeEmpty,
+			want:    map[string]int64{"node1": 100, "node2": 0, "node3": 100},
+		},
+		{
+			scoring: pluginConfig.FlavourUnknownNodeNeutral,
+			want:    map[string]int64{"node1": 100, "node2": 0, "node3": 50},
+		},
+	}
+	RegisterMetrics()
+	for _, tt := range tests {
+		t.Run(string(tt.scoring), func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.unknownNodeScoring = tt.scoring
+			before, err := metricstestutil.GetCounterMetricValue(unknownNodeScores.WithLabelValues(Name, string(tt.scoring)))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := scoreNodes(t, f, makePod("default", "p", "", flavoured("gold")))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+			after, err := metricstestutil.GetCounterMetricValue(unknownNodeScores.WithLabelValues(Name, string(tt.scoring)))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if after-before != 1 {
+				t.Errorf("expected 1 unknown node score, got %v", after-before)
+			}
+		})
+	}
+}
+
+func TestScoreUnknownNodeNeutralAmongZeroScores(t *testing.T) {
+	// node3 joined the cluster after the last rebuild, and the cap override leaves every known node at 0.
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	f := newTestPlugin(nodes, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 1},
+	})
+	f.unknownNodeScoring = pluginConfig.FlavourUnknownNodeNeutral
+	f.overrides = newAdminOverrides()
+	f.overrides.caps["gold"] = CapOverride{MaxPerNode: 1, Expires: f.clock.Now().Add(time.Hour)}
+
+	scores, _, err := f.runCycle(context.Background(), makePod("default", "p", "", flavoured("gold")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := make(map[string]int64)
+	for _, score := range scores {
+		got[score.Name] = score.Score
+	}
+	// The unknown node keeps its neutral score rather than being scaled to the best score.
+	want := map[string]int64{"node1": 0, "node2": 0, "node3": framework.MaxNodeScore / 2}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+	}
+}
+
+func TestScoreShadowMode(t *testing.T) {
+	var logs bytes.Buffer
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	f := newTestPlugin(nodes, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 3}})
+	f.logger = bufferLogger(&logs)
+	f.shadowMode = true
+
+	got := scoreNodes(t, f, makePod("default", "p", "", flavoured("gold")))
+	if diff := cmp.Diff(map[string]int64{"node1": 0, "node2": 0}, got); diff != "" {
+		t.Errorf("expected neutral scores (-want,+got):\n%s", diff)
+	}
+	if !strings.Contains(logs.String(), `"Shadow score of node" ExtensionPoint="Score" pod="default/p" node="node1" flavour="gold" score=100`) {
+		t.Errorf("expected the computed score to be logged, got %q", logs.String())
+	}
+}
+
+func TestNamedInstances(t *testing.T) {
+	pending := func(name string, labels map[string]string) *v1.Pod {
+		pod := makePod("default", name, "", labels)
+		pod.UID = types.UID(name)
+		return pod
+	}
+	client := clientsetfake.NewSimpleClientset(
+		pending("gold-1", map[string]string{"flavour": "gold"}),
+		pending("gold-2", map[string]string{"flavour": "gold"}),
+		pending("team-a", map[string]string{"team": "a"}),
+	)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	h := &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nil)}
+	newInstance := func(factory frameworkruntime.PluginFactory, args string) *FlavourClusterWide {
+		t.Helper()
+		// Args of named instances reach the plugin undecoded.
+		obj := &runtime.Unknown{Raw: []byte(args), ContentType: runtime.ContentTypeJSON}
+		p, err := factory(context.Background(), obj, h)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return p.(*FlavourClusterWide)
+	}
+	withOptions := func(name string) frameworkruntime.PluginFactory {
+		return func(ctx context.Context, obj runtime.Object, h framework.Handle) (framework.Plugin, error) {
+			return NewWithOptions(ctx, obj, h,
+				WithName(name),
+				WithClient(client),
+				WithInformerFactory(informerFactory),
+				WithLogger(logr.Discard()),
+			)
+		}
+	}
+	flavours := newInstance(withOptions(Name), `{"batchLookahead": 5}`)
+	teams := newInstance(withOptions("FlavourClusterWideTeam"), `{"labelName": "team", "batchLookahead": 5}`)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+
+	if flavours.Name() != Name || teams.Name() != "FlavourClusterWideTeam" {
+		t.Errorf("expected instances named %s and FlavourClusterWideTeam, got %s and %s", Name, flavours.Name(), teams.Name())
+	}
+	if teams.labelName() != "team" {
+		t.Errorf("expected the args of the named instance to be decoded, got label %q", teams.labelName())
+	}
+
+	// Both instances plan their own batch in the same cycle state.
+	pod := pending("current", map[string]string{"flavour": "gold", "team": "a"})
+	state := framework.NewCycleState()
+	for _, f := range []*FlavourClusterWide{flavours, teams} {
+		if status := f.PreScore(ctx, state, pod, nil); !status.IsSuccess() {
+			t.Fatalf("unexpected status: %v", status)
+		}
+	}
+	if got := flavours.batchSize(state); got != 3 {
+		t.Errorf("expected a batch of 3 gold pods, got %d", got)
+	}
+	if got := teams.batchSize(state); got != 2 {
+		t.Errorf("expected a batch of 2 pods of team a, got %d", got)
+	}
+}
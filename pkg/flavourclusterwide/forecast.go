@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// ewmaSmoothing is the weight of the last interval in the moving average of the built-in forecaster.
+const ewmaSmoothing = 0.3
+
+// DemandForecaster predicts the near-term arrivals of every flavour. The plugin plans the forecast
+// arrivals together with the pod being scheduled, reserving headroom for them on the least loaded
+// nodes. Scheduler builds can provide their own forecaster, such as a client of an external
+// forecasting service, with WithForecaster. Implementations must be safe for concurrent use.
+type DemandForecaster interface {
+	// Observe records that a pod of the flavour was bound at the given time. It is called from PostBind
+	// and must not block.
+	Observe(flavour string, at time.Time)
+	// Forecast returns the number of pods of the flavour expected to arrive within the horizon.
+	Forecast(flavour string, now time.Time, horizon time.Duration) int
+}
+
+// ewmaForecaster is the built-in DemandForecaster. It counts the binds of every flavour over
+// consecutive intervals and forecasts from an exponentially weighted moving average of the counts.
+type ewmaForecaster struct {
+	interval time.Duration
+	mu       sync.Mutex
+	flavours map[string]*ewmaRate
+}
+
+// ewmaRate is the moving average of the binds of a flavour per interval, and the binds of the
+// interval in progress.
+type ewmaRate struct {
+	start time.Time
+	count int
+	rate  float64
+}
+
+var _ DemandForecaster = &ewmaForecaster{}
+
+// newEWMAForecaster returns a forecaster averaging the binds over intervals of the given length.
+func newEWMAForecaster(interval time.Duration) *ewmaForecaster {
+	return &ewmaForecaster{interval: interval, flavours: make(map[string]*ewmaRate)}
+}
+
+func (e *ewmaForecaster) Observe(flavour string, at time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	r, ok := e.flavours[flavour]
+	if !ok {
+		r = &ewmaRate{start: at}
+		e.flavours[flavour] = r
+	}
+	r.advance(at, e.interval)
+	r.count++
+}
+
+func (e *ewmaForecaster) Forecast(flavour string, now time.Time, horizon time.Duration) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	r, ok := e.flavours[flavour]
+	if !ok {
+		return 0
+	}
+	r.advance(now, e.interval)
+	return int(math.Round(r.rate * float64(horizon) / float64(e.interval)))
+}
+
+// advance folds the intervals completed by now into the moving average. Intervals without binds
+// decay it.
+func (r *ewmaRate) advance(now time.Time, interval time.Duration) {
+	elapsed := int(now.Sub(r.start) / interval)
+	if elapsed <= 0 {
+		return
+	}
+	r.rate = ewmaSmoothing*float64(r.count) + (1-ewmaSmoothing)*r.rate
+	r.rate *= math.Pow(1-ewmaSmoothing, float64(elapsed-1))
+	r.count = 0
+	r.start = r.start.Add(time.Duration(elapsed) * interval)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestEWMAForecaster(t *testing.T) {
+	start := time.Now()
+	e := newEWMAForecaster(time.Minute)
+	for i := 0; i < 10; i++ {
+		e.Observe("gold", start.Add(time.Duration(i)*time.Second))
+	}
+
+	steps := []struct {
+		name    string
+		at      time.Duration
+		observe int
+		horizon time.Duration
+		want    int
+	}{
+		// 0.3*10
+		{name: "first interval", at: time.Minute, horizon: time.Minute, want: 3},
+		{name: "longer horizon", at: time.Minute, horizon: 2 * time.Minute, want: 6},
+		// 0.3*10 + 0.7*3
+		{name: "second interval", at: 2 * time.Minute, observe: 10, horizon: time.Minute, want: 5},
+		// (0.3*0 + 0.7*5.1) * 0.7
+		{name: "idle intervals", at: 4 * time.Minute, horizon: time.Minute, want: 2},
+	}
+	for _, step := range steps {
+		now := start.Add(step.at)
+		for i := 0; i < step.observe; i++ {
+			e.Observe("gold", now.Add(-time.Second))
+		}
+		if got := e.Forecast("gold", now, step.horizon); got != step.want {
+			t.Errorf("%s: expected %d arrivals, got %d", step.name, step.want, got)
+		}
+	}
+	if got := e.Forecast("silver", start, time.Minute); got != 0 {
+		t.Errorf("expected no arrivals of an unknown flavour, got %d", got)
+	}
+}
+
+// staticForecaster forecasts the same arrivals for every flavour.
+type staticForecaster int
+
+func (s staticForecaster) Observe(string, time.Time) {}
+
+func (s staticForecaster) Forecast(string, time.Time, time.Duration) int {
+	return int(s)
+}
+
+func TestScoreForecast(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	f := newTestPlugin(nodes, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 2},
+		"node3": {"gold": 6},
+	})
+	f.forecaster = staticForecaster(5)
+	f.forecastHorizon = time.Minute
+
+	// The pod and the 5 forecast arrivals level node1 and node2 at 4.
+	pod := makePod("default", "p", "", flavoured("gold"))
+	state := framework.NewCycleState()
+	if status := f.PreScore(context.Background(), state, pod, nil); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	got := make(map[string]int64)
+	for _, node := range nodes {
+		nodeInfo := framework.NewNodeInfo()
+		nodeInfo.SetNode(node)
+		score, status := f.Score(context.Background(), state, pod, nodeInfo)
+		if !status.IsSuccess() {
+			t.Fatalf("unexpected status: %v", status)
+		}
+		got[node.Name] = score
+	}
+	want := map[string]int64{"node1": 100, "node2": 50, "node3": 0}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+// gangOf returns the full name and the PodGroup of the pod group the pod is admitted to the quotas with,
+// or "" when the pod is admitted on its own: it has no PodGroup, its PodGroup needs fewer than two
+// members, or the pod group was already admitted, as some of its members are bound or were allowed. The
+// quota mutex must be held by the caller.
+func (f *FlavourClusterWide) gangOf(pod *v1.Pod) (string, *v1alpha1.PodGroup, error) {
+	name := util.GetPodGroupLabel(pod)
+	if name == "" || f.podGroupLister == nil {
+		return "", nil, nil
+	}
+	pg, err := f.podGroupLister.PodGroups(pod.Namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return "", nil, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	if pg.Spec.MinMember < 2 {
+		return "", nil, nil
+	}
+	gang := util.GetPodGroupFullName(pod)
+	for uid, charge := range f.permitted {
+		if charge.gang == gang && !charge.reserved && !f.staleCharge(uid, charge) {
+			return "", nil, nil
+		}
+	}
+	members, err := f.podLister.Pods(pod.Namespace).List(labels.SelectorFromSet(labels.Set{v1alpha1.PodGroupLabel: name}))
+	if err != nil {
+		return "", nil, err
+	}
+	for _, member := range members {
+		if member.Spec.NodeName != "" && isActivePod(member, terminalPodPhases) {
+			return "", nil, nil
+		}
+	}
+	return gang, pg, nil
+}
+
+// admitGang admits the pod group once minMember of its members hold a reservation, whatever their
+// flavours: the reservations become charges, and the members waiting for the others are allowed. It
+// returns false while the pod group is incomplete, its members then holding their reservations until
+// they are allowed or the pod group is released, see releaseGang. The quota mutex must be held by the
+// caller.
+func (f *FlavourClusterWide) admitGang(gang string, pg *v1alpha1.PodGroup, waiting []framework.WaitingPod) bool {
+	members := sets.New[types.UID]()
+	for uid, charge := range f.permitted {
+		if charge.gang != gang || !charge.reserved {
+			continue
+		}
+		if f.staleCharge(uid, charge) {
+			delete(f.permitted, uid)
+			continue
+		}
+		members.Insert(uid)
+	}
+	if members.Len() < int(pg.Spec.MinMember) {
+		return false
+	}
+	for uid := range members {
+		charge := f.permitted[uid]
+		if charge.flavour == "" {
+			// The members without a flavour only count towards the pod group.
+			delete(f.permitted, uid)
+			continue
+		}
+		charge.reserved = false
+		f.permitted[uid] = charge
+	}
+	for _, waitingPod := range waiting {
+		if members.Has(waitingPod.GetPod().UID) {
+			waitingPod.Allow(f.Name())
+		}
+	}
+	return true
+}
+
+// releaseGang drops the reservations of the members of the pod group not admitted yet, and returns true
+// when it held any. The quota mutex must be held by the caller.
+func (f *FlavourClusterWide) releaseGang(gang string) bool {
+	released := false
+	for uid, charge := range f.permitted {
+		if charge.gang == gang && charge.reserved {
+			delete(f.permitted, uid)
+			released = true
+		}
+	}
+	return released
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	schedlisters "sigs.k8s.io/scheduler-plugins/pkg/generated/listers/scheduling/v1alpha1"
+)
+
+func makePodGroup(name string, minMember int32, timeout *int32) *v1alpha1.PodGroup {
+	return &v1alpha1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       v1alpha1.PodGroupSpec{MinMember: minMember, ScheduleTimeoutSeconds: timeout},
+	}
+}
+
+// member adds the pod to the pod group.
+func member(pod *v1.Pod, podGroup string) *v1.Pod {
+	pod.Labels[v1alpha1.PodGroupLabel] = podGroup
+	return pod
+}
+
+// newGangQuotaPlugin returns a test plugin enforcing the quotas on the pods, and on the members of the
+// pod groups together.
+func newGangQuotaPlugin(quotas []*v1alpha1.FlavourQuota, podGroups []*v1alpha1.PodGroup, pods []*v1.Pod, wait time.Duration) *FlavourClusterWide {
+	f, _ := newQuotaPlugin(quotas, pods, wait)
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, pg := range podGroups {
+		indexer.Add(pg)
+	}
+	f.podGroupLister = schedlisters.NewPodGroupLister(indexer)
+	return f
+}
+
+func TestGangQuotaAdmission(t *testing.T) {
+	driver := member(uidPod("driver", "", "gold"), "job")
+	worker1 := member(uidPod("worker1", "", "bronze"), "job")
+	worker2 := member(uidPod("worker2", "", "bronze"), "job")
+	single := member(uidPod("single", "", "gold"), "single")
+	f := newGangQuotaPlugin(
+		[]*v1alpha1.FlavourQuota{makeQuota("gold", "gold", ptr.To[int32](2), nil)},
+		[]*v1alpha1.PodGroup{makePodGroup("job", 3, ptr.To[int32](30)), makePodGroup("single", 1, nil)},
+		[]*v1.Pod{driver, worker1, worker2, single}, time.Minute)
+	handle := f.handle.(*waitingPodsHandle)
+
+	// The members of flavours with and without a quota reserve their part until the pod group is complete.
+	for _, pod := range []*v1.Pod{driver, worker1} {
+		status, wait := f.Permit(context.Background(), framework.NewCycleState(), pod, "node1")
+		if status.Code() != fwk.Wait || wait != 30*time.Second {
+			t.Fatalf("expected %s to wait for 30s, got %v for %v", pod.Name, status, wait)
+		}
+		if want := "waiting for the other members of pod group default/job to fit in the flavour quotas"; status.Message() != want {
+			t.Errorf("expected message %q, got %q", want, status.Message())
+		}
+		handle.waiting = append(handle.waiting, &waitingPod{pod: pod})
+	}
+	if !f.permitted[driver.UID].reserved {
+		t.Errorf("expected the driver to hold a reservation")
+	}
+
+	// The last member completes the pod group, which is allowed as a whole.
+	if status, _ := f.Permit(context.Background(), framework.NewCycleState(), worker2, "node1"); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	if allowed := allowedPods(handle); len(allowed) != 2 {
+		t.Errorf("expected the waiting members to be allowed, got %v", allowed)
+	}
+	if charge := f.permitted[driver.UID]; charge.reserved || charge.flavour != "gold" {
+		t.Errorf("expected the driver to be charged to its quota, got %+v", charge)
+	}
+
+	// The pods of a pod group of a single member are admitted on their own.
+	if status, _ := f.Permit(context.Background(), framework.NewCycleState(), single, "node1"); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	if f.permitted[single.UID].reserved {
+		t.Errorf("expected the single pod to be charged without a reservation")
+	}
+}
+
+func TestGangQuotaRelease(t *testing.T) {
+	member1 := member(uidPod("member1", "", "gold"), "job")
+	member2 := member(uidPod("member2", "", "gold"), "job")
+	other := uidPod("other", "", "gold")
+	f := newGangQuotaPlugin(
+		[]*v1alpha1.FlavourQuota{makeQuota("gold", "gold", ptr.To[int32](1), nil)},
+		[]*v1alpha1.PodGroup{makePodGroup("job", 2, nil)},
+		[]*v1.Pod{member1, member2, other}, time.Minute)
+	handle := f.handle.(*waitingPodsHandle)
+
+	// The reservation of member1 takes the quota, which holds member2 and the other pod.
+	for _, pod := range []*v1.Pod{member1, member2, other} {
+		if status, _ := f.Permit(context.Background(), framework.NewCycleState(), pod, "node1"); status.Code() != fwk.Wait {
+			t.Fatalf("expected %s to wait, got %v", pod.Name, status)
+		}
+		handle.waiting = append(handle.waiting, &waitingPod{pod: pod})
+	}
+
+	// member2 times out: the pod group releases its reservations instead of holding the quota in part.
+	handle.waiting[1].rejected = true
+	f.Unreserve(context.Background(), framework.NewCycleState(), member2, "node1")
+	if !handle.waiting[0].rejected {
+		t.Errorf("expected member1 to be rejected with its pod group")
+	}
+	if _, charged := f.permitted[member1.UID]; charged {
+		t.Errorf("expected the reservation of member1 to be released")
+	}
+	if allowed := allowedPods(handle); len(allowed) != 1 || allowed[0] != "other" {
+		t.Errorf("expected the other pod to be allowed, got %v", allowed)
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// SnapshotGenerationAnnotation is the annotation of the gossip ConfigMap holding the generation of the
+	// snapshot it holds, incremented on every publication.
+	SnapshotGenerationAnnotation = "scheduling.x-k8s.io/flavour-snapshot-generation"
+
+	// snapshotGossipInterval is how often the active replica publishes the changes of its cache.
+	snapshotGossipInterval = 5 * time.Second
+)
+
+// snapshotGossip shares the cache of the replica running the scheduling cycles with the standby replicas
+// through a ConfigMap holding the counts of every node under a data key of its own, so that a publication
+// only patches the nodes that changed since the last one.
+type snapshotGossip struct {
+	namespace string
+	name      string
+	// active is set once the replica runs a scheduling cycle, after which it publishes its cache and no
+	// longer replaces it with the published one.
+	active atomic.Bool
+	// generation and published are the generation and the data of the last publication, nil until the
+	// ConfigMap is first read.
+	generation int64
+	published  map[string]string
+}
+
+// markActive records that the replica runs the scheduling cycles. It is a no-op without gossip.
+func (g *snapshotGossip) markActive() {
+	if g != nil {
+		g.active.Store(true)
+	}
+}
+
+// startSnapshotGossip watches the gossip ConfigMap, replacing the cache with it while the replica is on
+// standby, and publishes the changes of the cache to it every snapshotGossipInterval once the replica is
+// active, until ctx is done.
+func (f *FlavourClusterWide) startSnapshotGossip(ctx context.Context, ref string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(ref)
+	if err != nil {
+		return err
+	}
+	f.gossip = &snapshotGossip{namespace: namespace, name: name}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(f.client, 0, informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+		}))
+	if _, err := factory.Core().V1().ConfigMaps().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if cm, ok := obj.(*v1.ConfigMap); ok {
+				f.applySnapshot(cm)
+			}
+		},
+		UpdateFunc: func(_, obj any) {
+			if cm, ok := obj.(*v1.ConfigMap); ok {
+				f.applySnapshot(cm)
+			}
+		},
+	}); err != nil {
+		return err
+	}
+	factory.Start(ctx.Done())
+
+	f.runInBackground(func() {
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+			defer cancel()
+			if err := f.publishSnapshot(ctx); err != nil {
+				f.logger.Error(err, "Error publishing the cache", "configMap", ref)
+			}
+		}, snapshotGossipInterval)
+		factory.Shutdown()
+	})
+	return nil
+}
+
+// publishSnapshot patches the gossip ConfigMap with the nodes whose counts changed since the last
+// publication, and removes the nodes that left the cache. The ConfigMap is created when it does not
+// exist, and its generation carries on from the previous active replica otherwise. Standby replicas
+// publish nothing.
+func (f *FlavourClusterWide) publishSnapshot(ctx context.Context) error {
+	g := f.gossip
+	if !g.active.Load() {
+		return nil
+	}
+	configMaps := f.client.CoreV1().ConfigMaps(g.namespace)
+
+	f.cacheMutex.RLock()
+	data := make(map[string]string, len(f.cache))
+	for node, counts := range f.cache {
+		data[node] = encodeNodeCounts(counts)
+	}
+	f.cacheMutex.RUnlock()
+
+	if g.published == nil {
+		cm, err := configMaps.Get(ctx, g.name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			cm = &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   g.namespace,
+					Name:        g.name,
+					Annotations: map[string]string{SnapshotGenerationAnnotation: "1"},
+				},
+				Data: data,
+			}
+			if _, err := configMaps.Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+				return err
+			}
+			g.generation, g.published = 1, data
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		g.generation, _ = strconv.ParseInt(cm.Annotations[SnapshotGenerationAnnotation], 10, 64)
+		g.published = cm.Data
+		if g.published == nil {
+			g.published = make(map[string]string)
+		}
+	}
+
+	delta := make(map[string]any)
+	for node, counts := range data {
+		if published, ok := g.published[node]; !ok || published != counts {
+			delta[node] = counts
+		}
+	}
+	for node := range g.published {
+		if _, ok := data[node]; !ok {
+			delta[node] = nil
+		}
+	}
+	if len(delta) == 0 {
+		return nil
+	}
+
+	generation := g.generation + 1
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{SnapshotGenerationAnnotation: strconv.FormatInt(generation, 10)},
+		},
+		"data": delta,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := configMaps.Patch(ctx, g.name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		// The ConfigMap is read again, or created, on the next publication.
+		g.published = nil
+		return err
+	}
+	g.generation, g.published = generation, data
+	return nil
+}
+
+// applySnapshot replaces the cache with the counts published to the gossip ConfigMap, and restarts the
+// cache TTL, while the replica is on standby. The informer cache is kept current on standby replicas as
+// well, so it is never replaced.
+func (f *FlavourClusterWide) applySnapshot(cm *v1.ConfigMap) {
+	if f.gossip.active.Load() || f.informerCache {
+		return
+	}
+	snapshot := make(map[string]map[string]int, len(cm.Data))
+	for node, value := range cm.Data {
+		counts, err := decodeNodeCounts(value)
+		if err != nil {
+			f.logger.Error(err, "Error decoding node of ConfigMap", "node", node, "configMap", klog.KObj(cm))
+			return
+		}
+		snapshot[node] = counts
+	}
+
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+	f.cache = snapshot
+	f.balancedSlots = countBalancedSlots(f.cache)
+	f.lastUpdated = f.clock.Now()
+	f.logger.V(4).Info("Cache replaced with the published one", "configMap", klog.KObj(cm), "generation", cm.Annotations[SnapshotGenerationAnnotation])
+}
+
+// encodeNodeCounts encodes the counts of a node as comma-separated flavour=count pairs, sorted by flavour.
+func encodeNodeCounts(counts map[string]int) string {
+	pairs := make([]string, 0, len(counts))
+	for flavour, count := range counts {
+		pairs = append(pairs, flavour+"="+strconv.Itoa(count))
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// decodeNodeCounts decodes the counts of a node encoded by encodeNodeCounts.
+func decodeNodeCounts(value string) (map[string]int, error) {
+	counts := make(map[string]int)
+	if value == "" {
+		return counts, nil
+	}
+	for _, pair := range strings.Split(value, ",") {
+		flavour, count, found := strings.Cut(pair, "=")
+		n, err := strconv.Atoi(count)
+		if !found || err != nil {
+			return nil, fmt.Errorf("invalid flavour count %q", pair)
+		}
+		counts[flavour] = n
+	}
+	return counts, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPublishSnapshot(t *testing.T) {
+	ctx := context.Background()
+	f := newTestPlugin(nil, map[string]map[string]int{
+		"node1": {"gold": 2, "silver": 0},
+		"node2": {"gold": 0, "silver": 1},
+	})
+	f.logger = logr.Discard()
+	f.client = clientsetfake.NewSimpleClientset()
+	f.gossip = &snapshotGossip{namespace: "kube-system", name: "flavour-snapshot"}
+
+	expect := func(generation string, data map[string]string) {
+		t.Helper()
+		cm, err := f.client.CoreV1().ConfigMaps("kube-system").Get(ctx, "flavour-snapshot", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cm.Annotations[SnapshotGenerationAnnotation]; got != generation {
+			t.Errorf("expected generation %s, got %s", generation, got)
+		}
+		if diff := cmp.Diff(data, cm.Data); diff != "" {
+			t.Errorf("unexpected data (-want,+got):\n%s", diff)
+		}
+	}
+	publish := func() {
+		t.Helper()
+		if err := f.publishSnapshot(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// A standby replica publishes nothing.
+	publish()
+	if _, err := f.client.CoreV1().ConfigMaps("kube-system").Get(ctx, "flavour-snapshot", metav1.GetOptions{}); err == nil {
+		t.Fatalf("expected no ConfigMap from a standby replica")
+	}
+
+	f.gossip.markActive()
+	publish()
+	expect("1", map[string]string{"node1": "gold=2,silver=0", "node2": "gold=0,silver=1"})
+
+	f.cache = map[string]map[string]int{
+		"node1": {"gold": 3, "silver": 0},
+		"node3": {},
+	}
+	publish()
+	expect("2", map[string]string{"node1": "gold=3,silver=0", "node3": ""})
+
+	// An unchanged cache is not published again.
+	publish()
+	expect("2", map[string]string{"node1": "gold=3,silver=0", "node3": ""})
+}
+
+func TestApplySnapshot(t *testing.T) {
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "kube-system",
+			Name:        "flavour-snapshot",
+			Annotations: map[string]string{SnapshotGenerationAnnotation: "7"},
+		},
+		Data: map[string]string{"node1": "gold=3,silver=0", "node2": ""},
+	}
+	stale := map[string]map[string]int{"node1": {"gold": 1}}
+
+	f := newTestPlugin(nil, stale)
+	f.logger = logr.Discard()
+	f.gossip = &snapshotGossip{namespace: "kube-system", name: "flavour-snapshot"}
+	f.applySnapshot(cm)
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 3, "silver": 0},
+		"node2": {},
+	})
+
+	// The active replica keeps its own cache.
+	f = newTestPlugin(nil, stale)
+	f.gossip = &snapshotGossip{namespace: "kube-system", name: "flavour-snapshot"}
+	f.gossip.markActive()
+	f.applySnapshot(cm)
+	expectCache(t, f, stale)
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// placement is the node and flavour a pod is counted under.
+type placement struct {
+	node    string
+	flavour string
+	// index is the completion index of the pods of indexed Jobs, which count once per index.
+	index jobIndex
+	// weight is what the pod adds to the count of the placement, see podWeight.
+	weight int
+}
+
+// startInformerCache registers the event handlers keeping the cache current between the rebuilds from
+// the informers. Pods are counted once, by UID, whether their bind is first seen by PostBind or by the
+// informer. The events are applied once, by UID and resourceVersion, see acceptEvent. Node label and
+// condition changes are only taken into account on the next rebuild.
+func (f *FlavourClusterWide) startInformerCache(factory informers.SharedInformerFactory) error {
+	f.counted = make(map[types.UID]placement)
+	f.versions = make(map[types.UID]podVersion)
+	if _, err := factory.Core().V1().Pods().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if pod, ok := obj.(*v1.Pod); ok {
+				f.onPod(pod)
+			}
+		},
+		UpdateFunc: func(_, obj any) {
+			if pod, ok := obj.(*v1.Pod); ok {
+				f.onPod(pod)
+			}
+		},
+		DeleteFunc: f.onPodDeleteEvent,
+	}); err != nil {
+		return err
+	}
+	_, err := factory.Core().V1().Nodes().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if node, ok := obj.(*v1.Node); ok {
+				f.onNodeAdd(node)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if node, ok := obj.(*v1.Node); ok {
+				f.onNodeDelete(node)
+			}
+		},
+	})
+	return err
+}
+
+// startPodEndHandler registers the event handlers uncounting the pods that terminate, are being deleted
+// or are deleted between the polled rebuilds, so that the nodes they ran on do not look full until the
+// next rebuild. Only the pods counted by the last rebuild or by PostBind since are uncounted.
+func (f *FlavourClusterWide) startPodEndHandler(factory informers.SharedInformerFactory) error {
+	f.counted = make(map[types.UID]placement)
+	_, err := factory.Core().V1().Pods().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, obj any) {
+			if pod, ok := obj.(*v1.Pod); ok && !isActivePod(pod, f.excludedPodPhases) {
+				f.onPodDelete(pod)
+			}
+		},
+		DeleteFunc: f.onPodDeleteEvent,
+	})
+	return err
+}
+
+// countedPods returns the placements of the pods buildSnapshot counts.
+func (f *FlavourClusterWide) countedPods(pods []v1.Pod) map[types.UID]placement {
+	counted := make(map[types.UID]placement, len(pods))
+	for i := range pods {
+		node := pods[i].Spec.NodeName
+		flavour := pods[i].Labels[f.labelName()]
+		if node != "" && flavour != "" {
+			counted[pods[i].UID] = f.podPlacement(&pods[i], node, flavour)
+		}
+	}
+	return counted
+}
+
+// placementOf returns where the pod is to be counted, with the filters of the rebuilds, and false when
+// it is not counted.
+func (f *FlavourClusterWide) placementOf(pod *v1.Pod) (placement, bool) {
+	p := f.podPlacement(pod, pod.Spec.NodeName, f.flavourOf(pod))
+	if p.node == "" || p.flavour == "" || f.gatedNodes.Has(p.node) || !isActivePod(pod, f.excludedPodPhases) {
+		return placement{}, false
+	}
+	if f.schedulerName != "" && podSchedulerName(pod) != f.schedulerName {
+		return placement{}, false
+	}
+	return p, true
+}
+
+// onPod moves the count of the pod to its current placement.
+func (f *FlavourClusterWide) onPod(pod *v1.Pod) {
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+	// The first rebuild counts every pod of the informer. Pods are never unbound, so an update without
+	// a node is older than the bind PostBind may have counted.
+	if f.lastUpdated.IsZero() || pod.Spec.NodeName == "" || !f.acceptEvent(pod) {
+		return
+	}
+
+	current, counted := f.counted[pod.UID]
+	p, ok := f.placementOf(pod)
+	if counted && ok && current == p {
+		return
+	}
+	if counted {
+		f.uncount(pod.UID, current)
+	}
+	if ok {
+		f.count(pod.UID, p)
+	}
+}
+
+func (f *FlavourClusterWide) onPodDeleteEvent(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if pod, ok := obj.(*v1.Pod); ok {
+		f.forgetPod(pod)
+		f.onPodDelete(pod)
+	}
+}
+
+func (f *FlavourClusterWide) onPodDelete(pod *v1.Pod) {
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+	if current, counted := f.counted[pod.UID]; counted {
+		f.uncount(pod.UID, current)
+	}
+}
+
+// onNodeAdd adds an entry for a new node matching nodeLabelSelector and passing the readiness gate, so that it is known
+// before the next rebuild.
+func (f *FlavourClusterWide) onNodeAdd(node *v1.Node) {
+	if !f.selectsNode(node) || !f.passesReadinessGate(node) {
+		return
+	}
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+	if f.lastUpdated.IsZero() {
+		return
+	}
+	if _, exists := f.cache[node.Name]; exists {
+		return
+	}
+	counts := make(map[string]int)
+	for _, nodeCounts := range f.cache {
+		for flavour := range nodeCounts {
+			counts[flavour] = 0
+		}
+		break
+	}
+	f.cache[node.Name] = counts
+}
+
+func (f *FlavourClusterWide) onNodeDelete(node *v1.Node) {
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+	delete(f.cache, node.Name)
+}
+
+// count adds the pod to the counts of its placement, adding the flavour to every node if it is new so
+// that nodes without pods of the flavour report an explicit 0. The cache mutex must be held by the caller.
+func (f *FlavourClusterWide) count(uid types.UID, p placement) {
+	if _, exists := f.cache[p.node]; !exists {
+		f.cache[p.node] = make(map[string]int)
+	}
+	if _, exists := f.cache[p.node][p.flavour]; !exists {
+		for node := range f.cache {
+			if _, nodeHasFlavour := f.cache[node][p.flavour]; !nodeHasFlavour {
+				f.cache[node][p.flavour] = 0
+			}
+		}
+	}
+	f.cache[p.node][p.flavour] += p.weight
+	if f.counted != nil {
+		f.counted[uid] = p
+		f.replaceAttempt(uid, p)
+	}
+}
+
+// replaceAttempt uncounts the previous attempt of the completion index of the pod, which the pod
+// retries, and records the pod as the one counted for the index. The cache mutex must be held by the
+// caller.
+func (f *FlavourClusterWide) replaceAttempt(uid types.UID, p placement) {
+	if p.index == (jobIndex{}) {
+		return
+	}
+	if previous, ok := f.indices[p.index]; ok && previous != uid {
+		if current, counted := f.counted[previous]; counted {
+			f.uncount(previous, current)
+		}
+	}
+	if f.indices == nil {
+		f.indices = make(map[jobIndex]types.UID)
+	}
+	f.indices[p.index] = uid
+}
+
+// uncount removes the pod from the counts of its placement, and forgets its reservation if any. The
+// cache mutex must be held by the caller.
+func (f *FlavourClusterWide) uncount(uid types.UID, p placement) {
+	if count, exists := f.cache[p.node][p.flavour]; exists {
+		f.cache[p.node][p.flavour] = max(count-p.weight, 0)
+	}
+	delete(f.counted, uid)
+	delete(f.reserved, uid)
+	if p.index != (jobIndex{}) && f.indices[p.index] == uid {
+		delete(f.indices, p.index)
+	}
+}
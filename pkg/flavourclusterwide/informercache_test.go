@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+)
+
+func TestInformerCache(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	polled := clientsetfake.NewSimpleClientset()
+	informed := clientsetfake.NewSimpleClientset(
+		makeWorker("node1"), makeWorker("node2"),
+		uidPod("p1", "node1", "gold"),
+	)
+	informerFactory := informers.NewSharedInformerFactory(informed, 0)
+	f, err := NewWithOptions(ctx, &cfgv1.FlavourClusterWideArgs{InformerCache: ptr.To(true)}, nil,
+		WithClient(polled),
+		WithInformerFactory(informerFactory),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+
+	f.updateCacheIfNeeded(ctx)
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 0},
+	})
+
+	// The bind is counted once, whether PostBind or the informer sees it first.
+	bound := uidPod("p2", "node2", "gold")
+	f.PostBind(ctx, nil, bound, "node2")
+	if _, err := informed.CoreV1().Pods("default").Create(ctx, bound, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := informed.CoreV1().Pods("default").Create(ctx, uidPod("p3", "node1", "silver"), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := informed.CoreV1().Pods("default").Delete(ctx, "p1", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := informed.CoreV1().Nodes().Create(ctx, makeWorker("node3"), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 0, "silver": 1},
+		"node2": {"gold": 1, "silver": 0},
+		"node3": {"gold": 0, "silver": 0},
+	})
+
+	if actions := polled.Actions(); len(actions) != 0 {
+		t.Errorf("expected no API server calls, got %v", actions)
+	}
+}
+
+func TestPolledCachePodEnds(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := clientsetfake.NewSimpleClientset(
+		makeWorker("node1"), makeWorker("node2"),
+		uidPod("p1", "node1", "gold"), uidPod("p2", "node1", "gold"),
+	)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	f, err := NewWithOptions(ctx, &cfgv1.FlavourClusterWideArgs{}, nil,
+		WithClient(client),
+		WithInformerFactory(informerFactory),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+
+	f.updateCacheIfNeeded(ctx)
+	bound := uidPod("p3", "node2", "gold")
+	f.PostBind(ctx, nil, bound, "node2")
+	if _, err := client.CoreV1().Pods("default").Create(ctx, bound, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 2},
+		"node2": {"gold": 1},
+	})
+
+	// The pods counted by the rebuild and by PostBind are uncounted when deleted, before the next rebuild.
+	for _, name := range []string{"p1", "p3"} {
+		if err := client.CoreV1().Pods("default").Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	waitForCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 0},
+	})
+
+	// So are the counted pods that complete.
+	completed := uidPod("p2", "node1", "gold")
+	completed.Status.Phase = v1.PodSucceeded
+	if _, err := client.CoreV1().Pods("default").UpdateStatus(ctx, completed, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 0},
+	})
+}
+
+// uidPod returns a flavoured pod with a UID, as the informer cache counts the pods by UID.
+func uidPod(name, nodeName, flavour string) *v1.Pod {
+	pod := makePod("default", name, nodeName, flavoured(flavour))
+	pod.UID = types.UID(name)
+	return pod
+}
+
+func expectCache(t *testing.T, f *FlavourClusterWide, want map[string]map[string]int) {
+	t.Helper()
+	f.cacheMutex.RLock()
+	defer f.cacheMutex.RUnlock()
+	if diff := cmp.Diff(want, f.cache); diff != "" {
+		t.Errorf("unexpected cache (-want,+got):\n%s", diff)
+	}
+}
+
+// waitForCache waits for the informer events to bring the cache to want.
+func waitForCache(t *testing.T, f *FlavourClusterWide, want map[string]map[string]int) {
+	t.Helper()
+	var got map[string]map[string]int
+	err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		f.cacheMutex.RLock()
+		defer f.cacheMutex.RUnlock()
+		got = make(map[string]map[string]int, len(f.cache))
+		for node, counts := range f.cache {
+			got[node] = make(map[string]int, len(counts))
+			for flavour, count := range counts {
+				got[node][flavour] = count
+			}
+		}
+		return cmp.Equal(want, got), nil
+	})
+	if err != nil {
+		t.Errorf("unexpected cache (-want,+got):\n%s", cmp.Diff(want, got))
+	}
+}
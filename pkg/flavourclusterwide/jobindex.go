@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+// jobIndex identifies a completion index of an indexed Job. The pods retrying an index share it.
+type jobIndex struct {
+	job   types.UID
+	index string
+}
+
+// completionIndex returns the completion index of the pod, and false when the pod does not belong to
+// an indexed Job. The index is read from the batch.kubernetes.io/job-completion-index label, and the
+// Job from the controller reference of the pod.
+func completionIndex(pod *v1.Pod) (jobIndex, bool) {
+	index, ok := pod.Labels[batchv1.JobCompletionIndexAnnotation]
+	if !ok {
+		return jobIndex{}, false
+	}
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "Job" {
+		return jobIndex{}, false
+	}
+	return jobIndex{job: owner.UID, index: index}, true
+}
+
+// podPlacement returns the placement of the pod on the node, with its completion index if any and its
+// weight.
+func (f *FlavourClusterWide) podPlacement(pod *v1.Pod, node, flavour string) placement {
+	p := placement{node: node, flavour: flavour, weight: f.podWeight(pod)}
+	p.index, _ = completionIndex(pod)
+	return p
+}
+
+// latestAttempts drops the pods superseded by a more recent attempt of the same completion index, so
+// that the retries of an index count once. The other pods are kept as they are.
+func latestAttempts(pods []v1.Pod) []v1.Pod {
+	latest := make(map[jobIndex]int)
+	for i := range pods {
+		index, ok := completionIndex(&pods[i])
+		if !ok {
+			continue
+		}
+		if j, seen := latest[index]; !seen || isMoreRecent(&pods[i], &pods[j]) {
+			latest[index] = i
+		}
+	}
+	if len(latest) == 0 {
+		return pods
+	}
+	return slices.DeleteFunc(slices.Clone(pods), func(pod v1.Pod) bool {
+		index, ok := completionIndex(&pod)
+		return ok && pods[latest[index]].UID != pod.UID
+	})
+}
+
+// isMoreRecent returns true when pod was created after other, breaking ties by name.
+func isMoreRecent(pod, other *v1.Pod) bool {
+	if !pod.CreationTimestamp.Equal(&other.CreationTimestamp) {
+		return other.CreationTimestamp.Before(&pod.CreationTimestamp)
+	}
+	return pod.Name > other.Name
+}
+
+// indexOwners returns the pod counted for each completion index of the counted pods.
+func indexOwners(counted map[types.UID]placement) map[jobIndex]types.UID {
+	owners := make(map[jobIndex]types.UID)
+	for uid, p := range counted {
+		if p.index != (jobIndex{}) {
+			owners[p.index] = uid
+		}
+	}
+	return owners
+}
+
+// spreadIndex lowers by one the nodes sharing the best score with others, except the node picked by
+// the completion index of the pod among them in name order. The scheduler picks one of the best nodes
+// at random, so consecutive indices of a Job would otherwise land on the same nodes by chance; they are
+// spread round-robin across the best nodes instead, and an index lands on the same node when the
+// cluster is unchanged.
+func spreadIndex(pod *v1.Pod, scores framework.NodeScoreList) {
+	if len(scores) < 2 {
+		return
+	}
+	index, err := strconv.Atoi(pod.Labels[batchv1.JobCompletionIndexAnnotation])
+	if _, ok := completionIndex(pod); !ok || err != nil || index < 0 {
+		return
+	}
+	var best []int
+	for i := range scores {
+		switch {
+		case len(best) == 0 || scores[i].Score > scores[best[0]].Score:
+			best = []int{i}
+		case scores[i].Score == scores[best[0]].Score:
+			best = append(best, i)
+		}
+	}
+	if len(best) < 2 || scores[best[0]].Score == 0 {
+		return
+	}
+	slices.SortFunc(best, func(a, b int) int {
+		return strings.Compare(scores[a].Name, scores[b].Name)
+	})
+	picked := best[index%len(best)]
+	for _, i := range best {
+		if i != picked {
+			scores[i].Score--
+		}
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/utils/ptr"
+)
+
+// indexedPod returns a gold pod of the completion index of the indexed Job, created at the given minute.
+func indexedPod(name, nodeName, index string, minute int) *v1.Pod {
+	pod := uidPod(name, nodeName, "gold")
+	pod.Labels[batchv1.JobCompletionIndexAnnotation] = index
+	pod.OwnerReferences = []metav1.OwnerReference{{Kind: "Job", Name: "job", UID: "job", Controller: ptr.To(true)}}
+	pod.CreationTimestamp = metav1.NewTime(time.Date(2026, 3, 1, 12, minute, 0, 0, time.UTC))
+	return pod
+}
+
+func TestActivePodsCompletionIndex(t *testing.T) {
+	failed := indexedPod("job-0-a", "node1", "0", 0)
+	failed.Status.Phase = v1.PodFailed
+	pods := []v1.Pod{
+		*failed,
+		*indexedPod("job-0-b", "node2", "0", 1),
+		*indexedPod("job-1-a", "node1", "1", 0),
+		*uidPod("plain", "node1", "gold"),
+	}
+	nodes := []v1.Node{*makeWorker("node1"), *makeWorker("node2")}
+
+	// Even when failed pods are counted, only the latest attempt of an index is.
+	got := BuildSnapshot(nodes, activePods(pods, nil), "flavour")
+	want := map[string]map[string]int{
+		"node1": {"gold": 2},
+		"node2": {"gold": 1},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected snapshot (-want,+got):\n%s", diff)
+	}
+}
+
+func TestReplaceAttempt(t *testing.T) {
+	ctx := context.Background()
+	f := newTestPlugin(nil, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 0},
+	})
+	f.logger = logr.Discard()
+	f.counted = make(map[types.UID]placement)
+
+	first := indexedPod("job-0-a", "node1", "0", 0)
+	f.PostBind(ctx, nil, first, "node1")
+	// The retry of the index replaces the first attempt, which still counts until it is deleted.
+	retry := indexedPod("job-0-b", "node2", "0", 1)
+	f.PostBind(ctx, nil, retry, "node2")
+	f.PostBind(ctx, nil, indexedPod("job-1-a", "node2", "1", 0), "node2")
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 2},
+	})
+
+	// Deleting the first attempt does not uncount its retry.
+	f.onPodDelete(first)
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 2},
+	})
+	f.onPodDelete(retry)
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 1},
+	})
+}
+
+func TestSpreadIndex(t *testing.T) {
+	scores := func() framework.NodeScoreList {
+		return framework.NodeScoreList{
+			{Name: "node3", Score: 100},
+			{Name: "node1", Score: 100},
+			{Name: "node4", Score: 50},
+			{Name: "node2", Score: 100},
+		}
+	}
+	tests := []struct {
+		name string
+		pod  *v1.Pod
+		want framework.NodeScoreList
+	}{
+		{
+			name: "pod without completion index",
+			pod:  uidPod("p", "", "gold"),
+			want: scores(),
+		},
+		{
+			name: "index 0",
+			pod:  indexedPod("job-0", "", "0", 0),
+			want: framework.NodeScoreList{
+				{Name: "node3", Score: 99},
+				{Name: "node1", Score: 100},
+				{Name: "node4", Score: 50},
+				{Name: "node2", Score: 99},
+			},
+		},
+		{
+			name: "index 4",
+			pod:  indexedPod("job-4", "", "4", 0),
+			want: framework.NodeScoreList{
+				{Name: "node3", Score: 99},
+				{Name: "node1", Score: 99},
+				{Name: "node4", Score: 50},
+				{Name: "node2", Score: 100},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scores()
+			spreadIndex(tt.pod, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
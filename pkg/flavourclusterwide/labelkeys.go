@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// labelKeyDistribution is the distribution of the pods sharing the value of a further label key of the
+// pod being scheduled, see LabelKeys.
+type labelKeyDistribution struct {
+	key    string
+	value  string
+	weight int64
+	// counts are the pods sharing the value per node in scope, known or not, and known the counts of the
+	// known nodes the node is scored against.
+	counts map[string]int
+	known  []int
+}
+
+// splitLabelKeys returns the weight of the flavour label and the other label keys. The flavour weighs 1
+// unless the label keys list it.
+func splitLabelKeys(labelName string, keys []pluginConfig.FlavourLabelKey) (int64, []pluginConfig.FlavourLabelKey) {
+	weight := int64(1)
+	var others []pluginConfig.FlavourLabelKey
+	for _, key := range keys {
+		if key.LabelKey == labelName {
+			weight = int64(key.Weight)
+			continue
+		}
+		others = append(others, key)
+	}
+	return weight, others
+}
+
+// takeLabelKeys returns the distribution of every further label key of the pod among the nodes in
+// scope. The keys the pod does not have are left out. The pods are counted from the scheduler's node
+// snapshot, as the cache only counts the flavours, and without age weighting.
+func (f *FlavourClusterWide) takeLabelKeys(pod *v1.Pod, inScope func(string) bool, known sets.Set[string]) []labelKeyDistribution {
+	var keys []labelKeyDistribution
+	for _, key := range f.label.Load().keys {
+		if value, ok := pod.Labels[key.LabelKey]; ok {
+			keys = append(keys, labelKeyDistribution{key: key.LabelKey, value: value, weight: int64(key.Weight), counts: make(map[string]int)})
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	nodeInfos, err := f.handle.SnapshotSharedLister().NodeInfos().List()
+	if err != nil {
+		f.logger.Error(err, "Error listing nodes from snapshot")
+		return nil
+	}
+
+	for _, nodeInfo := range nodeInfos {
+		node := nodeInfo.Node().Name
+		if !inScope(node) {
+			continue
+		}
+		for i := range keys {
+			keys[i].counts[node] = 0
+		}
+		for _, podInfo := range nodeInfo.GetPods() {
+			p := podInfo.GetPod()
+			if !isActivePod(p, f.excludedPodPhases) || !f.namespaces.accounts(p.Namespace) {
+				continue
+			}
+			for i := range keys {
+				if value, ok := p.Labels[keys[i].key]; ok && value == keys[i].value {
+					keys[i].counts[node]++
+				}
+			}
+		}
+		if known.Has(node) {
+			for i := range keys {
+				keys[i].known = append(keys[i].known, keys[i].counts[node])
+			}
+		}
+	}
+	return keys
+}
+
+// combineLabelKeys returns the average of the balance score of the flavour and of the spread scores of
+// the node for the further label keys, weighted by their weights. A node missing from the cache is scored
+// against the known nodes and itself when unknown is set.
+func (f *FlavourClusterWide) combineLabelKeys(strategy pluginConfig.FlavourScoringStrategy, score int64, keys []labelKeyDistribution, node string, unknown bool) int64 {
+	if len(keys) == 0 {
+		return score
+	}
+	weight := f.label.Load().weight
+	sum, total := weight*score, weight
+	for _, key := range keys {
+		counts := key.known
+		if unknown {
+			counts = append(slices.Clone(counts), key.counts[node])
+		}
+		sum += key.weight * balanceScore(strategy, counts, key.counts[node], 1, 1)
+		total += key.weight
+	}
+	return sum / total
+}
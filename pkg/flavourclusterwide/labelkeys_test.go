@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	testutil "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+func TestScoreLabelKeys(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	tiered := func(name, node, tier string) *v1.Pod {
+		return makePod("default", name, node, map[string]string{"tier": tier})
+	}
+	// node1 is the least loaded node for gold, and node3 for the frontend tier.
+	pods := []*v1.Pod{
+		tiered("f1", "node1", "frontend"),
+		tiered("f2", "node1", "frontend"),
+		tiered("f3", "node2", "frontend"),
+		tiered("b1", "node3", "backend"),
+	}
+	cache := map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 2},
+		"node3": {"gold": 2},
+	}
+	tests := []struct {
+		name      string
+		labelKeys []pluginConfig.FlavourLabelKey
+		labels    map[string]string
+		want      map[string]int64
+	}{
+		{
+			name:   "flavour alone",
+			labels: map[string]string{"flavour": "gold", "tier": "frontend"},
+			want:   map[string]int64{"node1": 100, "node2": 0, "node3": 0},
+		},
+		{
+			name:      "flavour and tier",
+			labelKeys: []pluginConfig.FlavourLabelKey{{LabelKey: "tier", Weight: 1}},
+			labels:    map[string]string{"flavour": "gold", "tier": "frontend"},
+			want:      map[string]int64{"node1": 50, "node2": 0, "node3": 50},
+		},
+		{
+			name:      "tier weighing more than the flavour",
+			labelKeys: []pluginConfig.FlavourLabelKey{{LabelKey: "flavour", Weight: 1}, {LabelKey: "tier", Weight: 3}},
+			labels:    map[string]string{"flavour": "gold", "tier": "frontend"},
+			want:      map[string]int64{"node1": 25, "node2": 0, "node3": 75},
+		},
+		{
+			name:      "pod without the tier",
+			labelKeys: []pluginConfig.FlavourLabelKey{{LabelKey: "tier", Weight: 1}},
+			labels:    map[string]string{"flavour": "gold"},
+			want:      map[string]int64{"node1": 100, "node2": 0, "node3": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.handle = &fakeHandle{lister: testutil.NewFakeSharedLister(pods, nodes)}
+			f.label.Store(newFlavourLabel(f.labelName(), tt.labelKeys))
+			got := scoreNodes(t, f, makePod("default", "p", "", tt.labels))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+// lifecycleRank returns the position of lifecycle in the preference chain, or len(chain)
+// when the lifecycle is not part of it.
+func lifecycleRank(chain []string, lifecycle string) int {
+	for i, preferred := range chain {
+		if preferred == lifecycle {
+			return i
+		}
+	}
+	return len(chain)
+}
+
+// lifecycleScore maps a balance score into the score band of the given lifecycle rank.
+// The node score range is split into len(chain)+1 bands, the most preferred lifecycle
+// getting the highest one, so that the lifecycle order always wins over balance and the
+// balance score only breaks ties between nodes of the same lifecycle. Because scoring only
+// sees feasible nodes, the best available band implements the fallback chain.
+func lifecycleScore(rank, chainLength int, balanceScore int64) int64 {
+	band := framework.MaxNodeScore / int64(chainLength+1)
+	// Keep the balance part strictly below the band width so that bands never overlap.
+	return int64(chainLength-rank)*band + balanceScore*(band-1)/framework.MaxNodeScore
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	fwk "k8s.io/kube-scheduler/framework"
+)
+
+// preScoreStateKey is the key in CycleState to the batch planned by PreScore, see stateKey.
+const preScoreStateKey = "PreScore"
+
+// preScoreState holds the number of same-flavour pods planned together with the pod being scheduled,
+// the pod itself included.
+type preScoreState struct {
+	batch int
+}
+
+// Clone the preScore state. It is never modified after PreScore, so the state itself is returned.
+func (s *preScoreState) Clone() fwk.StateData {
+	return s
+}
+
+// PreScore counts the pending pods sharing the flavour of the pod being scheduled, up to the
+// configured batch lookahead, and the forecast arrivals of the flavour, so that Score can plan them
+// together with the pod. It also starts the
+// overhead accounting and the strategy comparison of the cycle, restricts the nodes in scope to the
+// feasible nodes and to the allowed topologies of the pod's pending volumes, and takes the
+// distribution of the flavour that every node is scored against. With snapshot gossip, it marks the
+// replica as the active one, see publishSnapshot.
+func (f *FlavourClusterWide) PreScore(ctx context.Context, state fwk.CycleState, pod *v1.Pod, nodes []fwk.NodeInfo) *fwk.Status {
+	f.gossip.markActive()
+	f.startOverhead(state)
+	f.startComparison(state)
+	defer f.trackOverhead(state, f.clock.Now())
+
+	flavour := f.flavourOf(pod)
+	if flavour != "" {
+		f.startFeasibleNodes(state, nodes)
+		f.startVolumeTopology(state, pod)
+		f.startDistribution(state, pod)
+		f.startNeutralNodes(state)
+	}
+	if flavour == "" || (f.batchLookahead == 0 || f.podLister == nil) && f.forecaster == nil {
+		return nil
+	}
+
+	batch := 1
+	if f.batchLookahead > 0 && f.podLister != nil {
+		pods, err := f.podLister.List(labels.SelectorFromSet(labels.Set{f.labelName(): flavour}))
+		if err != nil {
+			return fwk.AsStatus(err)
+		}
+		for _, p := range pods {
+			if batch > int(f.batchLookahead) {
+				break
+			}
+			if p.UID == pod.UID || !isPending(p, pod.Spec.SchedulerName) || !f.namespaces.accounts(p.Namespace) {
+				continue
+			}
+			batch++
+		}
+	}
+	if f.forecaster != nil {
+		batch += max(0, f.forecaster.Forecast(flavour, f.clock.Now(), f.forecastHorizon))
+	}
+	state.Write(f.stateKey(preScoreStateKey), &preScoreState{batch: batch})
+	return nil
+}
+
+// isPending returns true if the pod waits to be scheduled by the given scheduler.
+func isPending(pod *v1.Pod, schedulerName string) bool {
+	return pod.Spec.NodeName == "" &&
+		pod.DeletionTimestamp == nil &&
+		pod.Spec.SchedulerName == schedulerName &&
+		pod.Status.Phase != v1.PodSucceeded &&
+		pod.Status.Phase != v1.PodFailed
+}
+
+// batchSize returns the batch planned by PreScore, or 1 when there is none.
+func (f *FlavourClusterWide) batchSize(state fwk.CycleState) int {
+	if state == nil {
+		return 1
+	}
+	c, err := state.Read(f.stateKey(preScoreStateKey))
+	if err != nil {
+		return 1
+	}
+	s, ok := c.(*preScoreState)
+	if !ok {
+		return 1
+	}
+	return s.batch
+}
+
+// waterLevel returns the lowest count that receives no pod when a batch of pods, each adding step to
+// the count of its node, is placed round-robin on the least loaded node each time. Nodes below the
+// level receive pods from the batch, one more for every step below it. A batch of 1 yields the
+// current minimum plus one.
+func waterLevel(counts []int, batch, step int) int {
+	if len(counts) == 0 {
+		return 0
+	}
+	lowest := counts[0]
+	for _, count := range counts {
+		lowest = min(lowest, count)
+	}
+
+	// Binary search the highest count receiving a pod: the minimum receives the whole batch at worst.
+	lo, hi := lowest, lowest+(batch-1)*step
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if placedBelow(counts, mid, step) >= batch {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo + 1
+}
+
+// placedBelow returns the number of pods needed for every node to exceed the given count.
+func placedBelow(counts []int, count, step int) int {
+	pods := 0
+	for _, c := range counts {
+		if c <= count {
+			pods += (count-c)/step + 1
+		}
+	}
+	return pods
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestWaterLevel(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts []int
+		batch  int
+		want   int
+	}{
+		{name: "no nodes", batch: 3, want: 0},
+		{name: "single pod", counts: []int{2, 2, 5}, batch: 1, want: 3},
+		{name: "fills the lowest node first", counts: []int{0, 4, 4}, batch: 3, want: 3},
+		{name: "exactly reaches the next node", counts: []int{0, 2}, batch: 2, want: 2},
+		{name: "spreads once levelled", counts: []int{0, 2, 2}, batch: 5, want: 3},
+		{name: "large batch on even nodes", counts: []int{1, 1, 1, 1}, batch: 50, want: 14},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := waterLevel(tt.counts, tt.batch, 1); got != tt.want {
+				t.Errorf("expected level %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScoreBatchLookahead(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	cache := map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 2},
+		"node3": {"gold": 6},
+	}
+	pending := func(name, flavour string) *v1.Pod {
+		pod := makePod("default", name, "", flavoured(flavour))
+		pod.UID = types.UID(name)
+		return pod
+	}
+
+	tests := []struct {
+		name      string
+		lookahead int32
+		pending   int
+		want      map[string]int64
+	}{
+		{
+			name:    "disabled",
+			pending: 5,
+			want:    map[string]int64{"node1": 100, "node2": 0, "node3": 0},
+		},
+		{
+			name:      "no other pending pod",
+			lookahead: 10,
+			want:      map[string]int64{"node1": 100, "node2": 0, "node3": 0},
+		},
+		{
+			// 6 pods level node1 and node2 at 4.
+			name:      "batch spread over the two least loaded nodes",
+			lookahead: 10,
+			pending:   5,
+			want:      map[string]int64{"node1": 100, "node2": 50, "node3": 0},
+		},
+		{
+			// Only 2 pending pods are looked at, 3 pods level node1 and node2 at 3.
+			name:      "batch bounded by the lookahead",
+			lookahead: 2,
+			pending:   5,
+			want:      map[string]int64{"node1": 100, "node2": 33, "node3": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := clientsetfake.NewSimpleClientset()
+			for i := 0; i < tt.pending; i++ {
+				if _, err := client.CoreV1().Pods("default").Create(context.Background(), pending(fmt.Sprintf("p%d", i), "gold"), metav1.CreateOptions{}); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			// A pending pod of another flavour, one of another scheduler and a bound one must not count.
+			client.CoreV1().Pods("default").Create(context.Background(), pending("other", "silver"), metav1.CreateOptions{})
+			elsewhere := pending("elsewhere", "gold")
+			elsewhere.Spec.SchedulerName = "other-scheduler"
+			client.CoreV1().Pods("default").Create(context.Background(), elsewhere, metav1.CreateOptions{})
+			client.CoreV1().Pods("default").Create(context.Background(), makePod("default", "bound", "node2", flavoured("gold")), metav1.CreateOptions{})
+
+			informerFactory := informers.NewSharedInformerFactory(client, 0)
+			f := newTestPlugin(nodes, cache)
+			f.batchLookahead = tt.lookahead
+			f.podLister = informerFactory.Core().V1().Pods().Lister()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			informerFactory.Start(ctx.Done())
+			informerFactory.WaitForCacheSync(ctx.Done())
+
+			pod := pending("current", "gold")
+			state := framework.NewCycleState()
+			if status := f.PreScore(ctx, state, pod, nil); !status.IsSuccess() {
+				t.Fatalf("unexpected status: %v", status)
+			}
+			got := make(map[string]int64)
+			nodeInfos, _ := f.handle.SnapshotSharedLister().NodeInfos().List()
+			for _, nodeInfo := range nodeInfos {
+				score, status := f.Score(ctx, state, pod, nodeInfo)
+				if !status.IsSuccess() {
+					t.Fatalf("unexpected status: %v", status)
+				}
+				got[nodeInfo.Node().Name] = score
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// otherLabelValue is the value of the flavour and node labels of the metrics under which the flavours
+// and nodes beyond the cardinality limits are aggregated.
+const otherLabelValue = "other"
+
+// metricLabels bounds the values of the flavour and node labels of the metrics of the plugin, so that
+// dynamic flavours or a large cluster cannot explode the number of series. Every per-flavour or per-node
+// metric takes its label values from it.
+type metricLabels struct {
+	// limit is the number of nodes, and of flavours without an allowlist, reported under their own name.
+	limit int
+	// allowed are the flavours reported under their own name, nil to report the first limit flavours.
+	allowed sets.Set[string]
+
+	mutex sync.Mutex
+	// seen are the flavours reported under their own name so far without an allowlist. A flavour keeps
+	// its series once it has one, so that its values do not jump between series.
+	seen sets.Set[string]
+}
+
+func newMetricLabels(limit int32, allowed []string) *metricLabels {
+	l := &metricLabels{limit: int(limit), seen: sets.New[string]()}
+	if len(allowed) > 0 {
+		l.allowed = sets.New(allowed...)
+	}
+	return l
+}
+
+// flavour returns the value of the flavour label for the flavour: the flavour itself when it is
+// allowlisted or, without an allowlist, when it is one of the first limit flavours seen, and
+// otherLabelValue otherwise.
+func (l *metricLabels) flavour(flavour string) string {
+	if l.allowed != nil {
+		if l.allowed.Has(flavour) {
+			return flavour
+		}
+		return otherLabelValue
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.seen.Has(flavour) {
+		return flavour
+	}
+	if l.seen.Len() < l.limit {
+		l.seen.Insert(flavour)
+		return flavour
+	}
+	return otherLabelValue
+}
+
+// nodes returns the value of the node label for every node of totals: the node itself for the limit
+// nodes with the highest totals, ties broken by name, and otherLabelValue for the others.
+func (l *metricLabels) nodes(totals map[string]int) map[string]string {
+	names := make([]string, 0, len(totals))
+	for name := range totals {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if totals[names[i]] != totals[names[j]] {
+			return totals[names[i]] > totals[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	values := make(map[string]string, len(names))
+	for i, name := range names {
+		if i < l.limit {
+			values[name] = name
+		} else {
+			values[name] = otherLabelValue
+		}
+	}
+	return values
+}
+
+// FlavourMetricLabel returns the function bounding the values of the flavour label of metrics reported
+// outside of the plugin, such as by the controllers, the way metricsLabelCap and metricsFlavours bound
+// those of the plugin: a flavour beyond them is reported as "other".
+func FlavourMetricLabel(limit int32, allowed []string) func(flavour string) string {
+	return newMetricLabels(limit, allowed).flavour
+}
+
+// reset forgets the flavours seen so far, such as the flavours of a previous flavour label, so that the
+// next flavours take their series.
+func (l *metricLabels) reset() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.seen = sets.New[string]()
+}
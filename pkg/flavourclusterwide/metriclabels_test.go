@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMetricLabels(t *testing.T) {
+	tests := []struct {
+		name         string
+		limit        int32
+		allowed      []string
+		flavours     []string
+		wantFlavours []string
+		wantNodes    map[string]string
+	}{
+		{
+			name:         "first flavours seen",
+			limit:        2,
+			flavours:     []string{"gold", "silver", "gold", "bronze", "silver"},
+			wantFlavours: []string{"gold", "silver", "gold", "other", "silver"},
+			wantNodes:    map[string]string{"node1": "node1", "node2": "node2", "node3": "other", "node4": "other"},
+		},
+		{
+			name:         "allowlisted flavours",
+			limit:        2,
+			allowed:      []string{"bronze"},
+			flavours:     []string{"gold", "silver", "bronze"},
+			wantFlavours: []string{"other", "other", "bronze"},
+			wantNodes:    map[string]string{"node1": "node1", "node2": "node2", "node3": "other", "node4": "other"},
+		},
+		{
+			name:         "zero limit",
+			limit:        0,
+			flavours:     []string{"gold"},
+			wantFlavours: []string{"other"},
+			wantNodes:    map[string]string{"node1": "other", "node2": "other", "node3": "other", "node4": "other"},
+		},
+	}
+	// node2 and node3 tie, and node2 is kept for its name.
+	totals := map[string]int{"node1": 5, "node2": 3, "node3": 3, "node4": 0}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newMetricLabels(tt.limit, tt.allowed)
+			var got []string
+			for _, flavour := range tt.flavours {
+				got = append(got, l.flavour(flavour))
+			}
+			if diff := cmp.Diff(tt.wantFlavours, got); diff != "" {
+				t.Errorf("unexpected flavour labels (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantNodes, l.nodes(totals)); diff != "" {
+				t.Errorf("unexpected node labels (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
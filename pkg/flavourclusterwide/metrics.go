@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+// metricsSubsystem prefixes the metrics of the plugin, exported with the scheduler's own metrics.
+const metricsSubsystem = "flavourclusterwide"
+
+var (
+	strategyComparisons = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "strategy_comparisons_total",
+			Help:           "Number of scheduling cycles in which the scoring strategy was compared with the comparison strategy.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin", "strategy", "comparison"})
+
+	strategyDivergences = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "strategy_divergences_total",
+			Help:           "Number of scheduling cycles in which no node was among the best nodes of both the scoring and the comparison strategies.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin", "strategy", "comparison"})
+
+	hypotheticalSkew = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "hypothetical_skew",
+			Help:           "Difference between the most and least loaded scored nodes of the flavour if the pod were placed on the node picked by the strategy.",
+			Buckets:        metrics.LinearBuckets(0, 1, 11),
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin", "strategy"})
+
+	cacheVerifications = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "cache_verifications_total",
+			Help:           "Number of cache rebuilds verified against the snapshot of the scheduler's informers.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin"})
+
+	cacheDiscrepancies = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "cache_discrepancies_total",
+			Help:           "Number of node and flavour counts that differed between the polled cache and the snapshot of the scheduler's informers.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin"})
+
+	unknownNodeScores = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "unknown_node_scores_total",
+			Help:           "Number of nodes scored while missing from the cache, by the configured unknown node scoring.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin", "scoring"})
+
+	postBindQueueOverflows = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "postbind_queue_overflows_total",
+			Help:           "Number of cache updates of bound pods that found the PostBind queue full, by the configured overflow policy.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin", "policy"})
+
+	postBindQueueLength = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "postbind_queue_length",
+			Help:           "Number of cache updates of bound pods waiting in the PostBind queue.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin"})
+
+	placementQualities = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "placement_quality",
+			Help:           "Normalized score of the node flavoured pods were bound to, 100 for the best node of the scheduling cycle.",
+			Buckets:        metrics.LinearBuckets(0, 10, 11),
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin"})
+
+	cacheRefreshDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "cache_refresh_duration_seconds",
+			Help:           "Duration of the cache refreshes, from listing the nodes and pods to rebuilding the cache.",
+			Buckets:        metrics.ExponentialBuckets(0.001, 2, 15),
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin"})
+
+	listErrors = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "list_errors_total",
+			Help:           "Number of cache refreshes that failed to list the nodes or pods, from the API server or the informers.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin"})
+
+	scoreDecisions = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "score_decisions_total",
+			Help:           "Number of normalized node scores, by whether the node got the maximum score 100, the minimum score 0, or a score in between.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin", "score"})
+
+	auditRecordsDropped = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "audit_records_dropped_total",
+			Help:           "Number of audit records that were not stored, by whether the queue was full or the store failed.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin", "reason"})
+
+	remoteCacheReads = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      metricsSubsystem,
+			Name:           "remote_cache_reads_total",
+			Help:           "Number of listings read from the remote cache, by whether the listing was found, missing, or the remote cache failed.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin", "result"})
+
+	metricsList = []metrics.Registerable{
+		strategyComparisons,
+		strategyDivergences,
+		hypotheticalSkew,
+		cacheVerifications,
+		cacheDiscrepancies,
+		unknownNodeScores,
+		postBindQueueOverflows,
+		postBindQueueLength,
+		placementQualities,
+		cacheRefreshDuration,
+		listErrors,
+		scoreDecisions,
+		auditRecordsDropped,
+		remoteCacheReads,
+	}
+)
+
+var registerMetrics sync.Once
+
+// RegisterMetrics registers the metrics of the plugin with the legacy registry served by the scheduler.
+// It is safe to call it several times.
+func RegisterMetrics() {
+	registerMetrics.Do(func() {
+		for _, metric := range metricsList {
+			legacyregistry.MustRegister(metric)
+		}
+		legacyregistry.CustomMustRegister(cacheMetrics)
+	})
+}
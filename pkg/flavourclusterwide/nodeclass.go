@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// NodeClassAnnotation is set on bound flavoured pods to the capacity class of their node when
+// AnnotateNodeClass is enabled.
+const NodeClassAnnotation = "scheduling.x-k8s.io/node-class"
+
+// FieldManager is the field manager of the server-side applies of the plugin, so that the fields it
+// owns, and conflicts with other controllers over them, show in the managed fields of the objects.
+const FieldManager = "flavourclusterwide"
+
+// CapacityClass returns the instance type of the node when the well-known label is set, and a
+// class derived from its allocatable CPU and memory otherwise.
+func CapacityClass(node *v1.Node) string {
+	if instanceType := node.Labels[v1.LabelInstanceTypeStable]; instanceType != "" {
+		return instanceType
+	}
+	cpu := node.Status.Allocatable[v1.ResourceCPU]
+	mem := node.Status.Allocatable[v1.ResourceMemory]
+	return fmt.Sprintf("%dcpu-%dGi", cpu.Value(), mem.Value()/(1024*1024*1024))
+}
+
+// nodeClass returns the capacity class of the node the pod was bound to, and false when the node is not
+// found.
+func (f *FlavourClusterWide) nodeClass(pod *v1.Pod, nodeName string) (string, bool) {
+	nodeInfo, err := f.handle.SnapshotSharedLister().NodeInfos().Get(nodeName)
+	if err != nil {
+		f.logger.Error(err, "Error getting node to annotate pod", "pod", klog.KObj(pod), "node", nodeName)
+		return "", false
+	}
+	return CapacityClass(nodeInfo.Node()), true
+}
+
+// annotatePod applies the annotations of the bind to the pod, in a single apply so that the annotations
+// of the field manager are applied together. Failures are logged only, as the annotations are hints and
+// must not hold up binding.
+func (f *FlavourClusterWide) annotatePod(ctx context.Context, pod *v1.Pod, annotations map[string]string) {
+	if len(annotations) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+	apply := corev1ac.Pod(pod.Name, pod.Namespace).WithAnnotations(annotations)
+	if _, err := f.client.CoreV1().Pods(pod.Namespace).Apply(ctx, apply, metav1.ApplyOptions{FieldManager: FieldManager, Force: true}); err != nil {
+		f.logger.Error(err, "Error annotating pod", "pod", klog.KObj(pod), "annotations", annotations)
+	}
+}
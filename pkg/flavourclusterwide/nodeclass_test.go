@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestCapacityClass(t *testing.T) {
+	labelled := makeNode("node1", map[string]string{v1.LabelInstanceTypeStable: "m5.xlarge"})
+	unlabelled := makeNode("node2", nil)
+	unlabelled.Status.Allocatable = v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("4"),
+		v1.ResourceMemory: resource.MustParse("16Gi"),
+	}
+	if got := CapacityClass(labelled); got != "m5.xlarge" {
+		t.Errorf("expected the instance type, got %s", got)
+	}
+	if got := CapacityClass(unlabelled); got != "4cpu-16Gi" {
+		t.Errorf("expected a class derived from allocatable resources, got %s", got)
+	}
+}
+
+func TestPostBindAnnotateNodeClass(t *testing.T) {
+	nodes := []*v1.Node{makeNode("node1", map[string]string{v1.LabelInstanceTypeStable: "m5.xlarge"})}
+	flavouredPod := makePod("default", "gold", "node1", flavoured("gold"))
+	plainPod := makePod("default", "plain", "node1", nil)
+
+	for _, enabled := range []bool{false, true} {
+		client := clientsetfake.NewSimpleClientset(flavouredPod.DeepCopy(), plainPod.DeepCopy())
+		f := newTestPlugin(nodes, map[string]map[string]int{"node1": {}})
+		f.client = client
+		f.annotateNodeClass = enabled
+
+		f.PostBind(context.Background(), nil, flavouredPod, "node1")
+		f.PostBind(context.Background(), nil, plainPod, "node1")
+
+		want := map[string]string{"gold": "", "plain": ""}
+		if enabled {
+			want["gold"] = "m5.xlarge"
+		}
+		for name, class := range want {
+			pod, err := client.CoreV1().Pods("default").Get(context.Background(), name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := pod.Annotations[NodeClassAnnotation]; got != class {
+				t.Errorf("enabled=%v: expected pod %s node class %q, got %q", enabled, name, class, got)
+			}
+		}
+		for _, action := range client.Actions() {
+			if patch, ok := action.(clienttesting.PatchAction); ok && patch.GetPatchType() != types.ApplyPatchType {
+				t.Errorf("expected the node class to be applied server-side, got a %s patch", patch.GetPatchType())
+			}
+		}
+	}
+}
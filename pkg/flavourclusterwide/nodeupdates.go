@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// gatesUpdates returns true when node update annotations or conditions are configured.
+func (f *FlavourClusterWide) gatesUpdates() bool {
+	return len(f.updateAnnotations) > 0 || len(f.updateConditions) > 0
+}
+
+// isUpdating returns true when a node update orchestrator marked the node with one of the update
+// annotations, or one of the update conditions of the node is True. An update annotation configured
+// with an empty value matches any value.
+func (f *FlavourClusterWide) isUpdating(node *v1.Node) bool {
+	if node == nil {
+		return false
+	}
+	for key, value := range f.updateAnnotations {
+		if actual, exists := node.Annotations[key]; exists && (value == "" || actual == value) {
+			return true
+		}
+	}
+	for _, conditionType := range f.updateConditions {
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == conditionType && condition.Status == v1.ConditionTrue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// startNodeUpdateHandler registers the event handlers rebuilding the cache as soon as a node update
+// starts or completes, rather than at the end of the cache TTL, so that an updating node stops winning
+// the flavour score right away and competes again once it is back.
+func (f *FlavourClusterWide) startNodeUpdateHandler(factory informers.SharedInformerFactory) error {
+	_, err := factory.Core().V1().Nodes().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj any) {
+			original, ok := oldObj.(*v1.Node)
+			if !ok {
+				return
+			}
+			if modified, ok := newObj.(*v1.Node); ok {
+				f.onNodeUpdate(original, modified)
+			}
+		},
+	})
+	return err
+}
+
+// onNodeUpdate requests a rebuild of the cache when the node started or completed an update.
+func (f *FlavourClusterWide) onNodeUpdate(original, modified *v1.Node) {
+	updating := f.isUpdating(modified)
+	if f.isUpdating(original) == updating || !f.selectsNode(modified) {
+		return
+	}
+	if updating {
+		f.logger.V(4).Info("Node update started, leaving the node out of the flavour distribution", "node", klog.KObj(modified))
+	} else {
+		f.logger.V(4).Info("Node update completed, adding the node back to the flavour distribution", "node", klog.KObj(modified))
+	}
+	f.reconcile.Store(true)
+	f.requestRefresh()
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestOnNodeUpdate(t *testing.T) {
+	updating := func(node *v1.Node) *v1.Node {
+		node.Status.Conditions = []v1.NodeCondition{{Type: "OSUpdateInProgress", Status: v1.ConditionTrue}}
+		return node
+	}
+	tests := []struct {
+		name     string
+		original *v1.Node
+		modified *v1.Node
+		want     bool
+	}{
+		{
+			name:     "update started",
+			original: makeWorker("node1"),
+			modified: updating(makeWorker("node1")),
+			want:     true,
+		},
+		{
+			name:     "update completed",
+			original: updating(makeWorker("node1")),
+			modified: makeWorker("node1"),
+			want:     true,
+		},
+		{
+			name:     "still updating",
+			original: updating(makeWorker("node1")),
+			modified: updating(makeWorker("node1")),
+		},
+		{
+			name:     "update of a node that is not a worker",
+			original: updating(makeNode("node1", nil)),
+			modified: makeNode("node1", nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nil, nil)
+			f.updateConditions = []v1.NodeConditionType{"OSUpdateInProgress"}
+			f.refreshRequests = make(chan struct{}, 1)
+
+			f.onNodeUpdate(tt.original, tt.modified)
+			if got := f.reconcile.Load(); got != tt.want {
+				t.Errorf("expected reconcile %v, got %v", tt.want, got)
+			}
+			if got := len(f.refreshRequests) == 1; got != tt.want {
+				t.Errorf("expected a refresh request %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/generated/informers/externalversions"
+)
+
+// Option configures a FlavourClusterWide plugin created with NewWithOptions.
+type Option func(*pluginOptions)
+
+type pluginOptions struct {
+	name            string
+	client          kubernetes.Interface
+	informerFactory informers.SharedInformerFactory
+	logger          klog.Logger
+	clock           clock.WithTicker
+	forecaster      DemandForecaster
+	combiner        Combiner
+	auditStore      AuditStore
+	quotaFactory    externalversions.SharedInformerFactory
+}
+
+// WithName sets the name of the plugin instance, under which it is registered and configured in the
+// scheduler profiles. Defaults to Name.
+func WithName(name string) Option {
+	return func(o *pluginOptions) {
+		o.name = name
+	}
+}
+
+// WithClient sets the client used to list nodes and pods.
+// Defaults to the client of the framework handle or, without one, to a client built from the in-cluster
+// configuration, or from the kubeconfig out of a cluster.
+func WithClient(client kubernetes.Interface) Option {
+	return func(o *pluginOptions) {
+		o.client = client
+	}
+}
+
+// WithInformerFactory sets the informer factory the plugin takes its informers from.
+// Defaults to the SharedInformerFactory of the framework handle.
+func WithInformerFactory(factory informers.SharedInformerFactory) Option {
+	return func(o *pluginOptions) {
+		o.informerFactory = factory
+	}
+}
+
+// WithLogger sets the logger the plugin writes to. Defaults to the logger of the context passed to
+// New, which the scheduler configures with its logging flags, with the name of the plugin instance.
+func WithLogger(logger klog.Logger) Option {
+	return func(o *pluginOptions) {
+		o.logger = logger
+	}
+}
+
+// WithClock sets the clock used by the time-based logic of the plugin, such as the cache TTL.
+// Defaults to the real clock; tests can pass a fake clock to move time deterministically, including
+// the timer of the background refresh.
+func WithClock(clock clock.WithTicker) Option {
+	return func(o *pluginOptions) {
+		o.clock = clock
+	}
+}
+
+// WithForecaster sets the forecaster of the flavour arrivals, used when forecastHorizonSeconds is set.
+// Defaults to the built-in moving average of the binds.
+func WithForecaster(forecaster DemandForecaster) Option {
+	return func(o *pluginOptions) {
+		o.forecaster = forecaster
+	}
+}
+
+// WithCombiner sets the combiner of the balance score terms, in place of the built-in one selected by
+// scoreCombiner.
+func WithCombiner(combiner Combiner) Option {
+	return func(o *pluginOptions) {
+		o.combiner = combiner
+	}
+}
+
+// WithAuditStore sets the store the audit records of the binds are written to, in place of the built-in
+// store selected by auditStore. Setting it enables the audit trail.
+func WithAuditStore(store AuditStore) Option {
+	return func(o *pluginOptions) {
+		o.auditStore = store
+	}
+}
+
+// WithQuotaInformerFactory sets the informer factory the plugin takes the FlavourQuota and PodGroup
+// informers from, when enforceFlavourQuotas is set, and the FlavourPolicy informer from, when
+// flavourPolicy is set. Defaults to a factory of a clientset created from the configuration of the
+// scheduler.
+func WithQuotaInformerFactory(factory externalversions.SharedInformerFactory) Option {
+	return func(o *pluginOptions) {
+		o.quotaFactory = factory
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	fwk "k8s.io/kube-scheduler/framework"
+)
+
+// overheadStateKey is the key in CycleState to the time spent by the plugin in the cycle, see stateKey.
+const overheadStateKey = "Overhead"
+
+// overheadWindow is the number of recent cycles the overhead percentile is computed over.
+const overheadWindow = 1000
+
+// overheadState accumulates the time spent by the plugin in a scheduling cycle. Score runs
+// concurrently on the nodes, hence the atomic counter.
+type overheadState struct {
+	spent atomic.Int64
+}
+
+// Clone the overhead state. It accumulates the whole cycle, so the state itself is returned.
+func (s *overheadState) Clone() fwk.StateData {
+	return s
+}
+
+// overheadTracker keeps the overhead of the recent cycles and reports when their 99th percentile
+// crosses the budget.
+type overheadTracker struct {
+	mu       sync.Mutex
+	budget   time.Duration
+	cycles   []time.Duration
+	next     int
+	exceeded bool
+}
+
+// startOverhead prepares the cycle state to accumulate the overhead of the cycle. It is a no-op
+// when the instrumentation is disabled.
+func (f *FlavourClusterWide) startOverhead(state fwk.CycleState) {
+	if f.overhead == nil || state == nil {
+		return
+	}
+	state.Write(f.stateKey(overheadStateKey), &overheadState{})
+}
+
+// trackOverhead adds the time elapsed since start to the overhead of the cycle. It is meant to be
+// deferred at the beginning of the extension points.
+func (f *FlavourClusterWide) trackOverhead(state fwk.CycleState, start time.Time) {
+	if s := f.readOverhead(state); s != nil {
+		s.spent.Add(int64(f.clock.Since(start)))
+	}
+}
+
+// finishOverhead records the overhead of the cycle once the nodes are scored.
+func (f *FlavourClusterWide) finishOverhead(state fwk.CycleState) {
+	s := f.readOverhead(state)
+	if s == nil {
+		return
+	}
+	f.observeOverhead(time.Duration(s.spent.Load()))
+}
+
+// readOverhead returns the overhead state of the cycle, or nil when there is none.
+func (f *FlavourClusterWide) readOverhead(state fwk.CycleState) *overheadState {
+	if state == nil {
+		return nil
+	}
+	c, err := state.Read(f.stateKey(overheadStateKey))
+	if err != nil {
+		return nil
+	}
+	s, _ := c.(*overheadState)
+	return s
+}
+
+// observeOverhead records the overhead of a cycle and logs when the 99th percentile of the recent
+// cycles exceeds the budget, and when it is back within it.
+func (f *FlavourClusterWide) observeOverhead(spent time.Duration) {
+	t := f.overhead
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.cycles) < overheadWindow {
+		t.cycles = append(t.cycles, spent)
+	} else {
+		t.cycles[t.next] = spent
+		t.next = (t.next + 1) % overheadWindow
+	}
+
+	p99 := percentile(t.cycles, 99)
+	switch {
+	case p99 > t.budget && !t.exceeded:
+		t.exceeded = true
+		f.logger.Info("p99 overhead above the budget", "cycles", len(t.cycles), "p99", p99, "budget", t.budget)
+	case p99 <= t.budget && t.exceeded:
+		t.exceeded = false
+		f.logger.Info("p99 overhead back within the budget", "cycles", len(t.cycles), "p99", p99, "budget", t.budget)
+	}
+}
+
+// percentile returns the nearest-rank percentile p of durations.
+func percentile(durations []time.Duration, p int) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
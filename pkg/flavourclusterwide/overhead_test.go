@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestPercentile(t *testing.T) {
+	durations := make([]time.Duration, 0, 200)
+	for i := 200; i > 0; i-- {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+	if got := percentile(durations, 99); got != 198*time.Millisecond {
+		t.Errorf("expected a p99 of 198ms, got %v", got)
+	}
+	if got := percentile(durations[:1], 99); got != 200*time.Millisecond {
+		t.Errorf("expected the single duration, got %v", got)
+	}
+	if got := percentile(nil, 99); got != 0 {
+		t.Errorf("expected 0 without durations, got %v", got)
+	}
+}
+
+func TestCycleOverhead(t *testing.T) {
+	var logs bytes.Buffer
+	f := newTestPlugin(nil, nil)
+	f.logger = bufferLogger(&logs)
+	f.overhead = &overheadTracker{budget: 5 * time.Millisecond}
+
+	cycle := func(spent ...time.Duration) {
+		state := framework.NewCycleState()
+		f.startOverhead(state)
+		for _, d := range spent {
+			f.trackOverhead(state, f.clock.Now().Add(-d))
+		}
+		if status := f.NormalizeScore(context.Background(), state, nil, nil); !status.IsSuccess() {
+			t.Fatalf("unexpected status: %v", status)
+		}
+	}
+
+	cycle(3*time.Millisecond, 3*time.Millisecond)
+	if !strings.Contains(logs.String(), `"p99 overhead above the budget" cycles=1 p99="6ms" budget="5ms"`) {
+		t.Fatalf("expected a warning for a 6ms cycle, got %q", logs.String())
+	}
+
+	logs.Reset()
+	for i := 0; i < overheadWindow; i++ {
+		cycle(time.Millisecond)
+	}
+	if !strings.Contains(logs.String(), `"p99 overhead back within the budget"`) {
+		t.Errorf("expected a recovery once the slow cycle falls out of the 99th percentile, got %q", logs.String())
+	}
+	if got := len(f.overhead.cycles); got != overheadWindow {
+		t.Errorf("expected %d cycles in the window, got %d", overheadWindow, got)
+	}
+}
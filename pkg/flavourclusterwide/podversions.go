@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// podVersion is the resourceVersion of the last pod event applied to the informer cache, and when the
+// pod was deleted, zero while it exists.
+type podVersion struct {
+	resourceVersion string
+	deleted         time.Time
+}
+
+// acceptEvent reports whether the pod event was not applied yet, and records it if so. After a watch
+// gap, the informer relists and replays the pods as updates, and the cache may have been rebuilt from
+// listers already showing them. Replaying an event could count a pod again after it ended or was
+// deleted, so the events of a pod carrying the resourceVersion of the last event applied or listed are
+// ignored, as are every event of a deleted pod. resourceVersions are opaque and only compared for
+// equality: the events of a pod are applied in the order the informer delivers them. The cache mutex
+// must be held by the caller.
+func (f *FlavourClusterWide) acceptEvent(pod *v1.Pod) bool {
+	if f.versions == nil {
+		return true
+	}
+	last, seen := f.versions[pod.UID]
+	if seen && (!last.deleted.IsZero() || (pod.ResourceVersion != "" && pod.ResourceVersion == last.resourceVersion)) {
+		f.logger.V(5).Info("Ignoring a replayed pod event", "pod", pod.Name, "namespace", pod.Namespace,
+			"resourceVersion", pod.ResourceVersion, "deleted", !last.deleted.IsZero())
+		return false
+	}
+	f.versions[pod.UID] = podVersion{resourceVersion: pod.ResourceVersion}
+	return true
+}
+
+// forgetPod records that the pod was deleted, so that the events of the pod replayed after its deletion
+// are ignored, see acceptEvent.
+func (f *FlavourClusterWide) forgetPod(pod *v1.Pod) {
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+	if f.versions != nil {
+		f.versions[pod.UID] = podVersion{resourceVersion: pod.ResourceVersion, deleted: f.clock.Now()}
+	}
+}
+
+// podVersions returns the versions of the pods the cache is rebuilt from, along with the deletions
+// recorded within the cache TTL of the pods that are no longer listed, longer than a watch gap lasts.
+// The cache mutex must be held by the caller.
+func (f *FlavourClusterWide) podVersions(pods []v1.Pod) map[types.UID]podVersion {
+	versions := make(map[types.UID]podVersion, len(pods))
+	for i := range pods {
+		versions[pods[i].UID] = podVersion{resourceVersion: pods[i].ResourceVersion}
+	}
+	for uid, version := range f.versions {
+		if _, listed := versions[uid]; !listed && !version.deleted.IsZero() && f.clock.Since(version.deleted) < f.cacheTTL {
+			versions[uid] = version
+		}
+	}
+	return versions
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// versionedPod returns a flavoured pod with a UID and a resourceVersion, as the informer delivers it.
+func versionedPod(name, nodeName, flavour, resourceVersion string, phase v1.PodPhase) *v1.Pod {
+	pod := uidPod(name, nodeName, flavour)
+	pod.ResourceVersion = resourceVersion
+	pod.Status.Phase = phase
+	return pod
+}
+
+// podEvent is a pod event delivered by the informer, a deletion when deleted is set.
+type podEvent struct {
+	pod     *v1.Pod
+	deleted bool
+}
+
+func TestWatchGapReconciliation(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []podEvent
+		want   map[string]map[string]int
+	}{
+		{
+			name: "relist replaying the listed pods",
+			events: []podEvent{
+				{pod: versionedPod("p1", "node1", "gold", "10", v1.PodRunning)},
+				{pod: versionedPod("p2", "node2", "gold", "20", v1.PodRunning)},
+				{pod: versionedPod("p1", "node1", "gold", "10", v1.PodRunning)},
+			},
+			want: map[string]map[string]int{"node1": {"gold": 1, "silver": 1}, "node2": {"gold": 1, "silver": 0}},
+		},
+		{
+			name: "relist replaying the end of the pod",
+			events: []podEvent{
+				{pod: versionedPod("p1", "node1", "gold", "12", v1.PodSucceeded)},
+				{pod: versionedPod("p1", "node1", "gold", "12", v1.PodSucceeded)},
+			},
+			want: map[string]map[string]int{"node1": {"gold": 0, "silver": 1}, "node2": {"gold": 1, "silver": 0}},
+		},
+		{
+			name: "opaque resourceVersions applied in delivery order",
+			events: []podEvent{
+				{pod: versionedPod("p1", "node1", "gold", "b", v1.PodRunning)},
+				{pod: versionedPod("p1", "node2", "gold", "a", v1.PodRunning)},
+				{pod: versionedPod("p1", "node2", "gold", "a", v1.PodRunning)},
+			},
+			want: map[string]map[string]int{"node1": {"gold": 0, "silver": 1}, "node2": {"gold": 2, "silver": 0}},
+		},
+		{
+			name: "pod deleted during the watch gap",
+			events: []podEvent{
+				{pod: versionedPod("p2", "node2", "gold", "20", v1.PodRunning), deleted: true},
+				{pod: versionedPod("p2", "node2", "gold", "20", v1.PodRunning)},
+				{pod: versionedPod("p2", "node2", "gold", "21", v1.PodRunning)},
+			},
+			want: map[string]map[string]int{"node1": {"gold": 1, "silver": 1}, "node2": {"gold": 0, "silver": 0}},
+		},
+		{
+			name: "notifications lagging behind the rebuild",
+			events: []podEvent{
+				{pod: versionedPod("p3", "node1", "gold", "29", v1.PodRunning)},
+				{pod: versionedPod("p3", "node1", "gold", "30", v1.PodSucceeded)},
+			},
+			want: map[string]map[string]int{"node1": {"gold": 1, "silver": 1}, "node2": {"gold": 1, "silver": 0}},
+		},
+		{
+			name: "bind seen by PostBind and replayed by the informer",
+			events: []podEvent{
+				{pod: versionedPod("p4", "node1", "silver", "40", v1.PodRunning)},
+				{pod: versionedPod("p4", "node1", "silver", "40", v1.PodRunning)},
+				{pod: versionedPod("p4", "node1", "silver", "41", v1.PodRunning)},
+			},
+			want: map[string]map[string]int{"node1": {"gold": 1, "silver": 1}, "node2": {"gold": 1, "silver": 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+			f := newTestPlugin(nodes, nil)
+			f.logger = logr.Discard()
+			f.counted = make(map[types.UID]placement)
+			f.versions = make(map[types.UID]podVersion)
+			f.excludedPodPhases = []v1.PodPhase{v1.PodSucceeded, v1.PodFailed}
+			// p3 ended before the rebuild, whose listers already show it.
+			f.store = &staticStore{
+				nodes: []v1.Node{*nodes[0], *nodes[1]},
+				pods: []v1.Pod{
+					*versionedPod("p1", "node1", "gold", "10", v1.PodRunning),
+					*versionedPod("p2", "node2", "gold", "20", v1.PodRunning),
+					*versionedPod("p3", "node1", "gold", "30", v1.PodSucceeded),
+				},
+			}
+			f.reconcile.Store(true)
+			f.updateCacheIfNeeded(context.Background())
+			f.PostBind(context.Background(), nil, versionedPod("p4", "", "silver", "39", v1.PodPending), "node1")
+
+			for _, event := range tt.events {
+				if event.deleted {
+					f.onPodDeleteEvent(cache.DeletedFinalStateUnknown{Key: "default/" + event.pod.Name, Obj: event.pod})
+					continue
+				}
+				f.onPod(event.pod)
+			}
+			expectCache(t, f, tt.want)
+		})
+	}
+}
+
+func TestPodVersionsPruning(t *testing.T) {
+	f := newTestPlugin(nil, nil)
+	fakeClock := f.clock.(*clocktesting.FakeClock)
+	f.versions = map[types.UID]podVersion{}
+	f.forgetPod(versionedPod("old", "node1", "gold", "1", v1.PodRunning))
+	fakeClock.Step(f.cacheTTL)
+	f.forgetPod(versionedPod("recent", "node1", "gold", "2", v1.PodRunning))
+
+	versions := f.podVersions([]v1.Pod{*versionedPod("listed", "node1", "gold", "3", v1.PodRunning)})
+	if _, kept := versions["old"]; kept {
+		t.Errorf("expected the deletion older than the cache TTL to be pruned")
+	}
+	if version := versions["recent"]; version.deleted != fakeClock.Now() {
+		t.Errorf("expected the recent deletion to be kept, got %+v", version)
+	}
+	if version := versions["listed"]; version.resourceVersion != "3" || !version.deleted.IsZero() {
+		t.Errorf("expected the listed pod to be recorded, got %+v", version)
+	}
+}
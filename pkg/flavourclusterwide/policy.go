@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/generated/informers/externalversions"
+)
+
+// flavourPolicyResource is the resource of the FlavourPolicy events, which may lift the caps holding
+// the pods back.
+const flavourPolicyResource = fwk.EventResource("flavourpolicies.v1alpha1.scheduling.x-k8s.io")
+
+// flavourTuning is the tuning of the flavour balancing set by a FlavourPolicy, which replaces the one of
+// the args while the policy exists.
+type flavourTuning struct {
+	labelName      string
+	targetRatios   map[string]int32
+	maxPodsPerNode int
+	domainCaps     map[string]int32
+	capTopologyKey string
+	topologyKey    string
+}
+
+// startFlavourPolicy watches the FlavourPolicy of the namespace/name ref, from the informer factory of
+// the scheduling.x-k8s.io clientset, created from the configuration of the scheduler and restricted to
+// the policy unless one is given, and retunes the plugin with it, see applyPolicy.
+func (f *FlavourClusterWide) startFlavourPolicy(ctx context.Context, ref string, args *pluginConfig.FlavourClusterWideArgs, factory externalversions.SharedInformerFactory) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(ref)
+	if err != nil {
+		return err
+	}
+	if factory == nil {
+		client, err := f.schedulingClient()
+		if err != nil {
+			return err
+		}
+		factory = externalversions.NewSharedInformerFactoryWithOptions(client, 0, externalversions.WithNamespace(namespace),
+			externalversions.WithTweakListOptions(func(options *metav1.ListOptions) {
+				options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+			}))
+	}
+	selected := func(obj any) (*v1alpha1.FlavourPolicy, bool) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		policy, ok := obj.(*v1alpha1.FlavourPolicy)
+		return policy, ok && policy.Namespace == namespace && policy.Name == name
+	}
+	if _, err := factory.Scheduling().V1alpha1().FlavourPolicies().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if policy, ok := selected(obj); ok {
+				f.applyPolicy(ctx, policy)
+			}
+		},
+		UpdateFunc: func(_, obj any) {
+			if policy, ok := selected(obj); ok {
+				f.applyPolicy(ctx, policy)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if policy, ok := selected(obj); ok {
+				f.tuning.Store(nil)
+				f.relabel(ctx, f.policyLabel(""))
+				f.logger.Info("FlavourPolicy deleted, tuning with the args again", "policy", klog.KObj(policy))
+			}
+		},
+	}); err != nil {
+		return err
+	}
+	f.policyArgs = args
+	factory.Start(ctx.Done())
+	return nil
+}
+
+// applyPolicy retunes the plugin with the policy, and rebuilds the cache when the policy changes the
+// flavour label, see relabel. An invalid policy is logged and leaves the tuning as it was.
+func (f *FlavourClusterWide) applyPolicy(ctx context.Context, policy *v1alpha1.FlavourPolicy) {
+	tuning, err := f.tuningOf(&policy.Spec)
+	if err != nil {
+		f.logger.Error(err, "Ignoring invalid FlavourPolicy", "policy", klog.KObj(policy))
+		return
+	}
+	f.tuning.Store(tuning)
+	f.relabel(ctx, f.policyLabel(tuning.labelName))
+	f.logger.Info("Flavour balancing retuned by FlavourPolicy", "policy", klog.KObj(policy), "generation", policy.Generation)
+}
+
+// tuningOf returns the tuning of the policy, validated as the args it replaces.
+func (f *FlavourClusterWide) tuningOf(spec *v1alpha1.FlavourPolicySpec) (*flavourTuning, error) {
+	args := f.policyArgs.DeepCopy()
+	if spec.LabelName != "" {
+		args.LabelName = spec.LabelName
+	}
+	args.TargetRatios = spec.TargetRatios
+	args.MaxPodsPerFlavourPerNode = spec.MaxPodsPerFlavourPerNode
+	args.MaxPodsPerTopologyDomain = spec.MaxPodsPerTopologyDomain
+	args.CapTopologyKey = spec.CapTopologyKey
+	args.TopologyKey = spec.TopologyKey
+	if err := validation.ValidateFlavourClusterWideArgs(args, field.NewPath("spec")); err != nil {
+		return nil, err
+	}
+	tuning := &flavourTuning{
+		labelName:      spec.LabelName,
+		targetRatios:   spec.TargetRatios,
+		maxPodsPerNode: int(spec.MaxPodsPerFlavourPerNode),
+		domainCaps:     spec.MaxPodsPerTopologyDomain,
+		capTopologyKey: spec.CapTopologyKey,
+		topologyKey:    spec.TopologyKey,
+	}
+	if tuning.capTopologyKey == "" {
+		tuning.capTopologyKey = v1.LabelTopologyZone
+	}
+	return tuning, nil
+}
+
+// policyLabel returns the flavour label of the given name, or of the args when the name is empty, with
+// the label keys of the args.
+func (f *FlavourClusterWide) policyLabel(name string) *flavourLabel {
+	if name == "" {
+		name = f.policyArgs.LabelName
+	}
+	if name == "" {
+		name = defaultLabelName
+	}
+	return newFlavourLabel(name, f.policyArgs.LabelKeys)
+}
+
+// ratios returns the target proportions of the flavours, see ratioScore.
+func (f *FlavourClusterWide) ratios() map[string]int32 {
+	if t := f.tuning.Load(); t != nil {
+		return t.targetRatios
+	}
+	return f.targetRatios
+}
+
+// nodeCap returns the number of pods of a flavour above which Filter rejects a node, 0 when unlimited.
+func (f *FlavourClusterWide) nodeCap() int {
+	if t := f.tuning.Load(); t != nil {
+		return t.maxPodsPerNode
+	}
+	return f.maxPodsPerNode
+}
+
+// domainCap returns the number of pods of the flavour above which Filter rejects the nodes of a topology
+// domain, 0 when unlimited, and the topology key of the domains.
+func (f *FlavourClusterWide) domainCap(flavour string) (int32, string) {
+	if t := f.tuning.Load(); t != nil {
+		return t.domainCaps[flavour], t.capTopologyKey
+	}
+	return f.domainCaps[flavour], f.capTopologyKey
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/utils/ptr"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	schedfake "sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned/fake"
+	"sigs.k8s.io/scheduler-plugins/pkg/generated/informers/externalversions"
+	testutil "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+func makePolicy(spec v1alpha1.FlavourPolicySpec) *v1alpha1.FlavourPolicy {
+	return &v1alpha1.FlavourPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "flavours"}, Spec: spec}
+}
+
+func TestTuningOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    pluginConfig.FlavourClusterWideArgs
+		spec    v1alpha1.FlavourPolicySpec
+		want    *flavourTuning
+		wantErr string
+	}{
+		{
+			name: "policy replacing the args",
+			args: pluginConfig.FlavourClusterWideArgs{MaxPodsPerFlavourPerNode: 3, TopologyKey: v1.LabelTopologyZone},
+			spec: v1alpha1.FlavourPolicySpec{
+				LabelName:                "flavour",
+				TargetRatios:             map[string]int32{"gold": 1, "silver": 2},
+				MaxPodsPerTopologyDomain: map[string]int32{"gold": 10},
+				CapTopologyKey:           v1.LabelTopologyRegion,
+			},
+			want: &flavourTuning{
+				labelName:      "flavour",
+				targetRatios:   map[string]int32{"gold": 1, "silver": 2},
+				domainCaps:     map[string]int32{"gold": 10},
+				capTopologyKey: v1.LabelTopologyRegion,
+			},
+		},
+		{
+			name: "empty policy",
+			args: pluginConfig.FlavourClusterWideArgs{MaxPodsPerFlavourPerNode: 3},
+			want: &flavourTuning{capTopologyKey: v1.LabelTopologyZone},
+		},
+		{
+			name: "other label",
+			spec: v1alpha1.FlavourPolicySpec{LabelName: "team"},
+			want: &flavourTuning{labelName: "team", capTopologyKey: v1.LabelTopologyZone},
+		},
+		{
+			name:    "invalid label",
+			spec:    v1alpha1.FlavourPolicySpec{LabelName: "not a label"},
+			wantErr: "spec.labelName: Invalid value",
+		},
+		{
+			name:    "invalid ratio",
+			spec:    v1alpha1.FlavourPolicySpec{TargetRatios: map[string]int32{"gold": 0}},
+			wantErr: "spec.targetRatios[gold]: Invalid value: 0",
+		},
+		{
+			name:    "topology key with topology tiers",
+			args:    pluginConfig.FlavourClusterWideArgs{TopologyTiers: []pluginConfig.FlavourTopologyTier{{TopologyKey: v1.LabelTopologyZone}, {TopologyKey: v1.LabelHostname}}},
+			spec:    v1alpha1.FlavourPolicySpec{TopologyKey: v1.LabelTopologyRegion},
+			wantErr: "spec.topologyKey: Invalid value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nil, nil)
+			f.policyArgs = &tt.args
+			got, err := f.tuningOf(&tt.spec)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(flavourTuning{})); diff != "" {
+				t.Errorf("unexpected tuning (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterWithPolicy(t *testing.T) {
+	f := newTestPlugin(nil, nil)
+	f.logger = logr.Discard()
+	f.maxPodsPerNode = 3
+	f.policyArgs = &pluginConfig.FlavourClusterWideArgs{MaxPodsPerFlavourPerNode: 3}
+	nodeInfo := framework.NewNodeInfo(makePod("default", "p1", "node1", flavoured("gold")), makePod("default", "p2", "node1", flavoured("gold")))
+	nodeInfo.SetNode(makeWorker("node1"))
+	pod := makePod("default", "p", "", flavoured("gold"))
+
+	if status := f.Filter(context.Background(), nil, pod, nodeInfo); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	// The policy lowers the cap of the args.
+	f.applyPolicy(context.Background(), makePolicy(v1alpha1.FlavourPolicySpec{MaxPodsPerFlavourPerNode: 2}))
+	if status := f.Filter(context.Background(), nil, pod, nodeInfo); status.Code() != fwk.Unschedulable {
+		t.Errorf("expected the node to be rejected, got %v", status)
+	}
+	// An invalid policy leaves the tuning as it was.
+	f.applyPolicy(context.Background(), makePolicy(v1alpha1.FlavourPolicySpec{TargetRatios: map[string]int32{"gold": 0}}))
+	if status := f.Filter(context.Background(), nil, pod, nodeInfo); status.Code() != fwk.Unschedulable {
+		t.Errorf("expected the node to be rejected, got %v", status)
+	}
+}
+
+func TestNewWithFlavourPolicy(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1")}
+	client := clientsetfake.NewSimpleClientset(nodes[0])
+	policyClient := schedfake.NewSimpleClientset(makePolicy(v1alpha1.FlavourPolicySpec{
+		MaxPodsPerFlavourPerNode: 2,
+		TargetRatios:             map[string]int32{"gold": 1, "silver": 3},
+	}))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f, err := NewWithOptions(ctx, &cfgv1.FlavourClusterWideArgs{FlavourPolicy: ptr.To("kube-system/flavours"), MaxPodsPerFlavourPerNode: ptr.To[int32](1)},
+		&fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)},
+		WithClient(client),
+		WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+		WithQuotaInformerFactory(externalversions.NewSharedInformerFactory(policyClient, 0)),
+		WithLogger(logr.Discard()),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+
+	waitForNodeCap := func(want int) {
+		t.Helper()
+		if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+			return f.nodeCap() == want, nil
+		}); err != nil {
+			t.Fatalf("expected a node cap of %d, got %d", want, f.nodeCap())
+		}
+	}
+	waitForNodeCap(2)
+	if diff := cmp.Diff(map[string]int32{"gold": 1, "silver": 3}, f.ratios()); diff != "" {
+		t.Errorf("unexpected ratios (-want,+got):\n%s", diff)
+	}
+
+	// The args apply again once the policy is deleted.
+	if err := policyClient.SchedulingV1alpha1().FlavourPolicies("kube-system").Delete(ctx, "flavours", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForNodeCap(1)
+	if ratios := f.ratios(); ratios != nil {
+		t.Errorf("expected no ratios, got %v", ratios)
+	}
+}
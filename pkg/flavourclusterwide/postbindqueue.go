@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// bindUpdate is the bookkeeping of a bound pod, applied by PostBind or by the worker of the PostBind queue.
+type bindUpdate struct {
+	pod      *v1.Pod
+	nodeName string
+	flavour  string
+	// quality is the placement quality of the pod, when scored is set, see placementQuality.
+	quality int64
+	scored  bool
+	// explanation is the explanation of the scoring of the node, when scoring events are recorded, see
+	// scoringExplanation.
+	explanation string
+}
+
+// startPostBindQueue starts the worker applying the queued bind updates until ctx is done. The updates
+// are applied with ctx, as PostBind may return before they are.
+func (f *FlavourClusterWide) startPostBindQueue(ctx context.Context, size int32) {
+	f.bindQueue = make(chan bindUpdate, size)
+	f.runInBackground(func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case update := <-f.bindQueue:
+				postBindQueueLength.WithLabelValues(f.Name()).Set(float64(len(f.bindQueue)))
+				f.applyBind(ctx, update)
+			}
+		}
+	})
+}
+
+// enqueueBind queues the update for the worker. When the queue is full, it blocks with the Block policy,
+// until there is room or ctx is done. Otherwise, the update is dropped and the cache is marked to be
+// rebuilt on the next scheduling cycle, which recounts the pod from the API.
+func (f *FlavourClusterWide) enqueueBind(ctx context.Context, update bindUpdate) {
+	select {
+	case f.bindQueue <- update:
+		postBindQueueLength.WithLabelValues(f.Name()).Set(float64(len(f.bindQueue)))
+		return
+	default:
+	}
+
+	postBindQueueOverflows.WithLabelValues(f.Name(), string(f.postBindOverflowPolicy)).Inc()
+	if f.postBindOverflowPolicy == pluginConfig.FlavourPostBindBlock {
+		select {
+		case f.bindQueue <- update:
+		case <-ctx.Done():
+		}
+		return
+	}
+	f.reconcile.Store(true)
+	f.requestRefresh()
+	f.logger.Info("PostBind queue full, dropped the cache update of pod", "pod", klog.KObj(update.pod), "node", update.nodeName)
+}
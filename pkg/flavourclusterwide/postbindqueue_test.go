@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	metricstestutil "k8s.io/component-base/metrics/testutil"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestPostBindQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := newTestPlugin(nil, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 0},
+	})
+	f.logger = logr.Discard()
+	f.startPostBindQueue(ctx, 10)
+
+	f.PostBind(ctx, nil, uidPod("p1", "node1", "gold"), "node1")
+	f.PostBind(ctx, nil, uidPod("p2", "node2", "gold"), "node2")
+	f.PostBind(ctx, nil, uidPod("p3", "node2", "gold"), "node2")
+	waitForCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 2},
+	})
+}
+
+func TestPostBindQueueDropAndReconcile(t *testing.T) {
+	ctx := context.Background()
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	f := newTestPlugin(nodes, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 0},
+	})
+	f.logger = logr.Discard()
+	f.postBindOverflowPolicy = pluginConfig.FlavourPostBindDropAndReconcile
+	// Without a worker, the queue stays full after the first update.
+	f.bindQueue = make(chan bindUpdate, 1)
+	overflows := postBindQueueOverflows.WithLabelValues(Name, string(pluginConfig.FlavourPostBindDropAndReconcile))
+	before, err := metricstestutil.GetCounterMetricValue(overflows)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f.PostBind(ctx, nil, uidPod("p1", "node1", "gold"), "node1")
+	f.PostBind(ctx, nil, uidPod("p2", "node2", "gold"), "node2")
+	after, err := metricstestutil.GetCounterMetricValue(overflows)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if after-before != 1 {
+		t.Errorf("expected 1 overflow, got %v", after-before)
+	}
+
+	// The cache is still within its TTL, but the dropped update rebuilds it.
+	f.store = apiStore{client: clientsetfake.NewSimpleClientset(nodes[0], nodes[1],
+		uidPod("p1", "node1", "gold"), uidPod("p2", "node2", "gold"))}
+	f.updateCacheIfNeeded(ctx)
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 1},
+	})
+
+	// The listing is unchanged since that rebuild, but the counts may have drifted from it: another
+	// dropped update rebuilds the cache all the same.
+	f.cacheMutex.Lock()
+	f.cache["node1"]["gold"] = 5
+	f.cacheMutex.Unlock()
+	f.PostBind(ctx, nil, uidPod("p3", "node1", "gold"), "node1")
+	f.updateCacheIfNeeded(ctx)
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 1},
+	})
+}
+
+func TestPostBindQueueBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := newTestPlugin(nil, map[string]map[string]int{"node1": {"gold": 0}})
+	f.logger = logr.Discard()
+	f.postBindOverflowPolicy = pluginConfig.FlavourPostBindBlock
+	f.bindQueue = make(chan bindUpdate, 1)
+	f.PostBind(ctx, nil, uidPod("p1", "node1", "gold"), "node1")
+
+	done := make(chan struct{})
+	go func() {
+		f.PostBind(ctx, nil, uidPod("p2", "node1", "gold"), "node1")
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatalf("expected PostBind to block on the full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// PostBind returns once the queue has room.
+	<-f.bindQueue
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected PostBind to return")
+	}
+	if f.reconcile.Load() {
+		t.Errorf("expected no reconcile with the Block policy")
+	}
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/klog/v2"
+	extenderv1 "k8s.io/kube-scheduler/extender/v1"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/preemption"
+	schedutil "k8s.io/kubernetes/pkg/scheduler/util"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+)
+
+var _ = framework.PostFilterPlugin(&FlavourClusterWide{})
+
+// PostFilter preempts pods of the flavours less important than the flavour of the pod, as ordered by
+// flavourPriorities, when the pod fits no node, and nominates the node where they make room for it. The
+// node is the one where preempting violates the fewest PodDisruptionBudgets, then where the most
+// important victim is of the least important flavour, then where the fewest pods are preempted. Without
+// flavour priorities, the pod is left unschedulable.
+func (f *FlavourClusterWide) PostFilter(ctx context.Context, state fwk.CycleState, pod *v1.Pod, m framework.NodeToStatusReader) (*framework.PostFilterResult, *fwk.Status) {
+	if f.preemption == nil {
+		return nil, fwk.NewStatus(fwk.Unschedulable, "flavour preemption is disabled")
+	}
+	return f.preemption.Preempt(ctx, state, pod, m)
+}
+
+// flavourPreemptor selects the victims of the flavour preemption for the preemption evaluator.
+type flavourPreemptor struct {
+	f *FlavourClusterWide
+}
+
+var _ preemption.Interface = &flavourPreemptor{}
+
+// flavourRank returns the rank of the flavour of the pod in flavourPriorities, 0 for the most important
+// flavour, and false when the pod has no flavour or its flavour is not listed.
+func (f *FlavourClusterWide) flavourRank(pod *v1.Pod) (int, bool) {
+	rank, ok := f.flavourRanks[f.flavourOf(pod)]
+	return rank, ok
+}
+
+// preemptible reports whether the pod may be preempted by a pod of the given flavour rank and priority:
+// its flavour is listed after the one of the preemptor, its priority is not higher, and it is not
+// protected by FlavourDoNotEvictAnnotation.
+func (f *FlavourClusterWide) preemptible(pod *v1.Pod, rank int, priority int32) bool {
+	if pod.Annotations[v1alpha1.FlavourDoNotEvictAnnotation] == "true" {
+		return false
+	}
+	victimRank, ok := f.flavourRank(pod)
+	return ok && victimRank > rank && corev1helpers.PodPriority(pod) <= priority
+}
+
+func (p *flavourPreemptor) GetOffsetAndNumCandidates(n int32) (int32, int32) {
+	return 0, n
+}
+
+func (p *flavourPreemptor) CandidatesToVictimsMap(candidates []preemption.Candidate) map[string]*extenderv1.Victims {
+	m := make(map[string]*extenderv1.Victims, len(candidates))
+	for _, c := range candidates {
+		m[c.Name()] = c.Victims()
+	}
+	return m
+}
+
+// PodEligibleToPreemptOthers reports whether the pod may preempt: its flavour is listed in
+// flavourPriorities before another one, its preemption policy allows it, and no pod it could preempt is
+// still terminating on the node it was nominated for by a previous preemption.
+func (p *flavourPreemptor) PodEligibleToPreemptOthers(_ context.Context, pod *v1.Pod, nominatedNodeStatus *fwk.Status) (bool, string) {
+	if pod.Spec.PreemptionPolicy != nil && *pod.Spec.PreemptionPolicy == v1.PreemptNever {
+		return false, "not eligible due to preemptionPolicy=Never."
+	}
+	rank, ok := p.f.flavourRank(pod)
+	if !ok {
+		return false, fmt.Sprintf("not eligible as flavour %q has no priority", p.f.flavourOf(pod))
+	}
+	if rank == len(p.f.flavourRanks)-1 {
+		return false, fmt.Sprintf("not eligible as no flavour is less important than %s", p.f.flavourOf(pod))
+	}
+
+	nominatedNode := pod.Status.NominatedNodeName
+	if nominatedNode == "" || nominatedNodeStatus.Code() == fwk.UnschedulableAndUnresolvable {
+		return true, ""
+	}
+	nodeInfo, _ := p.f.handle.SnapshotSharedLister().NodeInfos().Get(nominatedNode)
+	if nodeInfo == nil {
+		return true, ""
+	}
+	priority := corev1helpers.PodPriority(pod)
+	for _, pi := range nodeInfo.GetPods() {
+		if pi.GetPod().DeletionTimestamp != nil && p.f.preemptible(pi.GetPod(), rank, priority) {
+			return false, "not eligible due to a terminating pod on the nominated node."
+		}
+	}
+	return true, ""
+}
+
+// SelectVictimsOnNode returns the fewest pods of less important flavours to preempt for the pod to fit
+// the node. Every such pod is removed, and then the pods are added back from the most important flavour,
+// those whose PodDisruptionBudget would be violated first, for as long as the pod still fits.
+func (p *flavourPreemptor) SelectVictimsOnNode(ctx context.Context, state fwk.CycleState, pod *v1.Pod, nodeInfo fwk.NodeInfo, pdbs []*policy.PodDisruptionBudget) ([]*v1.Pod, int, *fwk.Status) {
+	logger := klog.FromContext(klog.NewContext(ctx, p.f.logger)).WithValues("ExtensionPoint", "PostFilter")
+	handle := p.f.handle
+	removePod := func(pi fwk.PodInfo) error {
+		if err := nodeInfo.RemovePod(logger, pi.GetPod()); err != nil {
+			return err
+		}
+		return handle.RunPreFilterExtensionRemovePod(ctx, state, pod, pi, nodeInfo).AsError()
+	}
+	addPod := func(pi fwk.PodInfo) error {
+		nodeInfo.AddPodInfo(pi)
+		return handle.RunPreFilterExtensionAddPod(ctx, state, pod, pi, nodeInfo).AsError()
+	}
+
+	rank, _ := p.f.flavourRank(pod)
+	priority := corev1helpers.PodPriority(pod)
+	var potentialVictims []fwk.PodInfo
+	for _, pi := range nodeInfo.GetPods() {
+		if p.f.preemptible(pi.GetPod(), rank, priority) {
+			potentialVictims = append(potentialVictims, pi)
+		}
+	}
+	if len(potentialVictims) == 0 {
+		return nil, 0, fwk.NewStatus(fwk.UnschedulableAndUnresolvable, fmt.Sprintf("No pods of a less important flavour on node %s for pod %s", nodeInfo.Node().Name, pod.Name))
+	}
+	for _, pi := range potentialVictims {
+		if err := removePod(pi); err != nil {
+			return nil, 0, fwk.AsStatus(err)
+		}
+	}
+	// The pod does not fit even without the pods of the less important flavours.
+	if s := handle.RunFilterPluginsWithNominatedPods(ctx, state, pod, nodeInfo); !s.IsSuccess() {
+		return nil, 0, s
+	}
+
+	sort.SliceStable(potentialVictims, func(i, j int) bool {
+		return p.f.moreImportant(potentialVictims[i].GetPod(), potentialVictims[j].GetPod())
+	})
+	var victims []*v1.Pod
+	numViolatingVictim := 0
+	reprievePod := func(pi fwk.PodInfo) (bool, error) {
+		if err := addPod(pi); err != nil {
+			return false, err
+		}
+		fits := handle.RunFilterPluginsWithNominatedPods(ctx, state, pod, nodeInfo).IsSuccess()
+		if !fits {
+			if err := removePod(pi); err != nil {
+				return false, err
+			}
+			victims = append(victims, pi.GetPod())
+			logger.V(5).Info("Found a potential preemption victim on node", "pod", klog.KObj(pi.GetPod()), "node", klog.KObj(nodeInfo.Node()))
+		}
+		return fits, nil
+	}
+	violatingVictims, nonViolatingVictims := filterPodsWithPDBViolation(potentialVictims, pdbs)
+	for _, pi := range violatingVictims {
+		fits, err := reprievePod(pi)
+		if err != nil {
+			logger.Error(err, "Failed to reprieve pod", "pod", klog.KObj(pi.GetPod()))
+			return nil, 0, fwk.AsStatus(err)
+		}
+		if !fits {
+			numViolatingVictim++
+		}
+	}
+	for _, pi := range nonViolatingVictims {
+		if _, err := reprievePod(pi); err != nil {
+			logger.Error(err, "Failed to reprieve pod", "pod", klog.KObj(pi.GetPod()))
+			return nil, 0, fwk.AsStatus(err)
+		}
+	}
+	if len(violatingVictims) != 0 && len(nonViolatingVictims) != 0 {
+		sort.SliceStable(victims, func(i, j int) bool { return p.f.moreImportant(victims[i], victims[j]) })
+	}
+	return victims, numViolatingVictim, fwk.NewStatus(fwk.Success)
+}
+
+// OrderedScoreFuncs prefers the nodes violating the fewest PodDisruptionBudgets, then those whose most
+// important victim is of the least important flavour, then those with the fewest victims.
+func (p *flavourPreemptor) OrderedScoreFuncs(_ context.Context, nodesToVictims map[string]*extenderv1.Victims) []func(node string) int64 {
+	return []func(node string) int64{
+		func(node string) int64 {
+			return -nodesToVictims[node].NumPDBViolations
+		},
+		func(node string) int64 {
+			top := math.MaxInt
+			for _, victim := range nodesToVictims[node].Pods {
+				if rank, ok := p.f.flavourRank(victim); ok {
+					top = min(top, rank)
+				}
+			}
+			return int64(top)
+		},
+		func(node string) int64 {
+			return -int64(len(nodesToVictims[node].Pods))
+		},
+	}
+}
+
+// moreImportant reports whether pod a is more important than pod b: of a more important flavour, or of
+// the same flavour and more important to the scheduler.
+func (f *FlavourClusterWide) moreImportant(a, b *v1.Pod) bool {
+	rankA, _ := f.flavourRank(a)
+	rankB, _ := f.flavourRank(b)
+	if rankA != rankB {
+		return rankA < rankB
+	}
+	return schedutil.MoreImportantPod(a, b)
+}
+
+// filterPodsWithPDBViolation groups the given pods into the pods whose PodDisruptionBudget would be
+// violated if they were preempted and the others, keeping their order.
+func filterPodsWithPDBViolation(podInfos []fwk.PodInfo, pdbs []*policy.PodDisruptionBudget) (violatingPods, nonViolatingPods []fwk.PodInfo) {
+	pdbsAllowed := make([]int32, len(pdbs))
+	for i, pdb := range pdbs {
+		pdbsAllowed[i] = pdb.Status.DisruptionsAllowed
+	}
+
+	for _, podInfo := range podInfos {
+		pod := podInfo.GetPod()
+		pdbForPodIsViolated := false
+		// A pod with no labels will not match any PDB. So, no need to check.
+		if len(pod.Labels) != 0 {
+			for i, pdb := range pdbs {
+				if pdb.Namespace != pod.Namespace {
+					continue
+				}
+				selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+				if err != nil {
+					continue
+				}
+				// A PDB with a nil or empty selector matches nothing.
+				if selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+					continue
+				}
+				// Existing in DisruptedPods means it has been processed in API server,
+				// we don't treat it as a violating case.
+				if _, exist := pdb.Status.DisruptedPods[pod.Name]; exist {
+					continue
+				}
+				// Only decrement the matched pdb when it's not in its DisruptedPods;
+				// otherwise we may over-decrement the budget number.
+				pdbsAllowed[i]--
+				if pdbsAllowed[i] < 0 {
+					pdbForPodIsViolated = true
+				}
+			}
+		}
+		if pdbForPodIsViolated {
+			violatingPods = append(violatingPods, podInfo)
+		} else {
+			nonViolatingPods = append(nonViolatingPods, podInfo)
+		}
+	}
+	return violatingPods, nonViolatingPods
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/events"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/defaultbinder"
+	plfeature "k8s.io/kubernetes/pkg/scheduler/framework/plugins/feature"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/noderesources"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/queuesort"
+	"k8s.io/kubernetes/pkg/scheduler/framework/preemption"
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+	"k8s.io/kubernetes/pkg/scheduler/metrics"
+	st "k8s.io/kubernetes/pkg/scheduler/testing"
+	tf "k8s.io/kubernetes/pkg/scheduler/testing/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	testutil "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+// preemptionNode returns a node with room for two pods of preemptionPod.
+func preemptionNode(name string) *v1.Node {
+	return st.MakeNode().Name(name).Capacity(map[v1.ResourceName]string{v1.ResourceCPU: "1", v1.ResourcePods: "10"}).Obj()
+}
+
+// preemptionPod returns a pod of the flavour, without a flavour when it is "", requesting half of the
+// CPU of a preemptionNode.
+func preemptionPod(name, nodeName, flavour string, priority int32) *v1.Pod {
+	pod := st.MakePod().Namespace("default").Name(name).UID(name).Node(nodeName).Priority(priority).
+		Req(map[v1.ResourceName]string{v1.ResourceCPU: "500m"}).Obj()
+	if flavour != "" {
+		pod.Labels = flavoured(flavour)
+	}
+	return pod
+}
+
+// wholeNode makes the pod request all the CPU of a preemptionNode.
+func wholeNode(pod *v1.Pod) *v1.Pod {
+	pod.Spec.Containers[0].Resources.Requests[v1.ResourceCPU] = resource.MustParse("1")
+	return pod
+}
+
+// doNotEvict protects the pod from eviction with FlavourDoNotEvictAnnotation.
+func doNotEvict(pod *v1.Pod) *v1.Pod {
+	pod.Annotations = map[string]string{v1alpha1.FlavourDoNotEvictAnnotation: "true"}
+	return pod
+}
+
+func TestPostFilter(t *testing.T) {
+	metrics.Register()
+
+	tests := []struct {
+		name          string
+		nodes         []*v1.Node
+		pods          []*v1.Pod
+		pod           *v1.Pod
+		wantNode      string
+		wantStatus    fwk.Code
+		wantMessage   string
+		wantPreempted []string
+	}{
+		{
+			name:  "gold pod preempting the bronze pod rather than a silver one",
+			nodes: []*v1.Node{preemptionNode("node1"), preemptionNode("node2")},
+			pods: []*v1.Pod{
+				preemptionPod("bronze1", "node1", "bronze", 0),
+				preemptionPod("silver1", "node1", "silver", 0),
+				preemptionPod("silver2", "node2", "silver", 0),
+				preemptionPod("silver3", "node2", "silver", 0),
+			},
+			pod:           preemptionPod("gold", "", "gold", 0),
+			wantNode:      "node1",
+			wantStatus:    fwk.Success,
+			wantPreempted: []string{"bronze1"},
+		},
+		{
+			name:  "silver pod preempting on the only node with a less important flavour",
+			nodes: []*v1.Node{preemptionNode("node1"), preemptionNode("node2")},
+			pods: []*v1.Pod{
+				preemptionPod("gold1", "node1", "gold", 0),
+				preemptionPod("silver1", "node1", "silver", 0),
+				preemptionPod("silver2", "node2", "silver", 0),
+				preemptionPod("bronze1", "node2", "bronze", 0),
+			},
+			pod:           preemptionPod("silver", "", "silver", 0),
+			wantNode:      "node2",
+			wantStatus:    fwk.Success,
+			wantPreempted: []string{"bronze1"},
+		},
+		{
+			name:  "fewest victims among the nodes of the least important flavour",
+			nodes: []*v1.Node{preemptionNode("node1"), preemptionNode("node2")},
+			pods: []*v1.Pod{
+				preemptionPod("bronze1", "node1", "bronze", 0),
+				preemptionPod("bronze2", "node1", "bronze", 0),
+				wholeNode(preemptionPod("bronze3", "node2", "bronze", 0)),
+			},
+			pod:           wholeNode(preemptionPod("gold", "", "gold", 0)),
+			wantNode:      "node2",
+			wantStatus:    fwk.Success,
+			wantPreempted: []string{"bronze3"},
+		},
+		{
+			name:  "pods of a higher priority or without a listed flavour kept",
+			nodes: []*v1.Node{preemptionNode("node1")},
+			pods: []*v1.Pod{
+				preemptionPod("bronze1", "node1", "bronze", 1000),
+				preemptionPod("other1", "node1", "", 0),
+			},
+			pod:         preemptionPod("gold", "", "gold", 0),
+			wantStatus:  fwk.Unschedulable,
+			wantMessage: "No pods of a less important flavour on node node1",
+		},
+		{
+			name:  "pods protected from eviction kept",
+			nodes: []*v1.Node{preemptionNode("node1"), preemptionNode("node2")},
+			pods: []*v1.Pod{
+				doNotEvict(preemptionPod("bronze1", "node1", "bronze", 0)),
+				preemptionPod("silver1", "node1", "silver", 0),
+				doNotEvict(preemptionPod("bronze2", "node2", "bronze", 0)),
+				doNotEvict(preemptionPod("bronze3", "node2", "bronze", 0)),
+			},
+			pod:           preemptionPod("gold", "", "gold", 0),
+			wantNode:      "node1",
+			wantStatus:    fwk.Success,
+			wantPreempted: []string{"silver1"},
+		},
+		{
+			name:        "least important flavour",
+			nodes:       []*v1.Node{preemptionNode("node1")},
+			pods:        []*v1.Pod{preemptionPod("bronze1", "node1", "bronze", 0), preemptionPod("bronze2", "node1", "bronze", 0)},
+			pod:         preemptionPod("bronze", "", "bronze", 0),
+			wantStatus:  fwk.Unschedulable,
+			wantMessage: "not eligible as no flavour is less important than bronze",
+		},
+		{
+			name:        "pod without flavour",
+			nodes:       []*v1.Node{preemptionNode("node1")},
+			pods:        []*v1.Pod{preemptionPod("bronze1", "node1", "bronze", 0), preemptionPod("bronze2", "node1", "bronze", 0)},
+			pod:         preemptionPod("other", "", "", 0),
+			wantStatus:  fwk.Unschedulable,
+			wantMessage: `not eligible as flavour "" has no priority`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			objects := []runtime.Object{tt.pod}
+			for _, pod := range tt.pods {
+				objects = append(objects, pod)
+			}
+			client := clientsetfake.NewSimpleClientset(objects...)
+			informerFactory := informers.NewSharedInformerFactory(client, 0)
+			podInformer := informerFactory.Core().V1().Pods().Informer()
+			for _, obj := range objects {
+				podInformer.GetStore().Add(obj)
+			}
+			handle, err := tf.NewFramework(ctx,
+				[]tf.RegisterPluginFunc{
+					tf.RegisterQueueSortPlugin(queuesort.Name, queuesort.New),
+					tf.RegisterBindPlugin(defaultbinder.Name, defaultbinder.New),
+					tf.RegisterPluginAsExtensions(noderesources.Name, func(ctx context.Context, args runtime.Object, h framework.Handle) (framework.Plugin, error) {
+						return noderesources.NewFit(ctx, args, h, plfeature.Features{})
+					}, "Filter", "PreFilter"),
+				},
+				"default-scheduler",
+				frameworkruntime.WithClientSet(client),
+				frameworkruntime.WithEventRecorder(&events.FakeRecorder{}),
+				frameworkruntime.WithInformerFactory(informerFactory),
+				frameworkruntime.WithPodNominator(testutil.NewPodNominator(informerFactory.Core().V1().Pods().Lister())),
+				frameworkruntime.WithSnapshotSharedLister(testutil.NewFakeSharedLister(tt.pods, tt.nodes)),
+				frameworkruntime.WithWaitingPods(frameworkruntime.NewWaitingPodsMap()),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f := newTestPlugin(tt.nodes, nil)
+			f.logger = logr.Discard()
+			f.handle = handle
+			f.flavourRanks = map[string]int{"gold": 0, "silver": 1, "bronze": 2}
+			f.preemption = preemption.NewEvaluator(f.Name(), handle, &flavourPreemptor{f: f}, false)
+
+			state := framework.NewCycleState()
+			if _, status, _ := handle.RunPreFilterPlugins(ctx, state, tt.pod); !status.IsSuccess() {
+				t.Fatalf("unexpected PreFilter status: %v", status)
+			}
+			filtered := framework.NewDefaultNodeToStatus()
+			for _, node := range tt.nodes {
+				filtered.Set(node.Name, fwk.NewStatus(fwk.Unschedulable))
+			}
+			result, status := f.PostFilter(ctx, state, tt.pod, filtered)
+			if status.Code() != tt.wantStatus {
+				t.Fatalf("expected status %v, got %v", tt.wantStatus, status)
+			}
+			if !strings.Contains(status.Message(), tt.wantMessage) {
+				t.Errorf("expected message %q, got %q", tt.wantMessage, status.Message())
+			}
+			if tt.wantNode != "" {
+				if result == nil || result.NominatedNodeName != tt.wantNode {
+					t.Errorf("expected the pod to be nominated to %s, got %+v", tt.wantNode, result)
+				}
+			}
+
+			pods, err := client.CoreV1().Pods("default").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			remaining := map[string]bool{}
+			for _, pod := range pods.Items {
+				remaining[pod.Name] = true
+			}
+			var preempted []string
+			for _, pod := range tt.pods {
+				if !remaining[pod.Name] {
+					preempted = append(preempted, pod.Name)
+				}
+			}
+			sort.Strings(preempted)
+			if diff := cmp.Diff(tt.wantPreempted, preempted); diff != "" {
+				t.Errorf("unexpected preempted pods (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewWithFlavourPrioritiesWithoutHandle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := NewWithOptions(ctx, &pluginConfig.FlavourClusterWideArgs{FlavourPriorities: []string{"gold", "silver"}}, nil,
+		WithClient(clientsetfake.NewSimpleClientset()),
+		WithLogger(logr.Discard()),
+	)
+	if err == nil || !strings.Contains(err.Error(), "flavourPriorities requires a framework handle") {
+		t.Errorf("expected an error without a framework handle, got %v", err)
+	}
+}
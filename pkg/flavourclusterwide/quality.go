@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+// PlacementQualityAnnotation is set on bound flavoured pods to the quality of their placement when
+// AnnotatePlacementQuality is enabled, see placementQuality.
+const PlacementQualityAnnotation = "scheduling.x-k8s.io/flavour-placement-quality"
+
+// placementQualityStateKey is the key in CycleState to the normalized scores of the cycle, see stateKey.
+const placementQualityStateKey = "PlacementQuality"
+
+// placementQualityState holds the normalized scores of the nodes of the cycle.
+type placementQualityState struct {
+	scores map[string]int64
+}
+
+// Clone the placement quality state. It is never modified after it is recorded, so the state itself is
+// returned.
+func (s *placementQualityState) Clone() fwk.StateData {
+	return s
+}
+
+// recordScores records the normalized scores of the cycle for placementQuality. Cycles in which every
+// node scores 0, such as in shadow mode, have no best node and are not recorded.
+func (f *FlavourClusterWide) recordScores(state fwk.CycleState, scores framework.NodeScoreList) {
+	if state == nil {
+		return
+	}
+	s := &placementQualityState{scores: make(map[string]int64, len(scores))}
+	var best int64
+	for _, score := range scores {
+		s.scores[score.Name] = score.Score
+		best = max(best, score.Score)
+	}
+	if best > 0 {
+		state.Write(f.stateKey(placementQualityStateKey), s)
+	}
+}
+
+// recordDecisions counts the normalized scores in the score decisions metric, by whether the node got
+// the maximum score, the minimum score or a score in between.
+func (f *FlavourClusterWide) recordDecisions(scores framework.NodeScoreList) {
+	for _, score := range scores {
+		decision := "between"
+		switch score.Score {
+		case framework.MaxNodeScore:
+			decision = "100"
+		case framework.MinNodeScore:
+			decision = "0"
+		}
+		scoreDecisions.WithLabelValues(f.Name(), decision).Inc()
+	}
+}
+
+// placementQuality returns the quality of the placement of the pod on the node it was bound to, its
+// normalized score: framework.MaxNodeScore on the best node of the cycle, and the lower the further from
+// it other plugins or the scheduler moved the pod. It returns false when the cycle recorded no scores,
+// or did not score the node.
+func (f *FlavourClusterWide) placementQuality(state fwk.CycleState, nodeName string) (int64, bool) {
+	score, ok := f.cycleScores(state)[nodeName]
+	return score, ok
+}
+
+// cycleScores returns the normalized scores recorded by recordScores, or nil when the cycle recorded none.
+func (f *FlavourClusterWide) cycleScores(state fwk.CycleState) map[string]int64 {
+	if state == nil {
+		return nil
+	}
+	c, err := state.Read(f.stateKey(placementQualityStateKey))
+	if err != nil {
+		return nil
+	}
+	s, ok := c.(*placementQualityState)
+	if !ok {
+		return nil
+	}
+	return s.scores
+}
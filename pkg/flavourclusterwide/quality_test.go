@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/component-base/metrics/testutil"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestPlacementQuality(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	tests := []struct {
+		name   string
+		scores framework.NodeScoreList
+		want   string
+	}{
+		{
+			// The scheduler bound the pod to node2, scored 20 against 50 for node1.
+			name:   "second best node",
+			scores: framework.NodeScoreList{{Name: "node1", Score: 50}, {Name: "node2", Score: 20}},
+			want:   "40",
+		},
+		{
+			name:   "best node",
+			scores: framework.NodeScoreList{{Name: "node1", Score: 20}, {Name: "node2", Score: 50}},
+			want:   "100",
+		},
+		{
+			// Without a best node, as in shadow mode, the placement has no quality.
+			name:   "unscored cycle",
+			scores: framework.NodeScoreList{{Name: "node1", Score: 0}, {Name: "node2", Score: 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := makePod("default", "p", "node2", flavoured("gold"))
+			client := clientsetfake.NewSimpleClientset(pod.DeepCopy())
+			f := newTestPlugin(nodes, map[string]map[string]int{"node1": {"gold": 0}, "node2": {"gold": 0}})
+			f.client = client
+			f.annotatePlacementQuality = true
+
+			observations := func() uint64 {
+				t.Helper()
+				count, err := testutil.GetHistogramMetricCount(placementQualities.WithLabelValues(Name))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return count
+			}
+			before := observations()
+
+			state := framework.NewCycleState()
+			if status := f.NormalizeScore(context.Background(), state, pod, tt.scores); !status.IsSuccess() {
+				t.Fatalf("unexpected status: %v", status)
+			}
+			f.PostBind(context.Background(), state, pod, "node2")
+
+			got, err := client.CoreV1().Pods("default").Get(context.Background(), "p", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if quality := got.Annotations[PlacementQualityAnnotation]; quality != tt.want {
+				t.Errorf("expected placement quality %q, got %q", tt.want, quality)
+			}
+			wantObservations := before
+			if tt.want != "" {
+				wantObservations++
+			}
+			if after := observations(); after != wantObservations {
+				t.Errorf("expected %d placement quality observations, got %d", wantObservations, after)
+			}
+		})
+	}
+}
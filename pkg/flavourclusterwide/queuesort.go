@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
+	fwk "k8s.io/kube-scheduler/framework"
+)
+
+// Less is the function used by the activeQ heap algorithm to sort pods, when the plugin replaces
+// PrioritySort at the QueueSort extension point. It sorts pods by priority. Within the same priority,
+// the pods of scarcer flavours, with fewer balanced slots, come first so that they get the least
+// loaded nodes before the pods of other flavours fill them up. Pods without the flavour label come
+// after the flavoured ones. Ties are broken by the time the pods were added to the queue.
+func (f *FlavourClusterWide) Less(podInfo1, podInfo2 fwk.QueuedPodInfo) bool {
+	pod1 := podInfo1.GetPodInfo().GetPod()
+	pod2 := podInfo2.GetPodInfo().GetPod()
+	prio1 := corev1helpers.PodPriority(pod1)
+	prio2 := corev1helpers.PodPriority(pod2)
+	if prio1 != prio2 {
+		return prio1 > prio2
+	}
+	slots1, slots2 := f.slots(pod1), f.slots(pod2)
+	if slots1 != slots2 {
+		return slots1 < slots2
+	}
+	return podInfo1.GetTimestamp().Before(podInfo2.GetTimestamp())
+}
+
+// slots returns the balanced slots of the pod's flavour as of the last cache rebuild, the number of
+// nodes at the flavour's minimum count, or math.MaxInt for a pod without the flavour label. A flavour
+// without pods is balanced on every node.
+func (f *FlavourClusterWide) slots(pod *v1.Pod) int {
+	flavour := f.flavourOf(pod)
+	if flavour == "" {
+		return math.MaxInt
+	}
+
+	f.cacheMutex.RLock()
+	defer f.cacheMutex.RUnlock()
+	if slots, ok := f.balancedSlots[flavour]; ok {
+		return slots
+	}
+	return len(f.cache)
+}
+
+// countBalancedSlots returns the number of nodes at the minimum count of every flavour of the cache.
+// It is only computed on cache rebuilds rather than on every bind, so that the order of the pods
+// waiting in the queue does not change under the heap between two rebuilds.
+func countBalancedSlots(cache map[string]map[string]int) map[string]int {
+	minimums := make(map[string]int)
+	for _, nodeCounts := range cache {
+		for flavour, count := range nodeCounts {
+			if lowest, ok := minimums[flavour]; !ok || count < lowest {
+				minimums[flavour] = count
+			}
+		}
+	}
+	slots := make(map[string]int, len(minimums))
+	for _, nodeCounts := range cache {
+		for flavour, count := range nodeCounts {
+			if count == minimums[flavour] {
+				slots[flavour]++
+			}
+		}
+	}
+	return slots
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestCountBalancedSlots(t *testing.T) {
+	cache := map[string]map[string]int{
+		"node1": {"gold": 1, "silver": 0},
+		"node2": {"gold": 1, "silver": 2},
+		"node3": {"gold": 2, "silver": 2},
+	}
+	want := map[string]int{"gold": 2, "silver": 1}
+	if diff := cmp.Diff(want, countBalancedSlots(cache)); diff != "" {
+		t.Errorf("unexpected slots (-want,+got):\n%s", diff)
+	}
+}
+
+func TestLess(t *testing.T) {
+	earlier := time.Now()
+	later := earlier.Add(time.Second)
+	queued := func(flavour string, priority int32, timestamp time.Time) *framework.QueuedPodInfo {
+		labels := map[string]string{}
+		if flavour != "" {
+			labels = flavoured(flavour)
+		}
+		pod := makePod("default", "p", "", labels)
+		pod.Spec.Priority = &priority
+		podInfo, _ := framework.NewPodInfo(pod)
+		return &framework.QueuedPodInfo{PodInfo: podInfo, Timestamp: timestamp}
+	}
+	tests := []struct {
+		name       string
+		pod1, pod2 *framework.QueuedPodInfo
+		wantLess   bool
+	}{
+		{
+			name:     "higher priority first",
+			pod1:     queued("gold", 10, later),
+			pod2:     queued("silver", 0, earlier),
+			wantLess: true,
+		},
+		{
+			name:     "scarcer flavour first within the same priority",
+			pod1:     queued("silver", 0, later),
+			pod2:     queued("gold", 0, earlier),
+			wantLess: true,
+		},
+		{
+			// A new flavour is balanced on all 3 nodes, as many slots as bronze.
+			name:     "same slots in queue order",
+			pod1:     queued("bronze", 0, earlier),
+			pod2:     queued("copper", 0, later),
+			wantLess: true,
+		},
+		{
+			name:     "flavoured pods before the others",
+			pod1:     queued("bronze", 0, later),
+			pod2:     queued("", 0, earlier),
+			wantLess: true,
+		},
+		{
+			name:     "pods without flavour in queue order",
+			pod1:     queued("", 0, earlier),
+			pod2:     queued("", 0, later),
+			wantLess: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin([]*v1.Node{}, map[string]map[string]int{
+				"node1": {"gold": 1, "silver": 0, "bronze": 0},
+				"node2": {"gold": 1, "silver": 2, "bronze": 0},
+				"node3": {"gold": 2, "silver": 2, "bronze": 0},
+			})
+			f.balancedSlots = countBalancedSlots(f.cache)
+			if got := f.Less(tt.pod1, tt.pod2); got != tt.wantLess {
+				t.Errorf("expected Less to be %v, got %v", tt.wantLess, got)
+			}
+			if got := f.Less(tt.pod2, tt.pod1); got == tt.wantLess {
+				t.Errorf("expected the reverse Less to be %v, got %v", !tt.wantLess, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,371 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	resourcehelper "k8s.io/component-helpers/resource"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned"
+	"sigs.k8s.io/scheduler-plugins/pkg/generated/informers/externalversions"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+var _ = framework.PermitPlugin(&FlavourClusterWide{})
+
+// flavourQuotaResource is the resource of the FlavourQuota events, which may let the pods of the flavour
+// of the quota in.
+const flavourQuotaResource = fwk.EventResource("flavourquotas.v1alpha1.scheduling.x-k8s.io")
+
+// quotaUsage is what the pods of a flavour take from its quotas.
+type quotaUsage struct {
+	pods     int64
+	requests v1.ResourceList
+}
+
+func (u *quotaUsage) add(requests v1.ResourceList) {
+	u.pods++
+	for name, quantity := range requests {
+		used := u.requests[name]
+		used.Add(quantity)
+		u.requests[name] = used
+	}
+}
+
+// exceeds returns true when the pod with the requests would take the usage above the quota.
+func (u *quotaUsage) exceeds(quota v1alpha1.FlavourQuotaSpec, requests v1.ResourceList) bool {
+	if quota.MaxPods != nil && u.pods+1 > int64(*quota.MaxPods) {
+		return true
+	}
+	for name, max := range quota.Max {
+		used := u.requests[name]
+		used.Add(requests[name])
+		if used.Cmp(max) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// quotaCharge is a pod allowed by Permit, charged to the quotas of its flavour until the pod informer
+// sees it bound. The charge of a member of a pod group is reserved until the pod group is admitted, see
+// admitGang.
+type quotaCharge struct {
+	namespace string
+	name      string
+	flavour   string
+	requests  v1.ResourceList
+	// gang is the full name of the pod group the pod was admitted with, if any.
+	gang     string
+	reserved bool
+}
+
+// startFlavourQuotas starts the informer of the FlavourQuota objects enforced by Permit, from the
+// informer factory of the scheduling.x-k8s.io clientset, created from the configuration of the scheduler
+// unless one is given, and registers the event handlers letting the waiting pods in once the quotas allow
+// them, see allowWaitingPods.
+func (f *FlavourClusterWide) startFlavourQuotas(ctx context.Context, factory informers.SharedInformerFactory, quotaFactory externalversions.SharedInformerFactory, wait time.Duration) error {
+	if quotaFactory == nil {
+		client, err := f.schedulingClient()
+		if err != nil {
+			return err
+		}
+		quotaFactory = externalversions.NewSharedInformerFactory(client, 0)
+	}
+	quotaInformer := quotaFactory.Scheduling().V1alpha1().FlavourQuotas()
+	podGroupInformer := quotaFactory.Scheduling().V1alpha1().PodGroups()
+	if _, err := quotaInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(any) { f.allowWaitingPods() },
+		UpdateFunc: func(_, _ any) { f.allowWaitingPods() },
+		DeleteFunc: func(any) { f.allowWaitingPods() },
+	}); err != nil {
+		return err
+	}
+	if _, err := factory.Core().V1().Pods().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj any) {
+			original, ok := oldObj.(*v1.Pod)
+			if !ok {
+				return
+			}
+			if modified, ok := newObj.(*v1.Pod); ok {
+				flavour := f.flavourOf(original)
+				if f.chargesQuota(original, flavour) && !f.chargesQuota(modified, flavour) {
+					f.allowWaitingPods()
+				}
+			}
+		},
+		DeleteFunc: func(any) { f.allowWaitingPods() },
+	}); err != nil {
+		return err
+	}
+	f.quotaLister = quotaInformer.Lister()
+	f.quotaSynced = func() bool {
+		return quotaInformer.Informer().HasSynced() && podGroupInformer.Informer().HasSynced()
+	}
+	f.podGroupLister = podGroupInformer.Lister()
+	f.quotaWait = wait
+	f.permitted = make(map[types.UID]quotaCharge)
+	quotaFactory.Start(ctx.Done())
+	return nil
+}
+
+// schedulingClient returns a scheduling.x-k8s.io clientset for the configuration of the scheduler.
+func (f *FlavourClusterWide) schedulingClient() (versioned.Interface, error) {
+	if f.handle == nil {
+		return nil, fmt.Errorf("no scheduler configuration to create the scheduling.x-k8s.io client with")
+	}
+	return versioned.NewForConfig(f.handle.KubeConfig())
+}
+
+// Permit holds the pods that would take their flavour above one of its FlavourQuota objects, when
+// enforceFlavourQuotas is set. The pods within the quotas are charged to them right away, so that the
+// pods allowed before their bind shows in the informer count. The others wait for up to quotaWaitSeconds,
+// and are allowed as soon as the pods of their flavour leave or the quota is raised, see
+// allowWaitingPods, or rejected right away without a wait. The members of a pod group are admitted all
+// together, see admitGang.
+func (f *FlavourClusterWide) Permit(ctx context.Context, state fwk.CycleState, pod *v1.Pod, nodeName string) (*fwk.Status, time.Duration) {
+	if f.quotaLister == nil {
+		return nil, 0
+	}
+	flavour := f.flavourOf(pod)
+	if flavour == "" && util.GetPodGroupLabel(pod) == "" {
+		return nil, 0
+	}
+	if !f.quotaSynced() {
+		return fwk.NewStatus(fwk.Unschedulable, "flavour quotas are not synced yet"), 0
+	}
+	logger := klog.FromContext(klog.NewContext(ctx, f.logger)).WithValues("ExtensionPoint", "Permit")
+	waiting := f.waitingPods()
+
+	f.quotaMutex.Lock()
+	defer f.quotaMutex.Unlock()
+	gang, pg, err := f.gangOf(pod)
+	if err != nil {
+		return fwk.AsStatus(err), 0
+	}
+	if flavour == "" && gang == "" {
+		return nil, 0
+	}
+	quota, err := f.admitToQuota(pod, flavour, gang)
+	if err != nil {
+		return fwk.AsStatus(err), 0
+	}
+	if quota == "" {
+		if gang == "" || f.admitGang(gang, pg, waiting) {
+			return nil, 0
+		}
+		logger.V(4).Info("Pod waiting for the other members of its pod group", "pod", klog.KObj(pod), "node", nodeName, "flavour", flavour, "podGroup", gang)
+		return fwk.NewStatus(fwk.Wait, fmt.Sprintf("waiting for the other members of pod group %s to fit in the flavour quotas", gang)),
+			util.GetWaitTimeDuration(pg, &f.quotaWait)
+	}
+	msg := fmt.Sprintf("pod would exceed FlavourQuota %s of flavour %s", quota, flavour)
+	if f.quotaWait == 0 {
+		return fwk.NewStatus(fwk.Unschedulable, msg), 0
+	}
+	logger.V(4).Info("Pod waiting for the quota of its flavour", "pod", klog.KObj(pod), "node", nodeName, "flavour", flavour, "quota", quota)
+	return fwk.NewStatus(fwk.Wait, msg), f.quotaWait
+}
+
+// waitingPods returns the pods waiting in Permit for the plugin.
+func (f *FlavourClusterWide) waitingPods() []framework.WaitingPod {
+	if f.handle == nil {
+		return nil
+	}
+	var waiting []framework.WaitingPod
+	f.handle.IterateOverWaitingPods(func(waitingPod framework.WaitingPod) {
+		if slices.Contains(waitingPod.GetPendingPlugins(), f.Name()) {
+			waiting = append(waiting, waitingPod)
+		}
+	})
+	return waiting
+}
+
+// allowWaitingPods allows the pods waiting in Permit that the quotas of their flavour now let in, the
+// oldest first. The members of a pod group only take a reservation until the pod group is admitted.
+func (f *FlavourClusterWide) allowWaitingPods() {
+	if f.handle == nil || f.quotaLister == nil || !f.quotaSynced() {
+		return
+	}
+	waiting := f.waitingPods()
+	slices.SortFunc(waiting, func(a, b framework.WaitingPod) int {
+		if c := a.GetPod().CreationTimestamp.Compare(b.GetPod().CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetPod().Name, b.GetPod().Name)
+	})
+
+	f.quotaMutex.Lock()
+	defer f.quotaMutex.Unlock()
+	for _, waitingPod := range waiting {
+		pod := waitingPod.GetPod()
+		if f.permitted[pod.UID].reserved {
+			// It already fits, and waits for the other members of its pod group.
+			continue
+		}
+		flavour := f.flavourOf(pod)
+		gang, pg, err := f.gangOf(pod)
+		if err != nil {
+			f.logger.Error(err, "Error checking the pod groups of the waiting pods")
+			return
+		}
+		quota, err := f.admitToQuota(pod, flavour, gang)
+		if err != nil {
+			f.logger.Error(err, "Error checking the quotas of the waiting pods")
+			return
+		}
+		if quota != "" {
+			continue
+		}
+		if gang == "" {
+			f.logger.V(4).Info("Pod allowed by the quota of its flavour", "pod", klog.KObj(pod), "flavour", flavour)
+			waitingPod.Allow(f.Name())
+		} else if f.admitGang(gang, pg, waiting) {
+			f.logger.V(4).Info("Pod group allowed by the quotas of its flavours", "podGroup", gang)
+		}
+	}
+}
+
+// admitToQuota charges the pod to the quotas of its flavour and returns "" when it is within all of them,
+// and returns the name of the first quota it would exceed otherwise. The charge of a member of the pod
+// group gang is a reservation, taken even when no quota applies so that the member counts towards
+// admitGang. The quota mutex must be held by the caller.
+func (f *FlavourClusterWide) admitToQuota(pod *v1.Pod, flavour, gang string) (string, error) {
+	// A pod is charged once, whether it is checked again or not.
+	delete(f.permitted, pod.UID)
+	quotas, err := f.quotaLister.List(labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	slices.SortFunc(quotas, func(a, b *v1alpha1.FlavourQuota) int { return strings.Compare(a.Name, b.Name) })
+	requests := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+	var usage *quotaUsage
+	for _, quota := range quotas {
+		if quota.Spec.Flavour != flavour {
+			continue
+		}
+		if usage == nil {
+			if usage, err = f.quotaUsage(flavour); err != nil {
+				return "", err
+			}
+		}
+		if usage.exceeds(quota.Spec, requests) {
+			return quota.Name, nil
+		}
+	}
+	if usage != nil || gang != "" {
+		f.permitted[pod.UID] = quotaCharge{namespace: pod.Namespace, name: pod.Name, flavour: flavour, requests: requests,
+			gang: gang, reserved: gang != ""}
+	}
+	return "", nil
+}
+
+// quotaUsage returns what the pods of the flavour take from its quotas: the bound pods of the informer
+// and the pods allowed by Permit it does not show bound yet. The charges of the pods the informer shows
+// bound or no longer has are dropped. The quota mutex must be held by the caller.
+func (f *FlavourClusterWide) quotaUsage(flavour string) (*quotaUsage, error) {
+	pods, err := f.podLister.List(labels.SelectorFromSet(labels.Set{f.labelName(): flavour}))
+	if err != nil {
+		return nil, err
+	}
+	usage := &quotaUsage{requests: v1.ResourceList{}}
+	bound := sets.New[types.UID]()
+	for _, pod := range pods {
+		if f.chargesQuota(pod, flavour) {
+			bound.Insert(pod.UID)
+			usage.add(resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{}))
+		}
+	}
+	for uid, charge := range f.permitted {
+		if charge.flavour != flavour {
+			continue
+		}
+		if bound.Has(uid) {
+			delete(f.permitted, uid)
+			continue
+		}
+		if f.staleCharge(uid, charge) {
+			delete(f.permitted, uid)
+			continue
+		}
+		usage.add(charge.requests)
+	}
+	return usage, nil
+}
+
+// staleCharge returns true when the informer no longer has the pod of the charge, has another pod of its
+// name, or shows it terminated.
+func (f *FlavourClusterWide) staleCharge(uid types.UID, charge quotaCharge) bool {
+	pod, err := f.podLister.Pods(charge.namespace).Get(charge.name)
+	return apierrors.IsNotFound(err) || err == nil && (pod.UID != uid || !isActivePod(pod, terminalPodPhases))
+}
+
+// terminalPodPhases are the phases of the pods that no longer take from the quotas of their flavour.
+var terminalPodPhases = []v1.PodPhase{v1.PodSucceeded, v1.PodFailed}
+
+// chargesQuota returns true when the pod is bound and takes from the quotas of the flavour: it counts on
+// its node and has not terminated.
+func (f *FlavourClusterWide) chargesQuota(pod *v1.Pod, flavour string) bool {
+	return flavour != "" && f.countsOnNode(pod, flavour) && isActivePod(pod, terminalPodPhases)
+}
+
+// releaseQuota drops the charge of the pod allowed by Permit whose binding failed, and lets in the pods
+// waiting for the quota it took. When the pod is a member of a pod group not admitted yet, because it
+// timed out or was rejected, the reservations of the whole pod group are released and its other members
+// are rejected, so that a pod group admitted in part does not hold the quotas.
+func (f *FlavourClusterWide) releaseQuota(pod *v1.Pod) {
+	if f.quotaLister == nil {
+		return
+	}
+	f.quotaMutex.Lock()
+	charge, charged := f.permitted[pod.UID]
+	delete(f.permitted, pod.UID)
+	var gang string
+	if !charged || charge.reserved {
+		gang = util.GetPodGroupFullName(pod)
+	}
+	released := gang != "" && f.releaseGang(gang)
+	f.quotaMutex.Unlock()
+
+	if released {
+		for _, waitingPod := range f.waitingPods() {
+			member := waitingPod.GetPod()
+			if member.UID != pod.UID && util.GetPodGroupFullName(member) == gang {
+				f.logger.V(4).Info("Rejecting the member of a pod group not admitted to the flavour quotas", "pod", klog.KObj(member), "podGroup", gang)
+				waitingPod.Reject(f.Name(), fmt.Sprintf("pod group %s was not admitted to the flavour quotas", gang))
+			}
+		}
+	}
+	if charged || released {
+		f.allowWaitingPods()
+	}
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/utils/ptr"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	schedfake "sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned/fake"
+	"sigs.k8s.io/scheduler-plugins/pkg/generated/informers/externalversions"
+	schedlisters "sigs.k8s.io/scheduler-plugins/pkg/generated/listers/scheduling/v1alpha1"
+	testutil "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+func makeQuota(name, flavour string, maxPods *int32, max v1.ResourceList) *v1alpha1.FlavourQuota {
+	return &v1alpha1.FlavourQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v1alpha1.FlavourQuotaSpec{Flavour: flavour, MaxPods: maxPods, Max: max},
+	}
+}
+
+// waitingPod is a pod waiting in Permit for the plugin.
+type waitingPod struct {
+	pod      *v1.Pod
+	allowed  bool
+	rejected bool
+}
+
+func (w *waitingPod) GetPod() *v1.Pod { return w.pod }
+
+func (w *waitingPod) GetPendingPlugins() []string {
+	if w.allowed || w.rejected {
+		return nil
+	}
+	return []string{Name}
+}
+
+func (w *waitingPod) Allow(string) { w.allowed = true }
+
+func (w *waitingPod) Reject(string, string) { w.rejected = true }
+
+// waitingPodsHandle is a fakeHandle with the pods waiting in Permit.
+type waitingPodsHandle struct {
+	*fakeHandle
+	waiting []*waitingPod
+}
+
+func (h *waitingPodsHandle) IterateOverWaitingPods(callback func(framework.WaitingPod)) {
+	for _, w := range h.waiting {
+		callback(w)
+	}
+}
+
+// newQuotaPlugin returns a test plugin enforcing the quotas on the pods, whose informers are already
+// synced.
+func newQuotaPlugin(quotas []*v1alpha1.FlavourQuota, pods []*v1.Pod, wait time.Duration) (*FlavourClusterWide, cache.Indexer) {
+	f := newTestPlugin(nil, nil)
+	f.logger = logr.Discard()
+	f.handle = &waitingPodsHandle{fakeHandle: f.handle.(*fakeHandle)}
+	podIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, pod := range pods {
+		podIndexer.Add(pod)
+	}
+	quotaIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, quota := range quotas {
+		quotaIndexer.Add(quota)
+	}
+	f.podLister = corelisters.NewPodLister(podIndexer)
+	f.quotaLister = schedlisters.NewFlavourQuotaLister(quotaIndexer)
+	f.quotaSynced = func() bool { return true }
+	f.quotaWait = wait
+	f.permitted = make(map[types.UID]quotaCharge)
+	return f, podIndexer
+}
+
+func TestPermit(t *testing.T) {
+	completed := uidPod("completed", "node1", "gold")
+	completed.Status.Phase = v1.PodSucceeded
+	tests := []struct {
+		name     string
+		quotas   []*v1alpha1.FlavourQuota
+		pods     []*v1.Pod
+		wait     time.Duration
+		pod      *v1.Pod
+		want     fwk.Code
+		wantWait time.Duration
+		wantMsg  string
+	}{
+		{
+			name:   "pod without flavour",
+			quotas: []*v1alpha1.FlavourQuota{makeQuota("gold", "gold", ptr.To[int32](0), nil)},
+			pod:    makePod("default", "p", "", nil),
+			want:   fwk.Success,
+		},
+		{
+			name:   "flavour without quota",
+			quotas: []*v1alpha1.FlavourQuota{makeQuota("silver", "silver", ptr.To[int32](0), nil)},
+			pod:    uidPod("p", "", "gold"),
+			want:   fwk.Success,
+		},
+		{
+			name:   "within the pods of the quota",
+			quotas: []*v1alpha1.FlavourQuota{makeQuota("gold", "gold", ptr.To[int32](2), nil)},
+			pods:   []*v1.Pod{uidPod("p1", "node1", "gold"), uidPod("p2", "", "gold")},
+			pod:    uidPod("p", "", "gold"),
+			want:   fwk.Success,
+		},
+		{
+			name:     "above the pods of the quota",
+			quotas:   []*v1alpha1.FlavourQuota{makeQuota("gold", "gold", ptr.To[int32](2), nil)},
+			pods:     []*v1.Pod{uidPod("p1", "node1", "gold"), uidPod("p2", "node2", "gold")},
+			wait:     time.Minute,
+			pod:      uidPod("p", "", "gold"),
+			want:     fwk.Wait,
+			wantWait: time.Minute,
+			wantMsg:  "pod would exceed FlavourQuota gold of flavour gold",
+		},
+		{
+			name:   "completed pods are not charged",
+			quotas: []*v1alpha1.FlavourQuota{makeQuota("gold", "gold", ptr.To[int32](1), nil)},
+			pods:   []*v1.Pod{completed},
+			pod:    uidPod("p", "", "gold"),
+			want:   fwk.Success,
+		},
+		{
+			name: "above the resources of a quota, without a wait",
+			quotas: []*v1alpha1.FlavourQuota{
+				makeQuota("gold-pods", "gold", ptr.To[int32](10), nil),
+				makeQuota("gold-cpu", "gold", nil, v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")}),
+			},
+			pods:    []*v1.Pod{requesting(uidPod("p1", "node1", "gold"), "1500m", "1Gi")},
+			pod:     requesting(uidPod("p", "", "gold"), "1", "1Gi"),
+			want:    fwk.Unschedulable,
+			wantMsg: "pod would exceed FlavourQuota gold-cpu of flavour gold",
+		},
+		{
+			name:   "within the resources of the quota",
+			quotas: []*v1alpha1.FlavourQuota{makeQuota("gold", "gold", nil, v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")})},
+			pods:   []*v1.Pod{requesting(uidPod("p1", "node1", "gold"), "1", "1Gi")},
+			pod:    requesting(uidPod("p", "", "gold"), "1", "1Gi"),
+			want:   fwk.Success,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _ := newQuotaPlugin(tt.quotas, tt.pods, tt.wait)
+			status, wait := f.Permit(context.Background(), framework.NewCycleState(), tt.pod, "node1")
+			if status.Code() != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, status)
+			}
+			if wait != tt.wantWait {
+				t.Errorf("expected to wait %v, got %v", tt.wantWait, wait)
+			}
+			if tt.wantMsg != "" && status.Message() != tt.wantMsg {
+				t.Errorf("expected message %q, got %q", tt.wantMsg, status.Message())
+			}
+		})
+	}
+}
+
+func TestPermitChargesAllowedPods(t *testing.T) {
+	f, podIndexer := newQuotaPlugin([]*v1alpha1.FlavourQuota{makeQuota("gold", "gold", ptr.To[int32](2), nil)},
+		[]*v1.Pod{uidPod("p1", "node1", "gold")}, time.Minute)
+	p2, p3 := uidPod("p2", "", "gold"), uidPod("p3", "", "gold")
+	podIndexer.Add(p2)
+	podIndexer.Add(p3)
+
+	// p2 is charged before its bind shows in the informer, which leaves no room for p3.
+	if status, _ := f.Permit(context.Background(), framework.NewCycleState(), p2, "node1"); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	if status, _ := f.Permit(context.Background(), framework.NewCycleState(), p3, "node1"); status.Code() != fwk.Wait {
+		t.Fatalf("expected p3 to wait, got %v", status)
+	}
+	// Once the informer shows p2 bound, it is charged once.
+	bound := p2.DeepCopy()
+	bound.Spec.NodeName = "node1"
+	podIndexer.Update(bound)
+	if status, _ := f.Permit(context.Background(), framework.NewCycleState(), p3, "node1"); status.Code() != fwk.Wait {
+		t.Fatalf("expected p3 to wait, got %v", status)
+	}
+	if _, charged := f.permitted[p2.UID]; charged {
+		t.Errorf("expected the charge of the bound pod to be dropped")
+	}
+}
+
+func TestAllowWaitingPods(t *testing.T) {
+	p1 := uidPod("p1", "node1", "gold")
+	f, podIndexer := newQuotaPlugin([]*v1alpha1.FlavourQuota{
+		makeQuota("gold", "gold", ptr.To[int32](1), nil),
+		makeQuota("silver", "silver", ptr.To[int32](0), nil),
+	}, []*v1.Pod{p1}, time.Minute)
+	now := time.Now()
+	older, newer := uidPod("older", "", "gold"), uidPod("newer", "", "gold")
+	older.CreationTimestamp = metav1.NewTime(now.Add(-time.Minute))
+	newer.CreationTimestamp = metav1.NewTime(now)
+	silver := uidPod("silver", "", "silver")
+	handle := f.handle.(*waitingPodsHandle)
+	handle.waiting = []*waitingPod{{pod: newer}, {pod: older}, {pod: silver}}
+	for _, w := range handle.waiting {
+		podIndexer.Add(w.pod)
+	}
+
+	// The quota is still taken by p1.
+	f.allowWaitingPods()
+	if allowed := allowedPods(handle); len(allowed) != 0 {
+		t.Fatalf("expected no pod to be allowed, got %v", allowed)
+	}
+
+	// p1 terminates, which lets in the oldest waiting pod only.
+	podIndexer.Delete(p1)
+	f.allowWaitingPods()
+	if allowed := allowedPods(handle); len(allowed) != 1 || allowed[0] != "older" {
+		t.Fatalf("expected the older pod to be allowed, got %v", allowed)
+	}
+
+	// The binding of the older pod fails, which lets in the newer one.
+	f.Unreserve(context.Background(), framework.NewCycleState(), older, "node1")
+	if allowed := allowedPods(handle); len(allowed) != 2 {
+		t.Fatalf("expected the newer pod to be allowed, got %v", allowed)
+	}
+}
+
+func allowedPods(handle *waitingPodsHandle) []string {
+	var allowed []string
+	for _, w := range handle.waiting {
+		if w.allowed {
+			allowed = append(allowed, w.pod.Name)
+		}
+	}
+	return allowed
+}
+
+func TestNewWithFlavourQuotas(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1")}
+	client := clientsetfake.NewSimpleClientset(nodes[0], uidPod("p1", "node1", "gold"))
+	quotaClient := schedfake.NewSimpleClientset(makeQuota("gold", "gold", ptr.To[int32](1), nil))
+	factory := informers.NewSharedInformerFactory(client, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f, err := NewWithOptions(ctx, &cfgv1.FlavourClusterWideArgs{EnforceFlavourQuotas: ptr.To(true), QuotaWaitSeconds: ptr.To[int32](0)},
+		&waitingPodsHandle{fakeHandle: &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)}},
+		WithClient(client),
+		WithInformerFactory(factory),
+		WithQuotaInformerFactory(externalversions.NewSharedInformerFactory(quotaClient, 0)),
+		WithLogger(logr.Discard()),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), f.quotaSynced) {
+		t.Fatalf("the quota informer did not sync")
+	}
+
+	status, _ := f.Permit(ctx, framework.NewCycleState(), uidPod("p2", "", "gold"), "node1")
+	if status.Code() != fwk.Unschedulable {
+		t.Errorf("expected the pod above the quota to be rejected, got %v", status)
+	}
+}
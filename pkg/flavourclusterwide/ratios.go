@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"math"
+
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+// ratioScore scores a node by how close the mix of the flavours of ratios on it would be to their
+// target proportions once a pod of the flavour is placed there: framework.MaxNodeScore for the exact
+// target, less the distance between the mix and the target, half the sum of the absolute differences of
+// their proportions, scaled to the score range.
+func ratioScore(ratios map[string]int32, counts map[string]int, flavour string) int64 {
+	var ratioTotal int32
+	total := 1
+	for listed, ratio := range ratios {
+		ratioTotal += ratio
+		total += counts[listed]
+	}
+
+	var distance float64
+	for listed, ratio := range ratios {
+		count := counts[listed]
+		if listed == flavour {
+			count++
+		}
+		distance += math.Abs(float64(count)/float64(total) - float64(ratio)/float64(ratioTotal))
+	}
+	return int64(math.Round(float64(framework.MaxNodeScore) * (1 - distance/2)))
+}
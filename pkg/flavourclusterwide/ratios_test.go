@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestScoreTargetRatios(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	cache := map[string]map[string]int{
+		"node1": {"gold": 1, "silver": 2, "bronze": 3, "copper": 1},
+		"node2": {"gold": 0, "silver": 0, "bronze": 0, "copper": 0},
+		"node3": {"gold": 2, "silver": 0, "bronze": 0, "copper": 2},
+	}
+	tests := []struct {
+		name    string
+		flavour string
+		want    map[string]int64
+	}{
+		{
+			// A bronze pod completes the 1:2:4 mix of node1, and is alone on node2.
+			name:    "flavour with a ratio",
+			flavour: "bronze",
+			want:    map[string]int64{"node1": 100, "node2": 57, "node3": 48},
+		},
+		{
+			name:    "flavour without a ratio",
+			flavour: "copper",
+			want:    map[string]int64{"node1": 0, "node2": 100, "node3": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.targetRatios = map[string]int32{"gold": 1, "silver": 2, "bronze": 4}
+			got := scoreNodes(t, f, makePod("default", "p", "", flavoured(tt.flavour)))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
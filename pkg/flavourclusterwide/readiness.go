@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// passesReadinessGate returns true if the node matches the readiness selector, every readiness
+// condition of the node is True, and the node is not being updated.
+func (f *FlavourClusterWide) passesReadinessGate(node *v1.Node) bool {
+	if f.isUpdating(node) {
+		return false
+	}
+	if f.readinessSelector != nil && !f.readinessSelector.Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	for _, conditionType := range f.readinessConditions {
+		ready := false
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == conditionType {
+				ready = condition.Status == v1.ConditionTrue
+				break
+			}
+		}
+		if !ready {
+			return false
+		}
+	}
+	return true
+}
+
+// gateNodes returns the nodes passing the readiness gate, and the pods not bound to the gated nodes.
+// Dropping their pods keeps gated nodes out of the snapshot altogether, as BuildSnapshot adds an entry
+// for every node with pods.
+func (f *FlavourClusterWide) gateNodes(nodes []v1.Node, pods []v1.Pod) ([]v1.Node, []v1.Pod) {
+	if f.readinessSelector == nil && len(f.readinessConditions) == 0 && !f.gatesUpdates() {
+		return nodes, pods
+	}
+
+	gated := make(map[string]bool)
+	ready := make([]v1.Node, 0, len(nodes))
+	for i := range nodes {
+		if f.passesReadinessGate(&nodes[i]) {
+			ready = append(ready, nodes[i])
+		} else {
+			gated[nodes[i].Name] = true
+		}
+	}
+	if len(gated) == 0 {
+		return nodes, pods
+	}
+
+	kept := make([]v1.Pod, 0, len(pods))
+	for i := range pods {
+		if !gated[pods[i].Spec.NodeName] {
+			kept = append(kept, pods[i])
+		}
+	}
+	return ready, kept
+}
+
+// gatedNodeNames returns the names of the listed nodes that did not pass the readiness gate, so that
+// Score tells them apart from the nodes missing from the cache.
+func gatedNodeNames(listed, ready []v1.Node) sets.Set[string] {
+	gated := sets.New[string]()
+	for i := range listed {
+		gated.Insert(listed[i].Name)
+	}
+	for i := range ready {
+		gated.Delete(ready[i].Name)
+	}
+	return gated
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	testutil "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+func TestScoreReadinessGate(t *testing.T) {
+	// node1 is fully provisioned, node2 was just added: it is Ready but its GPU drivers are not
+	// installed yet and its network is not ready. node2 is also being updated.
+	node1 := makeNode("node1", map[string]string{WorkerNodeLabelSelector: "", "gpu-driver-ready": "true"})
+	node1.Status.Conditions = []v1.NodeCondition{{Type: "NetworkReady", Status: v1.ConditionTrue}}
+	node2 := makeNode("node2", map[string]string{WorkerNodeLabelSelector: ""})
+	node2.Annotations = map[string]string{"machineconfiguration.openshift.io/state": "Working"}
+	node2.Status.Conditions = []v1.NodeCondition{
+		{Type: "NetworkReady", Status: v1.ConditionFalse},
+		{Type: "OSUpdateInProgress", Status: v1.ConditionTrue},
+	}
+	pods := []*v1.Pod{
+		makePod("default", "p1", "node1", flavoured("gold")),
+		makePod("default", "p2", "node1", flavoured("gold")),
+		makePod("default", "p3", "node2", flavoured("silver")),
+	}
+
+	tests := []struct {
+		name string
+		args *pluginConfig.FlavourClusterWideArgs
+		want map[string]int64
+	}{
+		{
+			name: "no gate",
+			args: &pluginConfig.FlavourClusterWideArgs{},
+			want: map[string]int64{"node1": 0, "node2": 100},
+		},
+		{
+			name: "readiness selector",
+			args: &pluginConfig.FlavourClusterWideArgs{NodeReadinessSelector: "gpu-driver-ready=true"},
+			want: map[string]int64{"node1": 100, "node2": 0},
+		},
+		{
+			name: "readiness condition",
+			args: &pluginConfig.FlavourClusterWideArgs{NodeReadinessConditions: []string{"NetworkReady"}},
+			want: map[string]int64{"node1": 100, "node2": 0},
+		},
+		{
+			name: "missing readiness condition",
+			args: &pluginConfig.FlavourClusterWideArgs{NodeReadinessConditions: []string{"GPUReady"}},
+			want: map[string]int64{"node1": 0, "node2": 0},
+		},
+		{
+			name: "node update annotation",
+			args: &pluginConfig.FlavourClusterWideArgs{NodeUpdateAnnotations: map[string]string{"machineconfiguration.openshift.io/state": "Working"}},
+			want: map[string]int64{"node1": 100, "node2": 0},
+		},
+		{
+			name: "node update annotation with any value",
+			args: &pluginConfig.FlavourClusterWideArgs{NodeUpdateAnnotations: map[string]string{"machineconfiguration.openshift.io/state": ""}},
+			want: map[string]int64{"node1": 100, "node2": 0},
+		},
+		{
+			name: "node update annotation with another value",
+			args: &pluginConfig.FlavourClusterWideArgs{NodeUpdateAnnotations: map[string]string{"machineconfiguration.openshift.io/state": "Done"}},
+			want: map[string]int64{"node1": 0, "node2": 100},
+		},
+		{
+			name: "node update condition",
+			args: &pluginConfig.FlavourClusterWideArgs{NodeUpdateConditions: []string{"OSUpdateInProgress"}},
+			want: map[string]int64{"node1": 100, "node2": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := clientsetfake.NewSimpleClientset(node1, node2, pods[0], pods[1], pods[2])
+			h := &fakeHandle{lister: testutil.NewFakeSharedLister(nil, []*v1.Node{node1, node2})}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			f, err := NewWithOptions(ctx, tt.args, h,
+				WithClient(client),
+				WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+				WithLogger(logr.Discard()),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			waitForRefresh(t, f)
+			got := scoreNodes(t, f, makePod("default", "p", "", flavoured("gold")))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+)
+
+// cacheRefreshJitter is the largest fraction of the cache TTL added to the period of the background
+// refresh, so that the scheduler replicas and the instances of a profile do not list the API server in
+// step.
+const cacheRefreshJitter = 0.1
+
+// startCacheRefresh refreshes the cache in a background goroutine until ctx is done: once the synced
+// informers are, then every cacheTTL jittered by up to cacheRefreshJitter, and right away again when a
+// refresh is requested. The scheduling cycles only ever read the cache, so they never wait for the API
+// server.
+func (f *FlavourClusterWide) startCacheRefresh(ctx context.Context, synced ...cache.InformerSynced) {
+	f.refreshRequests = make(chan struct{}, 1)
+	f.runInBackground(func() {
+		if !cache.WaitForCacheSync(ctx.Done(), synced...) {
+			return
+		}
+		for {
+			f.updateCacheIfNeeded(ctx)
+			timer := f.clock.NewTimer(wait.Jitter(f.cacheTTL, cacheRefreshJitter))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-f.refreshRequests:
+				timer.Stop()
+			case <-timer.C():
+			}
+		}
+	})
+}
+
+// requestRefresh makes the background refresh run right away rather than at the end of its period. It is
+// a no-op without a background refresh, and when a refresh is already requested.
+func (f *FlavourClusterWide) requestRefresh() {
+	select {
+	case f.refreshRequests <- struct{}{}:
+	default:
+	}
+}
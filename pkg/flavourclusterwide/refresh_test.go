@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	testutil "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+// waitForRefresh waits for the first background refresh of a plugin built by NewWithOptions.
+func waitForRefresh(t *testing.T, f *FlavourClusterWide) {
+	t.Helper()
+	err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		f.cacheMutex.RLock()
+		defer f.cacheMutex.RUnlock()
+		return !f.lastUpdated.IsZero(), nil
+	})
+	if err != nil {
+		t.Fatalf("timed out waiting for the first cache refresh")
+	}
+}
+
+func TestCacheRefresh(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	client := clientsetfake.NewSimpleClientset(nodes[0], nodes[1], makePod("default", "p1", "node1", flavoured("gold")))
+	var podLists atomic.Int32
+	client.PrependReactor("list", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		podLists.Add(1)
+		return false, nil, nil
+	})
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	f, err := NewWithOptions(ctx, nil, &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)},
+		WithClient(client),
+		WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+		WithLogger(logr.Discard()),
+		WithClock(fakeClock),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The cache is refreshed once right away, and scoring never refreshes it.
+	waitForRefresh(t, f)
+	expectCache(t, f, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 0}})
+	scoreNodes(t, f, makePod("default", "p2", "", flavoured("gold")))
+	if got := podLists.Load(); got != 1 {
+		t.Errorf("expected 1 pod list, got %d", got)
+	}
+
+	// A dropped bind update makes the refresh run again before the end of its period.
+	if _, err := client.CoreV1().Pods("default").Create(ctx, makePod("default", "p2", "node2", flavoured("gold")), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f.reconcile.Store(true)
+	f.requestRefresh()
+	waitForCache(t, f, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 1}})
+
+	// The cache is refreshed again once the jittered TTL elapses on the clock of the plugin.
+	if _, err := client.CoreV1().Pods("default").Create(ctx, makePod("default", "p3", "node2", flavoured("gold")), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitForTimer(t, fakeClock)
+	expectCache(t, f, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 1}})
+	fakeClock.Step(f.cacheTTL + time.Duration(cacheRefreshJitter*float64(f.cacheTTL)))
+	waitForCache(t, f, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 2}})
+}
+
+// waitForTimer waits for the background refresh to wait on the timer of the fake clock.
+func waitForTimer(t *testing.T, fakeClock *clocktesting.FakeClock) {
+	t.Helper()
+	err := wait.PollUntilContextTimeout(context.Background(), time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		return fakeClock.HasWaiters(), nil
+	})
+	if err != nil {
+		t.Fatalf("timed out waiting for the refresh timer")
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"time"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// flavourLabel is the label holding the flavour of the pods, with the weight of its balance score
+// against the spread scores of the further label keys, see combineLabelKeys.
+type flavourLabel struct {
+	name   string
+	weight int64
+	keys   []pluginConfig.FlavourLabelKey
+}
+
+func newFlavourLabel(name string, keys []pluginConfig.FlavourLabelKey) *flavourLabel {
+	l := &flavourLabel{name: name}
+	l.weight, l.keys = splitLabelKeys(name, keys)
+	return l
+}
+
+// labelName returns the name of the flavour label.
+func (f *FlavourClusterWide) labelName() string {
+	return f.label.Load().name
+}
+
+// relabel switches the flavour label to the given one, and rebuilds the cache keyed on it right away
+// while holding the cache mutex, so that no scheduling cycle sees the cache of the previous label
+// counted under the new one. Everything recorded per flavour of the previous label is dropped with the
+// cache: the reservations, which PostBind then counts under the new label, the recent placements, the
+// admissions and the drained flavours, and the flavours of the metric labels, so that the series of the
+// previous flavours are not kept beside the new ones. It is a no-op when the label does not change.
+func (f *FlavourClusterWide) relabel(ctx context.Context, label *flavourLabel) {
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+
+	previous := f.label.Load()
+	if previous.name == label.name {
+		f.label.Store(label)
+		return
+	}
+	f.label.Store(label)
+	f.cache = make(map[string]map[string]int)
+	f.revision = 0
+	f.lastUpdated = time.Time{}
+	f.reserved = nil
+	f.recentPlacements = nil
+	f.admissions = nil
+	f.drainedFlavours = nil
+	f.balancedSlots = nil
+	f.metricLabels.reset()
+	f.rebuildCache(ctx, false)
+	f.logger.Info("Flavour label changed, cache rebuilt", "previousLabelName", previous.name, "labelName", label.name)
+}
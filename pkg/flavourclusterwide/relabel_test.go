@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+)
+
+func TestRelabel(t *testing.T) {
+	ctx := context.Background()
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	f := newTestPlugin(nodes, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"silver": 1}})
+	f.logger = logr.Discard()
+	f.store = apiStore{client: clientsetfake.NewSimpleClientset(nodes[0], nodes[1],
+		makePod("default", "p1", "node1", map[string]string{"flavour": "gold", "team": "a"}),
+		makePod("default", "p2", "node2", map[string]string{"flavour": "silver", "team": "b"}),
+		makePod("default", "p3", "node2", map[string]string{"team": "b"}))}
+	f.policyArgs = &pluginConfig.FlavourClusterWideArgs{LabelName: "flavour"}
+	f.reserved = map[types.UID]placement{"reserved": {node: "node1", flavour: "gold", weight: 1}}
+	f.metricLabels.flavour("gold")
+
+	// The policy changes the label: the cache is rebuilt right away on the new label, without the
+	// reservations and metric labels of the previous flavours.
+	f.applyPolicy(ctx, makePolicy(v1alpha1.FlavourPolicySpec{LabelName: "team"}))
+	if got := f.labelName(); got != "team" {
+		t.Fatalf("expected the label to be team, got %q", got)
+	}
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"a": 1, "b": 0},
+		"node2": {"a": 0, "b": 2},
+	})
+	if f.reserved != nil {
+		t.Errorf("expected the reservations to be dropped, got %v", f.reserved)
+	}
+	if f.metricLabels.seen.Len() != 0 {
+		t.Errorf("expected the flavours of the metric labels to be forgotten, got %v", f.metricLabels.seen)
+	}
+	if flavour := f.flavourOf(makePod("default", "p", "", map[string]string{"team": "a"})); flavour != "a" {
+		t.Errorf("expected the pods to be flavoured by team, got %q", flavour)
+	}
+
+	// A policy without a label switches back to the label of the args.
+	f.applyPolicy(ctx, makePolicy(v1alpha1.FlavourPolicySpec{}))
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1, "silver": 0},
+		"node2": {"gold": 0, "silver": 1},
+	})
+
+	// An invalid label leaves the label as it was.
+	f.applyPolicy(ctx, makePolicy(v1alpha1.FlavourPolicySpec{LabelName: "not a label"}))
+	if got := f.labelName(); got != "flavour" {
+		t.Errorf("expected the label to stay flavour, got %q", got)
+	}
+}
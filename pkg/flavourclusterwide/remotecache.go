@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// remoteCacheTimeout bounds every call to the remote cache, so that an unresponsive Redis only delays
+// the rebuild by as much before the listing falls back to the next store.
+const remoteCacheTimeout = 2 * time.Second
+
+var _ flavourStore = &redisStore{}
+
+// remoteListing is the listing of the nodes and flavoured pods kept in the remote cache.
+type remoteListing struct {
+	Nodes []v1.Node `json:"nodes"`
+	Pods  []v1.Pod  `json:"pods"`
+}
+
+// redisStore serves the listings of the next store from Redis, so that the replicas of the scheduler
+// list the API server once per TTL between them rather than once each. A listing missing from Redis is
+// listed from the next store and written back for TTL. When Redis fails, the listing falls back to the
+// next store, so that the remote cache never fails a rebuild the API server can serve.
+type redisStore struct {
+	client *redis.Client
+	next   flavourStore
+	prefix string
+	ttl    time.Duration
+	plugin string
+	logger klog.Logger
+}
+
+// newRemoteCache returns the remote cache of the configuration in front of next.
+func newRemoteCache(config *pluginConfig.FlavourRemoteCache, next flavourStore, plugin string, logger klog.Logger) (*redisStore, error) {
+	if config.Type != pluginConfig.FlavourRemoteCacheRedis {
+		return nil, fmt.Errorf("unsupported remote cache type %q", config.Type)
+	}
+	var password string
+	if config.PasswordFile != "" {
+		data, err := os.ReadFile(config.PasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the Redis password: %v", err)
+		}
+		password = strings.TrimSpace(string(data))
+	}
+	return &redisStore{
+		client: redis.NewClient(&redis.Options{Addr: config.Address, Password: password}),
+		next:   next,
+		prefix: config.KeyPrefix,
+		ttl:    time.Duration(config.TTLSeconds) * time.Second,
+		plugin: plugin,
+		logger: logger,
+	}, nil
+}
+
+// key returns the key of the listing of the label and node selector. The plugins listing different
+// labels or nodes share the Redis without sharing their listings.
+func (s *redisStore) key(labelName string, nodeSelector labels.Selector) string {
+	return s.prefix + ":" + labelName + ":" + nodeSelector.String()
+}
+
+func (s *redisStore) List(ctx context.Context, labelName string, nodeSelector labels.Selector) ([]v1.Node, []v1.Pod, error) {
+	key := s.key(labelName, nodeSelector)
+	if listing, ok := s.get(ctx, key); ok {
+		return listing.Nodes, listing.Pods, nil
+	}
+
+	nodes, pods, err := s.next.List(ctx, labelName, nodeSelector)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.set(ctx, key, nodes, pods)
+	return nodes, pods, nil
+}
+
+// get returns the listing of the key from Redis, if it is there.
+func (s *redisStore) get(ctx context.Context, key string) (*remoteListing, bool) {
+	ctx, cancel := context.WithTimeout(ctx, remoteCacheTimeout)
+	defer cancel()
+	data, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		remoteCacheReads.WithLabelValues(s.plugin, "Miss").Inc()
+		return nil, false
+	}
+	listing := &remoteListing{}
+	if err == nil {
+		err = json.Unmarshal(data, listing)
+	}
+	if err != nil {
+		remoteCacheReads.WithLabelValues(s.plugin, "Error").Inc()
+		s.logger.Error(err, "Error reading the listing from the remote cache", "key", key)
+		return nil, false
+	}
+	remoteCacheReads.WithLabelValues(s.plugin, "Hit").Inc()
+	return listing, true
+}
+
+// set writes the listing to Redis for the TTL of the store. The managed fields are left out, the cache
+// does not need them and they make up much of the size of the objects.
+func (s *redisStore) set(ctx context.Context, key string, nodes []v1.Node, pods []v1.Pod) {
+	listing := &remoteListing{
+		Nodes: make([]v1.Node, len(nodes)),
+		Pods:  make([]v1.Pod, len(pods)),
+	}
+	for i := range nodes {
+		listing.Nodes[i] = nodes[i]
+		listing.Nodes[i].ManagedFields = nil
+	}
+	for i := range pods {
+		listing.Pods[i] = pods[i]
+		listing.Pods[i].ManagedFields = nil
+	}
+	data, err := json.Marshal(listing)
+	if err != nil {
+		s.logger.Error(err, "Error encoding the listing for the remote cache", "key", key)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, remoteCacheTimeout)
+	defer cancel()
+	if err := s.client.Set(ctx, key, data, s.ttl).Err(); err != nil {
+		s.logger.Error(err, "Error writing the listing to the remote cache", "key", key)
+	}
+}
+
+// Close closes the connections to Redis.
+func (s *redisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func newTestRemoteCache(t *testing.T, address string, next flavourStore) *redisStore {
+	t.Helper()
+	config := &pluginConfig.FlavourRemoteCache{
+		Type:       pluginConfig.FlavourRemoteCacheRedis,
+		Address:    address,
+		KeyPrefix:  "flavourclusterwide",
+		TTLSeconds: 10,
+	}
+	store, err := newRemoteCache(config, next, "FlavourClusterWide", logr.Discard())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestRemoteCache(t *testing.T) {
+	server := miniredis.RunT(t)
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	pod := makePod("default", "p1", "node1", flavoured("gold"))
+	pod.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kubectl"}}
+
+	// Two replicas share the Redis: the first lists the API server, the second reads its listing.
+	first := &staticStore{nodes: []v1.Node{*nodes[0], *nodes[1]}, pods: []v1.Pod{*pod}}
+	second := &staticStore{nodes: []v1.Node{*nodes[0]}}
+	replicas := []*FlavourClusterWide{newTestPlugin(nodes, nil), newTestPlugin(nodes, nil)}
+	replicas[0].store = newTestRemoteCache(t, server.Addr(), first)
+	replicas[1].store = newTestRemoteCache(t, server.Addr(), second)
+	want := map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 0}}
+	for _, f := range replicas {
+		f.logger = logr.Discard()
+		f.reconcile.Store(true)
+		f.updateCacheIfNeeded(context.Background())
+		expectCache(t, f, want)
+	}
+	if first.lists != 1 || second.lists != 0 {
+		t.Errorf("expected a single listing of the API server, got %d and %d", first.lists, second.lists)
+	}
+
+	// The managed fields are left out of the shared listing.
+	nodeList, podList, err := replicas[1].store.List(context.Background(), "flavour", replicas[1].nodeSelector)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(nodeList) != 2 || len(podList) != 1 || podList[0].ManagedFields != nil {
+		t.Errorf("unexpected listing from the remote cache: %d nodes, %d pods, managed fields %v", len(nodeList), len(podList), podList)
+	}
+
+	// Once the listing expires, the next replica to rebuild lists the API server again.
+	server.FastForward(10 * time.Second)
+	replicas[1].reconcile.Store(true)
+	replicas[1].updateCacheIfNeeded(context.Background())
+	expectCache(t, replicas[1], map[string]map[string]int{"node1": {}})
+	if second.lists != 1 {
+		t.Errorf("expected the second replica to list the API server once the listing expired, got %d", second.lists)
+	}
+}
+
+func TestRemoteCacheUnavailable(t *testing.T) {
+	server := miniredis.RunT(t)
+	nodes := []*v1.Node{makeWorker("node1")}
+	next := &staticStore{nodes: []v1.Node{*nodes[0]}, pods: []v1.Pod{*makePod("default", "p1", "node1", flavoured("gold"))}}
+	f := newTestPlugin(nodes, nil)
+	f.logger = logr.Discard()
+	f.store = newTestRemoteCache(t, server.Addr(), next)
+	server.Close()
+
+	// The rebuild falls back to the next store when Redis is down.
+	f.reconcile.Store(true)
+	f.updateCacheIfNeeded(context.Background())
+	expectCache(t, f, map[string]map[string]int{"node1": {"gold": 1}})
+	if next.lists != 1 {
+		t.Errorf("expected the rebuild to list the next store, got %d listings", next.lists)
+	}
+}
+
+func TestNewRemoteCachePasswordFile(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(passwordFile, []byte("secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config := &pluginConfig.FlavourRemoteCache{
+		Type:         pluginConfig.FlavourRemoteCacheRedis,
+		Address:      server.Addr(),
+		PasswordFile: passwordFile,
+		KeyPrefix:    "flavourclusterwide",
+		TTLSeconds:   10,
+	}
+	store, err := newRemoteCache(config, &staticStore{}, "FlavourClusterWide", logr.Discard())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer store.Close()
+	if err := store.client.Ping(context.Background()).Err(); err != nil {
+		t.Errorf("expected the password to authenticate, got %v", err)
+	}
+
+	config.PasswordFile = filepath.Join(t.TempDir(), "missing")
+	if _, err := newRemoteCache(config, &staticStore{}, "FlavourClusterWide", logr.Discard()); err == nil {
+		t.Errorf("expected an error reading a missing password file")
+	}
+}
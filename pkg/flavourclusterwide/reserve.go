@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+var _ = framework.ReservePlugin(&FlavourClusterWide{})
+
+// Reserve counts the pod on the node as soon as the scheduler assumes it there, rather than once it
+// is bound, so that the pods of the flavour scored while it is being bound see it. The pod is counted
+// once: PostBind does not count it again, and Unreserve uncounts it if the binding fails.
+func (f *FlavourClusterWide) Reserve(ctx context.Context, state fwk.CycleState, pod *v1.Pod, nodeName string) *fwk.Status {
+	flavour := f.flavourOf(pod)
+	if flavour == "" {
+		return nil
+	}
+
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+	if _, counted := f.counted[pod.UID]; counted {
+		return nil
+	}
+	if _, reserved := f.reserved[pod.UID]; reserved {
+		return nil
+	}
+	p := f.podPlacement(pod, nodeName, flavour)
+	f.count(pod.UID, p)
+	if f.reserved == nil {
+		f.reserved = make(map[types.UID]placement)
+	}
+	f.reserved[pod.UID] = p
+	return nil
+}
+
+// Unreserve uncounts the pod counted by Reserve when it is rejected or its binding fails, and releases
+// the quota Permit charged it to. It does nothing for the pods Reserve did not count.
+func (f *FlavourClusterWide) Unreserve(ctx context.Context, state fwk.CycleState, pod *v1.Pod, nodeName string) {
+	f.releaseQuota(pod)
+	f.cacheMutex.Lock()
+	defer f.cacheMutex.Unlock()
+	p, reserved := f.reserved[pod.UID]
+	if !reserved {
+		return
+	}
+	delete(f.reserved, pod.UID)
+	f.uncount(pod.UID, p)
+	logger := klog.FromContext(klog.NewContext(ctx, f.logger)).WithValues("ExtensionPoint", "Unreserve")
+	logger.V(4).Info("Pod unreserved from node", "pod", klog.KObj(pod), "node", p.node, "flavour", p.flavour)
+}
+
+// bindReserved forgets the reservation of the bound pod and reports whether Reserve counted it. The
+// cache mutex must be held by the caller.
+func (f *FlavourClusterWide) bindReserved(pod *v1.Pod) bool {
+	if _, reserved := f.reserved[pod.UID]; !reserved {
+		return false
+	}
+	delete(f.reserved, pod.UID)
+	return true
+}
+
+// recountReserved counts again the reserved pods a rebuild did not find bound, as they are not bound yet
+// and PostBind will not count them. The cache mutex must be held by the caller.
+func (f *FlavourClusterWide) recountReserved(pods []v1.Pod) {
+	if len(f.reserved) == 0 {
+		return
+	}
+	bound := make(map[types.UID]bool, len(f.reserved))
+	for i := range pods {
+		if _, reserved := f.reserved[pods[i].UID]; reserved && pods[i].Spec.NodeName != "" {
+			bound[pods[i].UID] = true
+		}
+	}
+	for uid, p := range f.reserved {
+		if !bound[uid] {
+			f.count(uid, p)
+		}
+	}
+}
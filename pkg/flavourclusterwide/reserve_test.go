@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+)
+
+func TestReserve(t *testing.T) {
+	ctx := context.Background()
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	f := newTestPlugin(nodes, map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 0},
+	})
+	f.logger = logr.Discard()
+	pending := makePod("default", "p", "", flavoured("gold"))
+
+	// A reserved pod is counted before it is bound, so that the next pod of the flavour avoids its node.
+	reserved := uidPod("p1", "", "gold")
+	if status := f.Reserve(ctx, nil, reserved, "node1"); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	if diff := cmp.Diff(map[string]int64{"node1": 0, "node2": 100}, scoreNodes(t, f, pending)); diff != "" {
+		t.Errorf("unexpected scores with a reserved pod (-want,+got):\n%s", diff)
+	}
+	f.PostBind(ctx, nil, reserved, "node1")
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 0},
+	})
+
+	// A pod whose binding fails is uncounted, once.
+	failed := uidPod("p2", "", "gold")
+	if status := f.Reserve(ctx, nil, failed, "node2"); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	f.Unreserve(ctx, nil, failed, "node2")
+	f.Unreserve(ctx, nil, failed, "node2")
+	f.Unreserve(ctx, nil, uidPod("p3", "", "gold"), "node1")
+	if status := f.Reserve(ctx, nil, makePod("default", "plain", "", nil), "node2"); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 0},
+	})
+}
+
+func TestReserveAcrossRebuild(t *testing.T) {
+	ctx := context.Background()
+	client := clientsetfake.NewSimpleClientset(makeWorker("node1"), makeWorker("node2"), uidPod("p0", "node2", "gold"))
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	f, err := NewWithOptions(ctx, &cfgv1.FlavourClusterWideArgs{}, nil,
+		WithClient(client),
+		WithLogger(logr.Discard()),
+		WithClock(fakeClock),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f.updateCacheIfNeeded(ctx)
+
+	pod := uidPod("p1", "", "gold")
+	if status := f.Reserve(ctx, nil, pod, "node1"); !status.IsSuccess() {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	// The rebuild does not list the pod bound yet, and keeps counting it.
+	fakeClock.Step(defaultCacheTTL)
+	f.updateCacheIfNeeded(ctx)
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 1},
+	})
+
+	// The rebuild lists the pod bound before its PostBind, which does not count it again.
+	pod.Spec.NodeName = "node1"
+	if _, err := client.CoreV1().Pods("default").Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fakeClock.Step(defaultCacheTTL)
+	f.updateCacheIfNeeded(ctx)
+	f.PostBind(ctx, nil, pod, "node1")
+	expectCache(t, f, map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 1},
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"hash/fnv"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// decisionSampler samples the bind decisions recorded by the CloudEvents and node class annotation
+// subsystems, keeping every anomalous decision.
+type decisionSampler struct {
+	percent int32
+}
+
+// newDecisionSampler returns a sampler keeping the given percentage of the decisions, or nil when
+// every decision is kept.
+func newDecisionSampler(percent int32) *decisionSampler {
+	if percent >= 100 {
+		return nil
+	}
+	return &decisionSampler{percent: percent}
+}
+
+// sampled returns true if the decision about the pod with the given UID is kept. The decision is
+// taken from a hash of the UID, so that every subsystem keeps the same decisions and a pod's bind
+// event and annotation are either both recorded or both skipped.
+func (s *decisionSampler) sampled(uid types.UID) bool {
+	h := fnv.New32a()
+	h.Write([]byte(uid))
+	return int32(h.Sum32()%100) < s.percent
+}
+
+// sampleDecision returns true if the bind of the flavoured pod to the node is to be recorded, that is
+// when there is no sampling, when the bind is anomalous, or when the pod is sampled.
+// The bind is anomalous when the node hosts more pods of the flavour than another node, which is the
+// case when other scores outweighed the plugin's. The cache must not be updated with the bind yet.
+func (f *FlavourClusterWide) sampleDecision(uid types.UID, flavour, nodeName string) bool {
+	if f.sampling == nil || f.sampling.sampled(uid) {
+		return true
+	}
+
+	f.cacheMutex.RLock()
+	defer f.cacheMutex.RUnlock()
+	count, exists := f.cache[nodeName][flavour]
+	if !exists {
+		return false
+	}
+	for _, nodeCounts := range f.cache {
+		if other, ok := nodeCounts[flavour]; ok && other < count {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDecisionSampler(t *testing.T) {
+	if s := newDecisionSampler(100); s != nil {
+		t.Errorf("expected no sampler when every decision is kept, got %v", s)
+	}
+	for _, percent := range []int32{0, 10, 50} {
+		s := newDecisionSampler(percent)
+		kept := 0
+		for i := 0; i < 10000; i++ {
+			if s.sampled(types.UID(fmt.Sprintf("uid-%d", i))) {
+				kept++
+			}
+		}
+		if want := int(percent) * 100; kept < want-200 || kept > want+200 {
+			t.Errorf("expected about %d of 10000 decisions kept at %d%%, got %d", want, percent, kept)
+		}
+	}
+}
+
+func TestSampleDecision(t *testing.T) {
+	cache := map[string]map[string]int{
+		"node1": {"gold": 1, "silver": 0},
+		"node2": {"gold": 2, "silver": 0},
+	}
+	tests := []struct {
+		name     string
+		sampling *decisionSampler
+		flavour  string
+		node     string
+		want     bool
+	}{
+		{
+			name:    "no sampling",
+			flavour: "gold",
+			node:    "node2",
+			want:    true,
+		},
+		{
+			name:     "bind to a least loaded node",
+			sampling: newDecisionSampler(0),
+			flavour:  "gold",
+			node:     "node1",
+			want:     false,
+		},
+		{
+			name:     "bind to a more loaded node",
+			sampling: newDecisionSampler(0),
+			flavour:  "gold",
+			node:     "node2",
+			want:     true,
+		},
+		{
+			name:     "first pod of a flavour",
+			sampling: newDecisionSampler(0),
+			flavour:  "bronze",
+			node:     "node1",
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nil, cache)
+			f.sampling = tt.sampling
+			if got := f.sampleDecision("uid", tt.flavour, tt.node); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
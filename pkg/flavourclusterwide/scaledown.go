@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// toBeDeletedTaint is the taint cluster-autoscaler sets on a node before draining and removing it.
+const toBeDeletedTaint = "ToBeDeletedByClusterAutoscaler"
+
+// isDraining returns true if the node is cordoned, as kubectl drain does first, or tainted for
+// deletion by cluster-autoscaler.
+func isDraining(node *v1.Node) bool {
+	if node.Spec.Unschedulable {
+		return true
+	}
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == toBeDeletedTaint {
+			return true
+		}
+	}
+	return false
+}
+
+// recordDrains records the draining nodes among the listed ones and stamps the flavours they still
+// host with the current time. The evicted pods of those flavours reschedule in a burst, which drainOrigin
+// recognizes until the scale-down window has passed since the flavour was last seen on a draining node.
+// Flavours whose window has passed are forgotten. The caller must hold the cache mutex for writing.
+func (f *FlavourClusterWide) recordDrains(nodes []v1.Node) {
+	now := f.clock.Now()
+	f.drainingNodes = sets.New[string]()
+	if f.drainedFlavours == nil {
+		f.drainedFlavours = make(map[string]time.Time)
+	}
+	for i := range nodes {
+		if !isDraining(&nodes[i]) {
+			continue
+		}
+		f.drainingNodes.Insert(nodes[i].Name)
+		for flavour, count := range f.cache[nodes[i].Name] {
+			if count > 0 {
+				f.drainedFlavours[flavour] = now
+			}
+		}
+	}
+	for flavour, seen := range f.drainedFlavours {
+		if now.Sub(seen) >= f.scaleDownWindow {
+			delete(f.drainedFlavours, flavour)
+		}
+	}
+}
+
+// recordCordoned stamps the flavours the cordoned nodes among the listed ones hosted at the last rebuild
+// with the current time. Cordoned nodes are left out of the cache, so recordDrains no longer sees them once
+// they are cordoned. The caller must hold the cache mutex for writing, and call it
+// before the cache is rebuilt without them.
+func (f *FlavourClusterWide) recordCordoned(nodes []v1.Node) {
+	now := f.clock.Now()
+	if f.drainedFlavours == nil {
+		f.drainedFlavours = make(map[string]time.Time)
+	}
+	for i := range nodes {
+		if !nodes[i].Spec.Unschedulable {
+			continue
+		}
+		for flavour, count := range f.cache[nodes[i].Name] {
+			if count > 0 {
+				f.drainedFlavours[flavour] = now
+			}
+		}
+	}
+}
+
+// drainOrigin returns true if pods of the flavour were on a draining node within the scale-down
+// window, in which case the pod is likely rescheduling after an eviction. The caller must hold the
+// cache mutex.
+func (f *FlavourClusterWide) drainOrigin(flavour string, now time.Time) bool {
+	if f.scaleDownWindow <= 0 {
+		return false
+	}
+	seen, ok := f.drainedFlavours[flavour]
+	return ok && now.Sub(seen) < f.scaleDownWindow
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestRecordDrains(t *testing.T) {
+	cordoned := makeWorker("node2")
+	cordoned.Spec.Unschedulable = true
+	tainted := makeWorker("node3")
+	tainted.Spec.Taints = []v1.Taint{{Key: toBeDeletedTaint, Effect: v1.TaintEffectNoSchedule}}
+	nodes := []v1.Node{*makeWorker("node1"), *cordoned, *tainted}
+
+	f := newTestPlugin(nil, map[string]map[string]int{
+		"node1": {"gold": 3, "silver": 1, "bronze": 1},
+		"node2": {"gold": 1, "silver": 0, "bronze": 0},
+		"node3": {"gold": 0, "silver": 2, "bronze": 0},
+	})
+	f.scaleDownWindow = 2 * time.Minute
+	now := f.clock.Now()
+	f.drainedFlavours = map[string]time.Time{"bronze": now.Add(-2 * time.Minute)}
+
+	f.recordDrains(nodes)
+	if diff := cmp.Diff(sets.New("node2", "node3"), f.drainingNodes); diff != "" {
+		t.Errorf("unexpected draining nodes (-want,+got):\n%s", diff)
+	}
+	// bronze was last seen on a draining node a full window ago.
+	want := map[string]time.Time{"gold": now, "silver": now}
+	if diff := cmp.Diff(want, f.drainedFlavours); diff != "" {
+		t.Errorf("unexpected drained flavours (-want,+got):\n%s", diff)
+	}
+}
+
+func TestRecordCordoned(t *testing.T) {
+	cordoned := makeNode("node2", nil)
+	cordoned.Spec.Unschedulable = true
+	nodes := []v1.Node{*makeNode("node1", nil), *cordoned}
+
+	f := newTestPlugin(nil, map[string]map[string]int{
+		"node1": {"gold": 3, "silver": 1},
+		"node2": {"gold": 1, "silver": 0},
+	})
+	f.scaleDownWindow = 2 * time.Minute
+	now := f.clock.Now()
+
+	// The cordoned node is left out of the rebuilt cache, its flavours are stamped from the previous one.
+	f.recordCordoned(nodes)
+	nodes, _ = SchedulableNodes(nodes, nil)
+	f.cache = map[string]map[string]int{"node1": {"gold": 3, "silver": 1}}
+	f.recordDrains(nodes)
+	if diff := cmp.Diff(map[string]time.Time{"gold": now}, f.drainedFlavours); diff != "" {
+		t.Errorf("unexpected drained flavours (-want,+got):\n%s", diff)
+	}
+}
+
+func TestScoreScaleDown(t *testing.T) {
+	nodes := []*v1.Node{makeNode("node1", nil), makeNode("node2", nil), makeNode("node3", nil)}
+	cache := map[string]map[string]int{
+		"node1": {"gold": 2},
+		"node2": {"gold": 4},
+		"node3": {"gold": 1},
+	}
+	tests := []struct {
+		name    string
+		drained time.Duration
+		want    map[string]int64
+	}{
+		{
+			// node3 is draining, which leaves the nodes that can take the pods without a full score.
+			name: "no drain",
+			want: map[string]int64{"node1": 67, "node2": 0, "node3": 100},
+		},
+		{
+			name:    "flavour drained within the window",
+			drained: 30 * time.Second,
+			want:    map[string]int64{"node1": 100, "node2": 0, "node3": 0},
+		},
+		{
+			name:    "flavour drained before the window",
+			drained: 3 * time.Minute,
+			want:    map[string]int64{"node1": 67, "node2": 0, "node3": 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.scoringStrategy = pluginConfig.FlavourScoringVarianceReduction
+			f.scaleDownWindow = 2 * time.Minute
+			f.drainingNodes = sets.New("node3")
+			f.drainedFlavours = map[string]time.Time{}
+			if tt.drained > 0 {
+				f.drainedFlavours["gold"] = f.clock.Now().Add(-tt.drained)
+			}
+
+			got := scoreNodes(t, f, makePod("default", "p", "", flavoured("gold")))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/scheduler/backend/cache"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"sigs.k8s.io/yaml"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+)
+
+// scenario is a scoring scenario of testdata/scenarios, see testdata/scenarios/README.md: the plugin
+// configured with args builds its cache from nodes and pods, and runs a scheduling cycle for pod.
+type scenario struct {
+	Description string                       `json:"description"`
+	Args        cfgv1.FlavourClusterWideArgs `json:"args"`
+	Nodes       []v1.Node                    `json:"nodes"`
+	Pods        []v1.Pod                     `json:"pods"`
+	Pod         v1.Pod                       `json:"pod"`
+	Want        scenarioWant                 `json:"want"`
+}
+
+// scenarioWant is the expected outcome of a scenario: the nodes passing Filter grouped by normalized
+// score, from the highest to the lowest, and the nodes Filter rejects.
+type scenarioWant struct {
+	Ranking  [][]string `json:"ranking"`
+	Rejected []string   `json:"rejected"`
+}
+
+// loadScenario reads the scenario of the file, filling in what the API server would: the namespace, the
+// UID and the running phase of the pods.
+func loadScenario(path string) (*scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &scenario{}
+	if err := yaml.UnmarshalStrict(data, s); err != nil {
+		return nil, err
+	}
+	if s.Pod.Name == "" {
+		s.Pod.Name = "incoming"
+	}
+	for _, pod := range append([]*v1.Pod{&s.Pod}, podPointers(s.Pods)...) {
+		if pod.Namespace == "" {
+			pod.Namespace = "default"
+		}
+		if pod.UID == "" {
+			pod.UID = types.UID(pod.Namespace + "/" + pod.Name)
+		}
+		if pod.Spec.NodeName != "" && pod.Status.Phase == "" {
+			pod.Status.Phase = v1.PodRunning
+		}
+	}
+	return s, nil
+}
+
+func podPointers(pods []v1.Pod) []*v1.Pod {
+	pointers := make([]*v1.Pod, len(pods))
+	for i := range pods {
+		pointers[i] = &pods[i]
+	}
+	return pointers
+}
+
+// run runs the scenario and returns the nodes passing Filter grouped by normalized score, from the
+// highest to the lowest and sorted by name within a group, and the sorted nodes Filter rejects.
+func (s *scenario) run(ctx context.Context) ([][]string, []string, error) {
+	pods := podPointers(s.Pods)
+	nodes := make([]*v1.Node, len(s.Nodes))
+	objects := make([]runtime.Object, 0, len(s.Nodes)+len(pods))
+	for i := range s.Nodes {
+		nodes[i] = &s.Nodes[i]
+		objects = append(objects, nodes[i])
+	}
+	for _, pod := range pods {
+		objects = append(objects, pod)
+	}
+	client := clientsetfake.NewSimpleClientset(objects...)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	f, err := NewWithOptions(ctx, &s.Args, &selfTestHandle{snapshot: cache.NewSnapshot(pods, nodes)},
+		WithClient(client),
+		WithInformerFactory(informers.NewSharedInformerFactory(client, 0)),
+		WithLogger(logr.Discard()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, selfTestTimeout, true, func(context.Context) (bool, error) {
+		f.cacheMutex.RLock()
+		defer f.cacheMutex.RUnlock()
+		return !f.lastUpdated.IsZero(), nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	scores, rejected, err := f.runCycle(ctx, &s.Pod)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rankingOf(scores), sortedNames(rejected), nil
+}
+
+// rankingOf groups the nodes by score, from the highest to the lowest.
+func rankingOf(scores framework.NodeScoreList) [][]string {
+	byScore := make(map[int64][]string)
+	for _, score := range scores {
+		byScore[score.Score] = append(byScore[score.Score], score.Name)
+	}
+	values := make([]int64, 0, len(byScore))
+	for score := range byScore {
+		values = append(values, score)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] > values[j] })
+	var ranking [][]string
+	for _, score := range values {
+		ranking = append(ranking, sortedNames(byScore[score]))
+	}
+	return ranking
+}
+
+func sortedNames(names []string) []string {
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func TestScenarios(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "scenarios", "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no scenarios in testdata/scenarios")
+	}
+	for _, path := range paths {
+		t.Run(strings.TrimSuffix(filepath.Base(path), ".yaml"), func(t *testing.T) {
+			s, err := loadScenario(path)
+			if err != nil {
+				t.Fatalf("loading %s: %v", path, err)
+			}
+			ranking, rejected, err := s.run(context.Background())
+			if err != nil {
+				t.Fatalf("running %s: %v", path, err)
+			}
+			want := make([][]string, len(s.Want.Ranking))
+			for i, nodes := range s.Want.Ranking {
+				want[i] = sortedNames(nodes)
+			}
+			if diff := cmp.Diff(want, ranking, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("%s: unexpected ranking (-want,+got):\n%s", s.Description, diff)
+			}
+			if diff := cmp.Diff(sortedNames(s.Want.Rejected), rejected, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("%s: unexpected rejected nodes (-want,+got):\n%s", s.Description, diff)
+			}
+		})
+	}
+}
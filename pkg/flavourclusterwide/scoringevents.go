@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"fmt"
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// ScoringEventReason is the reason of the Events explaining the choice of the node of bound flavoured
+// pods when RecordScoringEvents is enabled.
+const ScoringEventReason = "FlavourScored"
+
+// scoringExplanation returns why the cycle picked the node for the pod of the flavour, from the
+// distribution of the flavour taken by PreScore and the normalized scores of the cycle: whether the node
+// hosted the fewest pods of the flavour among the scored nodes, won with the strategy anyway, or won
+// because other scores outweighed the balance.
+// It returns "" when the cycle did not score the node, such as when it was the only feasible node.
+func (f *FlavourClusterWide) scoringExplanation(state fwk.CycleState, flavour, nodeName string) string {
+	dist := f.distribution(state)
+	scores := f.cycleScores(state)
+	score, scored := scores[nodeName]
+	if dist == nil || !scored {
+		return ""
+	}
+
+	count := dist.weighted[nodeName]
+	fewest, most := math.MaxInt, -1
+	var fewestNode, mostNode string
+	for node := range scores {
+		c := dist.weighted[node]
+		if c < fewest || (c == fewest && node < fewestNode) {
+			fewest, fewestNode = c, node
+		}
+		if c > most || (c == most && node < mostNode) {
+			most, mostNode = c, node
+		}
+	}
+	strategy := f.scoringStrategy
+	if dist.strict || strategy == "" {
+		strategy = pluginConfig.FlavourScoringSpread
+	}
+	unit := countUnit(f.countMode)
+	if count == fewest {
+		return fmt.Sprintf("Node %s had the fewest %s of flavour %s among the %d scored nodes: %d, against %d on %s. Its flavour score was %d of %d with the %s strategy.",
+			nodeName, unit, flavour, len(scores), count, most, mostNode, score, framework.MaxNodeScore, strategy)
+	}
+	explanation := fmt.Sprintf("Node %s had %d %s of flavour %s, more than the fewest, %d on %s, among the %d scored nodes.",
+		nodeName, count, unit, flavour, fewest, fewestNode, len(scores))
+	if score == framework.MaxNodeScore {
+		// Strategies other than Spread also weigh the topology, ratios or variance of the distribution.
+		return fmt.Sprintf("%s Its flavour score was still the best, %d of %d, with the %s strategy.", explanation, score, framework.MaxNodeScore, strategy)
+	}
+	return fmt.Sprintf("%s Its flavour score was %d of %d with the %s strategy, and other scores outweighed the flavour balance.", explanation, score, framework.MaxNodeScore, strategy)
+}
+
+// recordScoringEvent records the explanation of the scoring of the bound pod in a ScoringEventReason
+// Event on the pod, when scoring events are enabled and the cycle scored the node.
+func (f *FlavourClusterWide) recordScoringEvent(pod *v1.Pod, explanation string) {
+	if f.recorder == nil || explanation == "" {
+		return
+	}
+	f.recorder.Eventf(pod, nil, v1.EventTypeNormal, ScoringEventReason, "Scheduling", "%s", explanation)
+}
+
+// countUnit returns what the count mode counts, for the messages.
+func countUnit(mode pluginConfig.FlavourCountMode) string {
+	switch mode {
+	case pluginConfig.FlavourCountCPURequests:
+		return "millicores requested by pods"
+	case pluginConfig.FlavourCountMemoryRequests:
+		return "MiB requested by pods"
+	case pluginConfig.FlavourCountResourceWeighted:
+		return "weighted requests of pods"
+	}
+	return "pods"
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestScoringEvents(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	tests := []struct {
+		name     string
+		strategy pluginConfig.FlavourScoringStrategy
+		node     string
+		unscored bool
+		want     string
+	}{
+		{
+			name: "fewest pods",
+			node: "node1",
+			want: "Normal FlavourScored Node node1 had the fewest pods of flavour gold among the 3 scored nodes: 2, against 5 on node3. Its flavour score was 100 of 100 with the Spread strategy.",
+		},
+		{
+			name: "outweighed by other scores",
+			node: "node3",
+			want: "Normal FlavourScored Node node3 had 5 pods of flavour gold, more than the fewest, 2 on node1, among the 3 scored nodes. Its flavour score was 0 of 100 with the Spread strategy, and other scores outweighed the flavour balance.",
+		},
+		{
+			name:     "proportional strategy",
+			strategy: pluginConfig.FlavourScoringProportional,
+			node:     "node2",
+			want:     "Normal FlavourScored Node node2 had 3 pods of flavour gold, more than the fewest, 2 on node1, among the 3 scored nodes. Its flavour score was 67 of 100 with the Proportional strategy, and other scores outweighed the flavour balance.",
+		},
+		{
+			// A pod bound without scoring, such as to the only feasible node, is not explained.
+			name:     "unscored cycle",
+			node:     "node1",
+			unscored: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(1)
+			f := newTestPlugin(nodes, map[string]map[string]int{"node1": {"gold": 2}, "node2": {"gold": 3}, "node3": {"gold": 5}})
+			f.scoringStrategy = tt.strategy
+			f.recorder = recorder
+			pod := makePod("default", "p", "", flavoured("gold"))
+
+			state := framework.NewCycleState()
+			if !tt.unscored {
+				if status := f.PreScore(context.Background(), state, pod, nil); !status.IsSuccess() {
+					t.Fatalf("unexpected status: %v", status)
+				}
+				var scores framework.NodeScoreList
+				for _, node := range nodes {
+					nodeInfo := framework.NewNodeInfo()
+					nodeInfo.SetNode(node)
+					score, status := f.Score(context.Background(), state, pod, nodeInfo)
+					if !status.IsSuccess() {
+						t.Fatalf("unexpected status: %v", status)
+					}
+					scores = append(scores, framework.NodeScore{Name: node.Name, Score: score})
+				}
+				if status := f.NormalizeScore(context.Background(), state, pod, scores); !status.IsSuccess() {
+					t.Fatalf("unexpected status: %v", status)
+				}
+			}
+			f.PostBind(context.Background(), state, pod, tt.node)
+
+			select {
+			case event := <-recorder.Events:
+				if event != tt.want {
+					t.Errorf("expected event %q, got %q", tt.want, event)
+				}
+			default:
+				if tt.want != "" {
+					t.Errorf("expected event %q, got none", tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/backend/cache"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+const (
+	// selfTestFlavour is the flavour of the pods of the self-test cluster.
+	selfTestFlavour = "gold"
+	// selfTestNamespace is the namespace of the pods of the self-test cluster, unless the args only
+	// include others.
+	selfTestNamespace = "flavour-self-test"
+	// selfTestTimeout bounds the wait for the first cache refresh of the self-test.
+	selfTestTimeout = 10 * time.Second
+)
+
+// selfTestHandle is the framework handle of the self-test and of SimulatePlacement, which only provides
+// the scheduler snapshot.
+type selfTestHandle struct {
+	framework.Handle
+	snapshot *cache.Snapshot
+}
+
+func (h *selfTestHandle) SnapshotSharedLister() framework.SharedLister {
+	return h.snapshot
+}
+
+// SelfTest runs the plugin configured with obj against an in-memory fake cluster of three worker nodes
+// hosting 2, 1 and 0 pods of a flavour: it validates the args, builds the cache, and runs a scheduling
+// cycle for a pending pod of that flavour, from PreFilter to NormalizeScore. It returns an error naming
+// the step that failed, so that deployment pipelines can run it as a pre-flight check of a scheduler
+// build and configuration. The admin service, the CloudEvents sink and the remote cache are left out, as
+// they would reach out of the process.
+func SelfTest(ctx context.Context, obj runtime.Object) error {
+	args, err := getArgs(obj)
+	if err != nil {
+		return fmt.Errorf("validating args: %v", err)
+	}
+	selfTestArgs := *args
+	selfTestArgs.AdminAddress = ""
+	selfTestArgs.CloudEventsSink = ""
+	selfTestArgs.RemoteCache = nil
+
+	namespace := selfTestNamespace
+	if len(args.Namespaces.Include) > 0 {
+		namespace = args.Namespaces.Include[0]
+	}
+	nodes, pods, err := selfTestCluster(&selfTestArgs, namespace)
+	if err != nil {
+		return fmt.Errorf("building the fake cluster: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	f, err := newFakeClusterPlugin(ctx, &selfTestArgs, nodes, pods)
+	if err != nil {
+		return fmt.Errorf("creating the plugin: %v", err)
+	}
+	defer f.Close()
+
+	if err := f.selfTestCache(ctx, nodes); err != nil {
+		return fmt.Errorf("building the cache: %v", err)
+	}
+	if err := f.selfTestCycle(ctx, namespace); err != nil {
+		return fmt.Errorf("running a scheduling cycle: %v", err)
+	}
+	return nil
+}
+
+// newFakeClusterPlugin creates the plugin with args against an in-memory fake cluster of the nodes and
+// pods, whose scheduler snapshot holds the same objects, and starts its informers. The informers stop
+// when ctx is done, and the caller closes the plugin.
+func newFakeClusterPlugin(ctx context.Context, args *pluginConfig.FlavourClusterWideArgs, nodes []*v1.Node, pods []*v1.Pod) (*FlavourClusterWide, error) {
+	objects := make([]runtime.Object, 0, len(nodes)+len(pods))
+	for _, node := range nodes {
+		objects = append(objects, node)
+	}
+	for _, pod := range pods {
+		objects = append(objects, pod)
+	}
+	client := clientsetfake.NewSimpleClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+
+	f, err := NewWithOptions(ctx, args, &selfTestHandle{snapshot: cache.NewSnapshot(pods, nodes)},
+		WithClient(client),
+		WithInformerFactory(informerFactory),
+		WithLogger(logr.Discard()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	informerFactory.Start(ctx.Done())
+	return f, nil
+}
+
+// selfTestCluster returns the nodes and pods of the self-test cluster, built to be in the scope of args:
+// the nodes match the node selector and pass the readiness gate, and the pods are in namespace. node1,
+// node2 and node3 are in zones a, b and c, and host 2, 1 and 0 pods of selfTestFlavour.
+func selfTestCluster(args *pluginConfig.FlavourClusterWideArgs, namespace string) ([]*v1.Node, []*v1.Pod, error) {
+	nodeLabels := map[string]string{}
+	for _, s := range []string{args.NodeLabelSelector, args.NodeReadinessSelector} {
+		selector, err := labels.Parse(s)
+		if err != nil {
+			return nil, nil, err
+		}
+		matching, err := matchingLabels(selector)
+		if err != nil {
+			return nil, nil, err
+		}
+		for key, value := range matching {
+			nodeLabels[key] = value
+		}
+	}
+	conditions := []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}
+	for _, condition := range args.NodeReadinessConditions {
+		conditions = append(conditions, v1.NodeCondition{Type: v1.NodeConditionType(condition), Status: v1.ConditionTrue})
+	}
+	capacity := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("8"),
+		v1.ResourceMemory: resource.MustParse("32Gi"),
+		v1.ResourcePods:   resource.MustParse("110"),
+	}
+
+	labelName := args.LabelName
+	if labelName == "" {
+		labelName = defaultLabelName
+	}
+	var nodes []*v1.Node
+	var pods []*v1.Pod
+	for i, zone := range []string{"a", "b", "c"} {
+		name := fmt.Sprintf("node%d", i+1)
+		node := &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{
+				v1.LabelHostname:       name,
+				v1.LabelTopologyZone:   "zone-" + zone,
+				v1.LabelTopologyRegion: "region",
+			}},
+			Status: v1.NodeStatus{Conditions: conditions, Capacity: capacity, Allocatable: capacity},
+		}
+		for key, value := range nodeLabels {
+			node.Labels[key] = value
+		}
+		nodes = append(nodes, node)
+		for j := 0; j < 2-i; j++ {
+			pods = append(pods, selfTestPod(namespace, fmt.Sprintf("%s-%d", name, j), name, labelName))
+		}
+	}
+	return nodes, pods, nil
+}
+
+// selfTestPod returns a pod of selfTestFlavour bound to nodeName, or pending when it is empty.
+func selfTestPod(namespace, name, nodeName, labelName string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			UID:       types.UID(namespace + "/" + name),
+			Labels:    map[string]string{labelName: selfTestFlavour},
+		},
+		Spec: v1.PodSpec{
+			NodeName:      nodeName,
+			SchedulerName: v1.DefaultSchedulerName,
+			Containers: []v1.Container{{
+				Name: "app",
+				Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("128Mi"),
+				}},
+			}},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+// matchingLabels returns labels matching selector, taking the lowest value of the set-based requirements.
+func matchingLabels(selector labels.Selector) (map[string]string, error) {
+	requirements, _ := selector.Requirements()
+	matching := make(map[string]string)
+	for _, requirement := range requirements {
+		values := requirement.Values().List()
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			matching[requirement.Key()] = values[0]
+		case selection.Exists:
+			matching[requirement.Key()] = ""
+		case selection.GreaterThan, selection.LessThan:
+			bound, err := strconv.Atoi(values[0])
+			if err != nil {
+				return nil, err
+			}
+			if requirement.Operator() == selection.GreaterThan {
+				bound++
+			} else {
+				bound--
+			}
+			matching[requirement.Key()] = strconv.Itoa(bound)
+		}
+	}
+	if !selector.Matches(labels.Set(matching)) {
+		return nil, fmt.Errorf("no node labels match the selector %q", selector)
+	}
+	return matching, nil
+}
+
+// waitForCache waits for the first cache refresh of the plugin, for up to selfTestTimeout.
+func (f *FlavourClusterWide) waitForCache(ctx context.Context) error {
+	err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, selfTestTimeout, true, func(context.Context) (bool, error) {
+		f.cacheMutex.RLock()
+		defer f.cacheMutex.RUnlock()
+		return !f.lastUpdated.IsZero(), nil
+	})
+	if err != nil {
+		return fmt.Errorf("the cache was not refreshed within %v", selfTestTimeout)
+	}
+	return nil
+}
+
+// selfTestCache waits for the first cache refresh and checks that the cache counts the pods of every node.
+func (f *FlavourClusterWide) selfTestCache(ctx context.Context, nodes []*v1.Node) error {
+	if err := f.waitForCache(ctx); err != nil {
+		return err
+	}
+
+	f.cacheMutex.RLock()
+	defer f.cacheMutex.RUnlock()
+	counts := make([]int, 0, len(nodes))
+	for _, node := range nodes {
+		nodeCounts, ok := f.cache[node.Name]
+		if !ok {
+			return fmt.Errorf("node %s is missing from the cache %v", node.Name, f.cache)
+		}
+		counts = append(counts, nodeCounts[selfTestFlavour])
+	}
+	// The counts are in the units of countMode, so only their order is known.
+	if !(counts[0] > counts[1] && counts[1] > counts[2] && counts[2] == 0) {
+		return fmt.Errorf("the cache counts %v pods of flavour %s on nodes %s, %s and %s, want 2, 1 and 0 pods",
+			counts, selfTestFlavour, nodes[0].Name, nodes[1].Name, nodes[2].Name)
+	}
+	return nil
+}
+
+// selfTestCycle runs a scheduling cycle for a pending pod of selfTestFlavour, and checks that its
+// statuses succeed and that the normalized scores are in range. Nodes may be filtered out by the caps,
+// but node3, hosting no pod, always remains.
+func (f *FlavourClusterWide) selfTestCycle(ctx context.Context, namespace string) error {
+	pod := selfTestPod(namespace, "pending", "", f.labelName())
+	scores, _, err := f.runCycle(ctx, pod)
+	if err != nil {
+		return err
+	}
+	if len(scores) == 0 {
+		return fmt.Errorf("Filter rejected every node, including the one hosting no pod")
+	}
+	for _, score := range scores {
+		if score.Score < framework.MinNodeScore || score.Score > framework.MaxNodeScore {
+			return fmt.Errorf("node %s scored %d, out of [%d, %d]", score.Name, score.Score, framework.MinNodeScore, framework.MaxNodeScore)
+		}
+	}
+	return nil
+}
+
+// runCycle runs the extension points of the plugin for the pending pod on every node of the scheduler
+// snapshot, from PreFilter to NormalizeScore. It returns the normalized scores of the nodes passing
+// Filter, and the names of the nodes Filter rejected, or an error naming the extension point whose
+// status failed.
+func (f *FlavourClusterWide) runCycle(ctx context.Context, pod *v1.Pod) (framework.NodeScoreList, []string, error) {
+	state := framework.NewCycleState()
+	nodeInfos, err := f.handle.SnapshotSharedLister().NodeInfos().List()
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, status := f.PreFilter(ctx, state, pod, nodeInfos); !status.IsSuccess() && !status.IsSkip() {
+		return nil, nil, fmt.Errorf("PreFilter: %v", status.AsError())
+	}
+	var feasible []fwk.NodeInfo
+	var rejected []string
+	for _, nodeInfo := range nodeInfos {
+		status := f.Filter(ctx, state, pod, nodeInfo)
+		switch {
+		case status.IsSuccess():
+			feasible = append(feasible, nodeInfo)
+		case status.Code() == fwk.Unschedulable || status.Code() == fwk.UnschedulableAndUnresolvable:
+			rejected = append(rejected, nodeInfo.Node().Name)
+		default:
+			return nil, nil, fmt.Errorf("Filter on node %s: %v", nodeInfo.Node().Name, status.AsError())
+		}
+	}
+	if len(feasible) == 0 {
+		return nil, rejected, nil
+	}
+	if status := f.PreScore(ctx, state, pod, feasible); !status.IsSuccess() && !status.IsSkip() {
+		return nil, nil, fmt.Errorf("PreScore: %v", status.AsError())
+	}
+	scores := make(framework.NodeScoreList, 0, len(feasible))
+	for _, nodeInfo := range feasible {
+		score, status := f.Score(ctx, state, pod, nodeInfo)
+		if !status.IsSuccess() {
+			return nil, nil, fmt.Errorf("Score on node %s: %v", nodeInfo.Node().Name, status.AsError())
+		}
+		scores = append(scores, framework.NodeScore{Name: nodeInfo.Node().Name, Score: score})
+	}
+	if status := f.NormalizeScore(ctx, state, pod, scores); !status.IsSuccess() {
+		return nil, nil, fmt.Errorf("NormalizeScore: %v", status.AsError())
+	}
+	return scores, rejected, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+)
+
+func TestSelfTest(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    runtime.Object
+		wantErr string
+	}{
+		{
+			name: "default args",
+		},
+		{
+			name: "scoped nodes and namespaces",
+			args: &cfgv1.FlavourClusterWideArgs{
+				LabelName:               ptr.To("tier"),
+				NodeLabelSelector:       ptr.To("pool in (batch,flavour),!spot"),
+				NodeReadinessSelector:   ptr.To("gpu-drivers=ready"),
+				NodeReadinessConditions: []string{"NetworkReady"},
+				Namespaces:              cfgv1.FlavourNamespaces{Include: []string{"team-a"}},
+				IgnoreOtherSchedulers:   ptr.To(true),
+			},
+		},
+		{
+			name: "informer cache counting requests",
+			args: &cfgv1.FlavourClusterWideArgs{
+				InformerCache: ptr.To(true),
+				CountMode:     cfgv1.FlavourCountResourceWeighted,
+			},
+		},
+		{
+			name: "caps and cost-optimized preset",
+			args: &cfgv1.FlavourClusterWideArgs{
+				Preset:                   cfgv1.FlavourPresetCostOptimized,
+				MaxPodsPerFlavourPerNode: ptr.To[int32](1),
+				MaxPodsPerTopologyDomain: map[string]int32{"gold": 1},
+			},
+		},
+		{
+			name:    "invalid args",
+			args:    &pluginConfig.FlavourClusterWideArgs{LabelName: "not valid"},
+			wantErr: "validating args",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SelfTest(context.Background(), tt.args)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
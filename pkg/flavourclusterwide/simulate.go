@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Placement is where SimulatePlacement would place a pod.
+type Placement struct {
+	// Node is the node with the highest normalized score, the first by name among equal scores, or ""
+	// when Filter rejected every node.
+	Node string
+	// Scores are the normalized scores of the nodes passing Filter, by node name.
+	Scores map[string]int64
+	// Rejected are the names of the nodes Filter rejected.
+	Rejected []string
+}
+
+// SimulatePlacement runs the plugin configured with obj against an in-memory fake cluster of the nodes
+// and pods, and returns where it would place the pending pod: it validates the args, builds the cache,
+// and runs a scheduling cycle for the pod, from PreFilter to NormalizeScore, as SelfTest does. Only the
+// plugin takes part in the placement, not the other plugins of the scheduler. The admin service, the
+// CloudEvents sink, the audit store and the remote cache are left out, as they would reach out of the
+// process.
+func SimulatePlacement(ctx context.Context, obj runtime.Object, nodes []*v1.Node, pods []*v1.Pod, pod *v1.Pod) (*Placement, error) {
+	args, err := getArgs(obj)
+	if err != nil {
+		return nil, fmt.Errorf("validating args: %v", err)
+	}
+	simulationArgs := *args
+	simulationArgs.AdminAddress = ""
+	simulationArgs.CloudEventsSink = ""
+	simulationArgs.AuditStore = nil
+	simulationArgs.RemoteCache = nil
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	f, err := newFakeClusterPlugin(ctx, &simulationArgs, nodes, pods)
+	if err != nil {
+		return nil, fmt.Errorf("creating the plugin: %v", err)
+	}
+	defer f.Close()
+
+	if err := f.waitForCache(ctx); err != nil {
+		return nil, fmt.Errorf("building the cache: %v", err)
+	}
+	scores, rejected, err := f.runCycle(ctx, pod)
+	if err != nil {
+		return nil, fmt.Errorf("running a scheduling cycle: %v", err)
+	}
+
+	placement := &Placement{Scores: make(map[string]int64, len(scores)), Rejected: rejected}
+	for _, score := range scores {
+		placement.Scores[score.Name] = score.Score
+		best := placement.Scores[placement.Node]
+		if placement.Node == "" || score.Score > best || (score.Score == best && score.Name < placement.Node) {
+			placement.Node = score.Name
+		}
+	}
+	return placement, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+)
+
+func TestSimulatePlacement(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	tests := []struct {
+		name    string
+		args    runtime.Object
+		pods    []*v1.Pod
+		want    *Placement
+		wantErr string
+	}{
+		{
+			name: "least loaded node",
+			pods: []*v1.Pod{makePod("default", "p1", "node1", flavoured("gold")), makePod("default", "p2", "node2", flavoured("gold"))},
+			want: &Placement{Node: "node3", Scores: map[string]int64{"node1": 0, "node2": 0, "node3": 100}},
+		},
+		{
+			name: "equal scores go to the first node by name",
+			pods: []*v1.Pod{makePod("default", "p1", "node1", flavoured("gold"))},
+			want: &Placement{Node: "node2", Scores: map[string]int64{"node1": 0, "node2": 100, "node3": 100}},
+		},
+		{
+			name: "every node rejected",
+			args: &cfgv1.FlavourClusterWideArgs{MaxPodsPerFlavourPerNode: ptr.To[int32](1)},
+			pods: []*v1.Pod{
+				makePod("default", "p1", "node1", flavoured("gold")),
+				makePod("default", "p2", "node2", flavoured("gold")),
+				makePod("default", "p3", "node3", flavoured("gold")),
+			},
+			want: &Placement{Scores: map[string]int64{}, Rejected: []string{"node1", "node2", "node3"}},
+		},
+		{
+			name:    "invalid args",
+			args:    &pluginConfig.FlavourClusterWideArgs{LabelName: "not valid"},
+			wantErr: "validating args",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SimulatePlacement(context.Background(), tt.args, nodes, tt.pods, makePod("default", "pending", "", flavoured("gold")))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("unexpected placement (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+)
+
+// WorkerNodeLabelSelector selects the nodes that take part in flavour distribution, unless
+// nodeLabelSelector selects others.
+const WorkerNodeLabelSelector = "node-role.kubernetes.io/worker"
+
+// ListSnapshot lists the schedulable worker nodes and the pods carrying labelName across all namespaces
+// and returns the resulting per-node, per-flavour pod counts. It is the code path used to
+// (re)build the plugin cache and is exported so that tooling reports the same numbers the
+// scheduler scores with. Terminating pods and pods in the default excluded phases are not counted,
+// and the attempts of a completion index of an indexed Job count once.
+func ListSnapshot(ctx context.Context, client kubernetes.Interface, labelName string) (map[string]map[string]int, error) {
+	return ListNodeSnapshot(ctx, client, labelName, WorkerNodeLabelSelector)
+}
+
+// ListNodeSnapshot is ListSnapshot for the nodes matching the label selector nodeSelector instead of the
+// worker nodes, as configured with nodeLabelSelector. An empty selector selects every schedulable node, and
+// the cordoned nodes are left out whatever the selector, see SchedulableNodes.
+func ListNodeSnapshot(ctx context.Context, client kubernetes.Interface, labelName, nodeSelector string) (map[string]map[string]int, error) {
+	nodes, pods, err := listSnapshotObjects(ctx, client, labelName, nodeSelector)
+	if err != nil {
+		return nil, err
+	}
+	nodes, pods = SchedulableNodes(nodes, pods)
+	return BuildSnapshot(nodes, activePods(pods, cfgv1.DefaultExcludedPodPhases), labelName), nil
+}
+
+// listSnapshotObjects lists the nodes matching nodeSelector and the pods a snapshot is built from.
+func listSnapshotObjects(ctx context.Context, client kubernetes.Interface, labelName, nodeSelector string) ([]v1.Node, []v1.Pod, error) {
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: nodeSelector,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	// Query pods that have the label (any value)
+	pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		LabelSelector: labelName,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing pods: %v", err)
+	}
+
+	return nodes.Items, pods.Items, nil
+}
+
+// ownPods returns the pods scheduled by the given scheduler.
+func ownPods(pods []v1.Pod, schedulerName string) []v1.Pod {
+	kept := make([]v1.Pod, 0, len(pods))
+	for i := range pods {
+		if podSchedulerName(&pods[i]) == schedulerName {
+			kept = append(kept, pods[i])
+		}
+	}
+	return kept
+}
+
+// namespaceScope is the namespaces whose pods are accounted for, as configured with namespaces. A nil
+// scope accounts for every namespace.
+type namespaceScope struct {
+	include sets.Set[string]
+	exclude sets.Set[string]
+}
+
+// newNamespaceScope returns the scope of the configured namespaces, or nil when they select every
+// namespace.
+func newNamespaceScope(namespaces pluginConfig.FlavourNamespaces) *namespaceScope {
+	if len(namespaces.Include) == 0 && len(namespaces.Exclude) == 0 {
+		return nil
+	}
+	return &namespaceScope{include: sets.New(namespaces.Include...), exclude: sets.New(namespaces.Exclude...)}
+}
+
+// accounts returns true when the pods of the namespace are accounted for.
+func (s *namespaceScope) accounts(namespace string) bool {
+	if s == nil {
+		return true
+	}
+	return (s.include.Len() == 0 || s.include.Has(namespace)) && !s.exclude.Has(namespace)
+}
+
+// scopedPods returns the pods of the namespaces accounted for by the scope.
+func scopedPods(pods []v1.Pod, scope *namespaceScope) []v1.Pod {
+	kept := make([]v1.Pod, 0, len(pods))
+	for i := range pods {
+		if scope.accounts(pods[i].Namespace) {
+			kept = append(kept, pods[i])
+		}
+	}
+	return kept
+}
+
+// flavourOf returns the flavour of the pod, or "" when the pod does not have the flavour label or its
+// namespace is not accounted for, so that such pods are neither counted nor scored.
+func (f *FlavourClusterWide) flavourOf(pod *v1.Pod) string {
+	if !f.namespaces.accounts(pod.Namespace) {
+		return ""
+	}
+	return pod.Labels[f.labelName()]
+}
+
+// activePods returns the pods that are neither terminating nor in one of the excluded phases, such as
+// the pods of completed Jobs, which no longer use their node. Of the attempts of a completion index of
+// an indexed Job, only the latest is returned.
+func activePods(pods []v1.Pod, excludedPhases []v1.PodPhase) []v1.Pod {
+	kept := make([]v1.Pod, 0, len(pods))
+	for i := range pods {
+		if isActivePod(&pods[i], excludedPhases) {
+			kept = append(kept, pods[i])
+		}
+	}
+	return latestAttempts(kept)
+}
+
+func isActivePod(pod *v1.Pod, excludedPhases []v1.PodPhase) bool {
+	return pod.DeletionTimestamp == nil && !slices.Contains(excludedPhases, pod.Status.Phase)
+}
+
+// selectsNode returns true when the node matches nodeLabelSelector and takes part in the distribution.
+// Cordoned nodes take no part in it, whatever the selector, see SchedulableNodes.
+func (f *FlavourClusterWide) selectsNode(node *v1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	return f.nodeSelector.Matches(labels.Set(node.Labels))
+}
+
+// SchedulableNodes returns the nodes that are not cordoned, and the pods not bound to the cordoned nodes,
+// as the nodes selected by nodeLabelSelector are the schedulable ones only. Dropping their pods keeps cordoned
+// nodes out of the snapshot altogether, as BuildSnapshot adds an entry for every node with pods.
+func SchedulableNodes(nodes []v1.Node, pods []v1.Pod) ([]v1.Node, []v1.Pod) {
+	cordoned := sets.New[string]()
+	schedulable := make([]v1.Node, 0, len(nodes))
+	for i := range nodes {
+		if nodes[i].Spec.Unschedulable {
+			cordoned.Insert(nodes[i].Name)
+		} else {
+			schedulable = append(schedulable, nodes[i])
+		}
+	}
+	if cordoned.Len() == 0 {
+		return nodes, pods
+	}
+
+	kept := make([]v1.Pod, 0, len(pods))
+	for i := range pods {
+		if !cordoned.Has(pods[i].Spec.NodeName) {
+			kept = append(kept, pods[i])
+		}
+	}
+	return schedulable, kept
+}
+
+// podSchedulerName returns the scheduler of the pod. Pods without a scheduler name are assumed to be
+// scheduled by the default scheduler, as the API server defaults them.
+func podSchedulerName(pod *v1.Pod) string {
+	if pod.Spec.SchedulerName == "" {
+		return v1.DefaultSchedulerName
+	}
+	return pod.Spec.SchedulerName
+}
+
+// snapshotRevision fingerprints the resourceVersions of the objects a snapshot is built from.
+// Two listings with the same revision produce the same snapshot, so a rebuild can be skipped.
+// List-level resourceVersions are not used because they move with every write in the cluster.
+func snapshotRevision(nodes []v1.Node, pods []v1.Pod) uint64 {
+	keys := make([]string, 0, len(nodes)+len(pods))
+	for i := range nodes {
+		keys = append(keys, "node/"+nodes[i].Name+"/"+nodes[i].ResourceVersion)
+	}
+	for i := range pods {
+		keys = append(keys, "pod/"+string(pods[i].UID)+"/"+pods[i].ResourceVersion)
+	}
+	sort.Strings(keys)
+
+	h := fnv.New64a()
+	for _, key := range keys {
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
+// BuildSnapshot counts the bound pods per node and flavour. Every node gets an entry for every
+// flavour discovered in pods, so that nodes without pods of a flavour report an explicit 0.
+func BuildSnapshot(nodes []v1.Node, pods []v1.Pod, labelName string) map[string]map[string]int {
+	return buildSnapshot(nodes, pods, labelName, countPod)
+}
+
+// buildSnapshot is BuildSnapshot with every pod counting its weight, see podWeight.
+func buildSnapshot(nodes []v1.Node, pods []v1.Pod, labelName string, weight func(*v1.Pod) int) map[string]map[string]int {
+	snapshot := make(map[string]map[string]int)
+	discoveredFlavours := make(map[string]bool)
+
+	// First pass: discover all unique flavour values from pods
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		flavour := pod.Labels[labelName]
+		if flavour != "" {
+			discoveredFlavours[flavour] = true
+		}
+	}
+
+	// Initialize the snapshot for all nodes with discovered flavours
+	for _, node := range nodes {
+		snapshot[node.Name] = make(map[string]int)
+		for flavour := range discoveredFlavours {
+			snapshot[node.Name][flavour] = 0
+		}
+	}
+
+	// Second pass: count pods per node and flavour
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		node := pod.Spec.NodeName
+		flavour := pod.Labels[labelName]
+		if flavour == "" {
+			continue
+		}
+
+		if _, exists := snapshot[node]; !exists {
+			snapshot[node] = make(map[string]int)
+		}
+		snapshot[node][flavour] += weight(&pod)
+	}
+
+	return snapshot
+}
+
+// reconcileSnapshot updates snapshot in place to the counts buildSnapshot returns for the same nodes,
+// pods and weight. The maps of the nodes that remain are reused, so that large caches are rebuilt without
+// a second copy of them being allocated next to the current one.
+func reconcileSnapshot(snapshot map[string]map[string]int, nodes []v1.Node, pods []v1.Pod, labelName string, weight func(*v1.Pod) int) {
+	discoveredFlavours := make(map[string]bool)
+	for i := range pods {
+		if pods[i].Spec.NodeName == "" {
+			continue
+		}
+		if flavour := pods[i].Labels[labelName]; flavour != "" {
+			discoveredFlavours[flavour] = true
+		}
+	}
+	listed := make(map[string]bool, len(nodes))
+	for i := range nodes {
+		listed[nodes[i].Name] = true
+	}
+
+	// Reset the nodes that remain to the discovered flavours, and drop the others: nodes that are not
+	// listed only get entries for the flavours of their pods, as in BuildSnapshot.
+	for node, counts := range snapshot {
+		if !listed[node] {
+			delete(snapshot, node)
+			continue
+		}
+		for flavour := range counts {
+			if !discoveredFlavours[flavour] {
+				delete(counts, flavour)
+			}
+		}
+		for flavour := range discoveredFlavours {
+			counts[flavour] = 0
+		}
+	}
+	for node := range listed {
+		if _, exists := snapshot[node]; exists {
+			continue
+		}
+		snapshot[node] = make(map[string]int, len(discoveredFlavours))
+		for flavour := range discoveredFlavours {
+			snapshot[node][flavour] = 0
+		}
+	}
+
+	for i := range pods {
+		node := pods[i].Spec.NodeName
+		flavour := pods[i].Labels[labelName]
+		if node == "" || flavour == "" {
+			continue
+		}
+		if _, exists := snapshot[node]; !exists {
+			snapshot[node] = make(map[string]int)
+		}
+		snapshot[node][flavour] += weight(&pods[i])
+	}
+}
+
+// estimatedSnapshotSize estimates the number of node and flavour entries of a snapshot of the nodes,
+// assuming the flavours of the current snapshot.
+func estimatedSnapshotSize(snapshot map[string]map[string]int, nodes []v1.Node) int {
+	for _, counts := range snapshot {
+		return len(nodes) * len(counts)
+	}
+	return 0
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func makeNode(name string, labels map[string]string) *v1.Node {
+	return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func makeWorker(name string) *v1.Node {
+	return makeNode(name, map[string]string{WorkerNodeLabelSelector: ""})
+}
+
+func makePod(namespace, name, nodeName string, labels map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+		Spec:       v1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func flavoured(flavour string) map[string]string {
+	return map[string]string{"flavour": flavour}
+}
+
+func TestBuildSnapshot(t *testing.T) {
+	tests := []struct {
+		name  string
+		nodes []v1.Node
+		pods  []v1.Pod
+		want  map[string]map[string]int
+	}{
+		{
+			name:  "no pods",
+			nodes: []v1.Node{*makeWorker("node1"), *makeWorker("node2")},
+			want:  map[string]map[string]int{"node1": {}, "node2": {}},
+		},
+		{
+			name:  "counts across namespaces and seeds every node with every flavour",
+			nodes: []v1.Node{*makeWorker("node1"), *makeWorker("node2")},
+			pods: []v1.Pod{
+				*makePod("default", "p1", "node1", flavoured("gold")),
+				*makePod("prod", "p2", "node1", flavoured("gold")),
+				*makePod("prod", "p3", "node2", flavoured("silver")),
+			},
+			want: map[string]map[string]int{
+				"node1": {"gold": 2, "silver": 0},
+				"node2": {"gold": 0, "silver": 1},
+			},
+		},
+		{
+			name:  "pending and unlabelled pods are ignored",
+			nodes: []v1.Node{*makeWorker("node1")},
+			pods: []v1.Pod{
+				*makePod("default", "p1", "", flavoured("gold")),
+				*makePod("default", "p2", "node1", nil),
+				*makePod("default", "p3", "node1", flavoured("")),
+			},
+			want: map[string]map[string]int{"node1": {}},
+		},
+		{
+			name:  "pods on unknown nodes still get an entry",
+			nodes: []v1.Node{*makeWorker("node1")},
+			pods:  []v1.Pod{*makePod("default", "p1", "master", flavoured("gold"))},
+			want: map[string]map[string]int{
+				"node1":  {"gold": 0},
+				"master": {"gold": 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildSnapshot(tt.nodes, tt.pods, "flavour")
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected snapshot (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListSnapshot(t *testing.T) {
+	client := clientsetfake.NewSimpleClientset(
+		makeWorker("node1"),
+		makeWorker("node2"),
+		makeNode("master", nil),
+		makePod("default", "p1", "node1", flavoured("gold")),
+		makePod("kube-system", "p2", "node2", flavoured("bronze")),
+		makePod("default", "p3", "node2", map[string]string{"tier": "gold"}),
+	)
+
+	got, err := ListSnapshot(context.Background(), client, "flavour")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]map[string]int{
+		"node1": {"gold": 1, "bronze": 0},
+		"node2": {"gold": 0, "bronze": 1},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected snapshot (-want,+got):\n%s", diff)
+	}
+}
+
+func TestListNodeSnapshotCordoned(t *testing.T) {
+	cordoned := makeWorker("node3")
+	cordoned.Spec.Unschedulable = true
+	client := clientsetfake.NewSimpleClientset(
+		makeWorker("node1"),
+		makeNode("node2", nil),
+		cordoned,
+		makePod("default", "p1", "node1", flavoured("gold")),
+		makePod("default", "p2", "node3", flavoured("gold")),
+		makePod("default", "p3", "node3", flavoured("bronze")),
+	)
+
+	tests := []struct {
+		name     string
+		selector string
+		want     map[string]map[string]int
+	}{
+		{
+			name:     "cordoned worker and its pods left out by the worker selector",
+			selector: WorkerNodeLabelSelector,
+			want: map[string]map[string]int{
+				"node1": {"gold": 1},
+			},
+		},
+		{
+			name: "cordoned node and its pods left out by the empty selector",
+			want: map[string]map[string]int{
+				"node1": {"gold": 1},
+				"node2": {"gold": 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ListNodeSnapshot(context.Background(), client, "flavour", tt.selector)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected snapshot (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSnapshotRevision(t *testing.T) {
+	withRV := func(pod *v1.Pod, uid, rv string) v1.Pod {
+		pod.UID = types.UID(uid)
+		pod.ResourceVersion = rv
+		return *pod
+	}
+	nodes := []v1.Node{*makeWorker("node1"), *makeWorker("node2")}
+	pods := []v1.Pod{
+		withRV(makePod("default", "p1", "node1", flavoured("gold")), "uid1", "10"),
+		withRV(makePod("default", "p2", "node2", flavoured("gold")), "uid2", "11"),
+	}
+	base := snapshotRevision(nodes, pods)
+
+	reordered := []v1.Pod{pods[1], pods[0]}
+	if got := snapshotRevision(nodes, reordered); got != base {
+		t.Errorf("expected listing order not to change the revision")
+	}
+
+	updated := []v1.Pod{pods[0], withRV(makePod("default", "p2", "node1", flavoured("gold")), "uid2", "12")}
+	if got := snapshotRevision(nodes, updated); got == base {
+		t.Errorf("expected a pod update to change the revision")
+	}
+
+	if got := snapshotRevision(nodes[:1], pods); got == base {
+		t.Errorf("expected a removed node to change the revision")
+	}
+}
+
+func TestReconcileSnapshot(t *testing.T) {
+	nodes := []v1.Node{*makeWorker("node1"), *makeWorker("node2")}
+	tests := []struct {
+		name  string
+		stale map[string]map[string]int
+		pods  []v1.Pod
+	}{
+		{
+			name:  "empty snapshot",
+			stale: map[string]map[string]int{},
+			pods:  []v1.Pod{*makePod("default", "p1", "node1", flavoured("gold"))},
+		},
+		{
+			name: "removed nodes and flavours are dropped",
+			stale: map[string]map[string]int{
+				"node1":  {"gold": 3, "bronze": 1},
+				"node3":  {"gold": 2, "bronze": 0},
+				"master": {"bronze": 1},
+			},
+			pods: []v1.Pod{
+				*makePod("default", "p1", "node1", flavoured("gold")),
+				*makePod("default", "p2", "node2", flavoured("silver")),
+				*makePod("default", "p3", "master", flavoured("gold")),
+			},
+		},
+		{
+			name:  "no pods left",
+			stale: map[string]map[string]int{"node1": {"gold": 2}, "node2": {"gold": 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reused := tt.stale["node1"]
+			reconcileSnapshot(tt.stale, nodes, tt.pods, "flavour", countPod)
+			if diff := cmp.Diff(BuildSnapshot(nodes, tt.pods, "flavour"), tt.stale); diff != "" {
+				t.Errorf("unexpected snapshot (-want,+got):\n%s", diff)
+			}
+			if reused != nil && reflect.ValueOf(reused).Pointer() != reflect.ValueOf(tt.stale["node1"]).Pointer() {
+				t.Errorf("expected the counts of node1 to be updated in place")
+			}
+		})
+	}
+}
+
+func TestEstimatedSnapshotSize(t *testing.T) {
+	nodes := []v1.Node{*makeWorker("node1"), *makeWorker("node2"), *makeWorker("node3")}
+	if got := estimatedSnapshotSize(map[string]map[string]int{"node1": {"gold": 1, "silver": 0}}, nodes); got != 6 {
+		t.Errorf("expected 6 entries, got %d", got)
+	}
+	if got := estimatedSnapshotSize(map[string]map[string]int{}, nodes); got != 0 {
+		t.Errorf("expected 0 entries for an empty snapshot, got %d", got)
+	}
+}
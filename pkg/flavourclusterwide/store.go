@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// flavourStore lists the nodes and the flavoured pods the cache is rebuilt from. The scheduling logic
+// only sees the cache built from them, so another backend only has to implement List.
+type flavourStore interface {
+	// List returns the nodes matching nodeSelector and the pods carrying the label labelName.
+	List(ctx context.Context, labelName string, nodeSelector labels.Selector) ([]v1.Node, []v1.Pod, error)
+}
+
+var (
+	_ flavourStore = apiStore{}
+	_ flavourStore = listerStore{}
+)
+
+// apiStore lists the nodes and pods from the API server, every list bounded by apiCallTimeout.
+type apiStore struct {
+	client kubernetes.Interface
+}
+
+func (s apiStore) List(ctx context.Context, labelName string, nodeSelector labels.Selector) ([]v1.Node, []v1.Pod, error) {
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+	return listSnapshotObjects(ctx, s.client, labelName, nodeSelector.String())
+}
+
+// listerStore lists the nodes and pods from the informers, as apiStore does from the API server.
+type listerStore struct {
+	nodes corelisters.NodeLister
+	pods  corelisters.PodLister
+}
+
+func (s listerStore) List(_ context.Context, labelName string, nodeSelector labels.Selector) ([]v1.Node, []v1.Pod, error) {
+	flavoured, err := labels.Parse(labelName)
+	if err != nil {
+		return nil, nil, err
+	}
+	nodeList, err := s.nodes.List(nodeSelector)
+	if err != nil {
+		return nil, nil, err
+	}
+	podList, err := s.pods.List(flavoured)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodes := make([]v1.Node, 0, len(nodeList))
+	for _, node := range nodeList {
+		nodes = append(nodes, *node)
+	}
+	pods := make([]v1.Pod, 0, len(podList))
+	for _, pod := range podList {
+		pods = append(pods, *pod)
+	}
+	return nodes, pods, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// staticStore is a flavourStore listing fixed nodes and pods, or failing with err. lists counts the calls.
+type staticStore struct {
+	nodes []v1.Node
+	pods  []v1.Pod
+	err   error
+	lists int
+}
+
+func (s *staticStore) List(context.Context, string, labels.Selector) ([]v1.Node, []v1.Pod, error) {
+	s.lists++
+	return s.nodes, s.pods, s.err
+}
+
+func TestFlavourStore(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	f := newTestPlugin(nodes, nil)
+	f.logger = logr.Discard()
+	store := &staticStore{
+		nodes: []v1.Node{*nodes[0], *nodes[1]},
+		pods:  []v1.Pod{*makePod("default", "p1", "node1", flavoured("gold"))},
+	}
+	f.store = store
+
+	// The cache is rebuilt from whatever the store lists.
+	f.reconcile.Store(true)
+	f.updateCacheIfNeeded(context.Background())
+	expectCache(t, f, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 0}})
+
+	// A failing store keeps the current cache.
+	store.err = errors.New("unavailable")
+	f.reconcile.Store(true)
+	f.updateCacheIfNeeded(context.Background())
+	expectCache(t, f, map[string]map[string]int{"node1": {"gold": 1}, "node2": {"gold": 0}})
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"math"
+
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+// spreadScore scores the nodes that would receive pods when the batch is placed on the least loaded
+// nodes, in proportion to the number of pods they receive. Without a batch only the least loaded
+// nodes score. Every placed pod adds step to the count of its node.
+func spreadScore(counts []int, minPods, podCount, batch, step int) int64 {
+	if len(counts) == 0 || podCount < minPods {
+		return 0
+	}
+	level := waterLevel(counts, batch, step)
+	if podCount >= level {
+		return 0
+	}
+	received := func(count int) int64 {
+		return int64((level-1-count)/step + 1)
+	}
+	return framework.MaxNodeScore * received(podCount) / received(minPods)
+}
+
+// varianceReductionScore scores a node holding podCount pods inversely to the variance of counts once
+// the pod, adding step to the count, is placed on it. The node leaving the lowest variance gets the
+// maximum score and the node leaving the highest one gets 0; when every placement is equivalent all
+// nodes get the maximum score.
+func varianceReductionScore(counts []int, podCount, step int) int64 {
+	if len(counts) == 0 {
+		return 0
+	}
+	lowest, highest := counts[0], counts[0]
+	for _, count := range counts {
+		lowest = min(lowest, count)
+		highest = max(highest, count)
+	}
+	podCount = max(podCount, lowest)
+
+	best := varianceAfterPlacement(counts, lowest, step)
+	worst := varianceAfterPlacement(counts, highest, step)
+	if worst <= best {
+		return framework.MaxNodeScore
+	}
+	variance := varianceAfterPlacement(counts, podCount, step)
+	return int64(math.Round(float64(framework.MaxNodeScore) * (worst - variance) / (worst - best)))
+}
+
+// proportionalScore scores a node holding podCount pods linearly between the least loaded node of
+// counts, which gets the maximum score, and the most loaded one, which gets 0. Unlike spreadScore, the
+// nodes above the minimum remain distinguishable for the other score plugins. When every node holds
+// the same count all nodes get the maximum score.
+func proportionalScore(counts []int, podCount int) int64 {
+	if len(counts) == 0 {
+		return 0
+	}
+	lowest, highest := counts[0], counts[0]
+	for _, count := range counts {
+		lowest = min(lowest, count)
+		highest = max(highest, count)
+	}
+	if highest == lowest {
+		return framework.MaxNodeScore
+	}
+	podCount = min(max(podCount, lowest), highest)
+	return int64(math.Round(float64(framework.MaxNodeScore) * float64(highest-podCount) / float64(highest-lowest)))
+}
+
+// binPackScore scores a node holding podCount pods linearly between the most loaded node of counts,
+// which gets the maximum score, and the least loaded one, which gets 0, so that the pods of the flavour
+// are packed onto as few nodes as possible. When every node holds the same count all nodes get the
+// maximum score.
+func binPackScore(counts []int, podCount int) int64 {
+	if len(counts) == 0 {
+		return 0
+	}
+	lowest, highest := counts[0], counts[0]
+	for _, count := range counts {
+		lowest = min(lowest, count)
+		highest = max(highest, count)
+	}
+	if highest == lowest {
+		return framework.MaxNodeScore
+	}
+	podCount = min(max(podCount, lowest), highest)
+	return int64(math.Round(float64(framework.MaxNodeScore) * float64(podCount-lowest) / float64(highest-lowest)))
+}
+
+// varianceAfterPlacement returns the population variance of counts once step is added to the count
+// of a node currently holding count.
+func varianceAfterPlacement(counts []int, count, step int) float64 {
+	var sum, sumOfSquares float64
+	for _, c := range counts {
+		sum += float64(c)
+		sumOfSquares += float64(c) * float64(c)
+	}
+	n := float64(len(counts))
+	sumOfSquares += float64(2*count*step + step*step)
+	mean := (sum + float64(step)) / n
+	return sumOfSquares/n - mean*mean
+}
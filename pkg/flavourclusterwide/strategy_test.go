@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestVarianceAfterPlacement(t *testing.T) {
+	// Placing on the node with 1 pod yields {2, 2, 2}, on the node with 2 pods yields {1, 3, 2}.
+	counts := []int{1, 2, 2}
+	if got := varianceAfterPlacement(counts, 1, 1); math.Abs(got) > 1e-9 {
+		t.Errorf("expected a variance of 0, got %v", got)
+	}
+	if got, want := varianceAfterPlacement(counts, 2, 1), 2.0/3.0; math.Abs(got-want) > 1e-9 {
+		t.Errorf("expected a variance of %v, got %v", want, got)
+	}
+}
+
+func TestVarianceReductionScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		counts   []int
+		podCount int
+		want     int64
+	}{
+		{name: "no counts", podCount: 0, want: 0},
+		{name: "least loaded node", counts: []int{0, 2, 6}, podCount: 0, want: 100},
+		{name: "intermediate node", counts: []int{0, 2, 6}, podCount: 2, want: 67},
+		{name: "most loaded node", counts: []int{0, 2, 6}, podCount: 6, want: 0},
+		{name: "even distribution", counts: []int{3, 3, 3}, podCount: 3, want: 100},
+		{name: "node unknown to the cache", counts: []int{1, 4}, podCount: 0, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := varianceReductionScore(tt.counts, tt.podCount, 1); got != tt.want {
+				t.Errorf("expected score %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProportionalScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		counts   []int
+		podCount int
+		want     int64
+	}{
+		{name: "no counts", podCount: 0, want: 0},
+		{name: "least loaded node", counts: []int{1, 3, 5}, podCount: 1, want: 100},
+		{name: "second best node", counts: []int{1, 3, 5}, podCount: 3, want: 50},
+		{name: "most loaded node", counts: []int{1, 3, 5}, podCount: 5, want: 0},
+		{name: "rounded score", counts: []int{0, 1, 3}, podCount: 1, want: 67},
+		{name: "even distribution", counts: []int{3, 3, 3}, podCount: 3, want: 100},
+		{name: "node unknown to the cache", counts: []int{1, 4}, podCount: 0, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := proportionalScore(tt.counts, tt.podCount); got != tt.want {
+				t.Errorf("expected score %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBinPackScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		counts   []int
+		podCount int
+		want     int64
+	}{
+		{name: "no counts", podCount: 0, want: 0},
+		{name: "most loaded node", counts: []int{1, 3, 5}, podCount: 5, want: 100},
+		{name: "intermediate node", counts: []int{1, 3, 5}, podCount: 3, want: 50},
+		{name: "least loaded node", counts: []int{1, 3, 5}, podCount: 1, want: 0},
+		{name: "even distribution", counts: []int{0, 0}, podCount: 0, want: 100},
+		{name: "node unknown to the cache", counts: []int{1, 4}, podCount: 0, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := binPackScore(tt.counts, tt.podCount); got != tt.want {
+				t.Errorf("expected score %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScoreStrategies(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2"), makeWorker("node3")}
+	cache := map[string]map[string]int{
+		"node1": {"gold": 1},
+		"node2": {"gold": 2},
+		"node3": {"gold": 5},
+	}
+	tests := []struct {
+		strategy pluginConfig.FlavourScoringStrategy
+		want     map[string]int64
+	}{
+		{strategy: "", want: map[string]int64{"node1": 100, "node2": 0, "node3": 0}},
+		{strategy: pluginConfig.FlavourScoringSpread, want: map[string]int64{"node1": 100, "node2": 0, "node3": 0}},
+		{strategy: pluginConfig.FlavourScoringVarianceReduction, want: map[string]int64{"node1": 100, "node2": 75, "node3": 0}},
+		{strategy: pluginConfig.FlavourScoringProportional, want: map[string]int64{"node1": 100, "node2": 75, "node3": 0}},
+		{strategy: pluginConfig.FlavourScoringBinPack, want: map[string]int64{"node1": 0, "node2": 25, "node3": 100}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.scoringStrategy = tt.strategy
+			got := scoreNodes(t, f, makePod("default", "p", "", flavoured("gold")))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
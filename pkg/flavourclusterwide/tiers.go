@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// tierDistribution is the distribution of a flavour across the topology tiers of one lifecycle rank.
+// The groups of a tier are identified by their path, the values of the keys of the tiers above it and
+// of its own joined with slashes, so that the zones of a region only compete once the region is within
+// its tolerance.
+type tierDistribution struct {
+	// groups are the weighted counts of the groups of every tier.
+	groups []map[string]int
+	// candidates are the counts of the groups of every tier whose group of the tier above is within its
+	// tolerance, and within the candidates within the tolerance of the least loaded one.
+	candidates [][]int
+	within     []sets.Set[string]
+	// nodeCounts are the weighted counts of the nodes whose groups are within tolerance at every tier, and
+	// minPods the smallest of them, or -1 when there are none.
+	nodeCounts []int
+	minPods    int
+}
+
+// splitTopologyTiers returns the tiers of node groups and the tolerance of the nodes, set by a last
+// hostname tier.
+func splitTopologyTiers(tiers []pluginConfig.FlavourTopologyTier) ([]pluginConfig.FlavourTopologyTier, int32) {
+	if n := len(tiers); n > 0 && tiers[n-1].TopologyKey == v1.LabelHostname {
+		return tiers[:n-1], tiers[n-1].Tolerance
+	}
+	return tiers, 0
+}
+
+// tierPaths returns the path of the group of every tier of every node in the scheduler snapshot.
+func (f *FlavourClusterWide) tierPaths() map[string][]string {
+	paths := make(map[string][]string)
+	for i, tier := range f.tiers {
+		for node, value := range f.nodeLabelValues(tier.TopologyKey) {
+			if i == 0 {
+				paths[node] = make([]string, len(f.tiers))
+				paths[node][0] = value
+			} else if path, ok := paths[node]; ok {
+				path[i] = path[i-1] + "/" + value
+			}
+		}
+	}
+	return paths
+}
+
+// parentPath returns the path of the group of the tier above, the path without its last value. Label
+// values never contain slashes.
+func parentPath(path string) string {
+	return path[:max(strings.LastIndex(path, "/"), 0)]
+}
+
+// tolerance returns a tolerance in weighted counts, where a pod counts 100 with age weighting. With a count
+// mode other than Pods, the tolerance is in the units of the mode.
+func (f *FlavourClusterWide) tolerance(pods int32) int {
+	if f.recentWindow > 0 {
+		return int(pods) * 100
+	}
+	return int(pods)
+}
+
+// takeTiers returns the distribution across the tiers of the weighted counts of the nodes of a lifecycle
+// rank. Nodes missing from the scheduler snapshot have no path and are left out.
+func (f *FlavourClusterWide) takeTiers(counts map[string]int, paths map[string][]string) *tierDistribution {
+	t := &tierDistribution{
+		groups:     make([]map[string]int, len(f.tiers)),
+		candidates: make([][]int, len(f.tiers)),
+		within:     make([]sets.Set[string], len(f.tiers)),
+		minPods:    -1,
+	}
+	for i := range f.tiers {
+		t.groups[i] = make(map[string]int)
+	}
+	for node, count := range counts {
+		for i, path := range paths[node] {
+			t.groups[i][path] += count
+		}
+	}
+
+	for i, tier := range f.tiers {
+		t.within[i] = sets.New[string]()
+		for path, count := range t.groups[i] {
+			if i == 0 || t.within[i-1].Has(parentPath(path)) {
+				t.candidates[i] = append(t.candidates[i], count)
+			}
+		}
+		if len(t.candidates[i]) == 0 {
+			continue
+		}
+		limit := minOf(t.candidates[i]) + f.tolerance(tier.Tolerance)
+		for path, count := range t.groups[i] {
+			if (i == 0 || t.within[i-1].Has(parentPath(path))) && count <= limit {
+				t.within[i].Insert(path)
+			}
+		}
+	}
+
+	last := len(f.tiers) - 1
+	for node, count := range counts {
+		if path, ok := paths[node]; ok && t.within[last].Has(path[last]) {
+			t.nodeCounts = append(t.nodeCounts, count)
+			if t.minPods == -1 || count < t.minPods {
+				t.minPods = count
+			}
+		}
+	}
+	return t
+}
+
+// isWithin returns true when the group of the tier is within tolerance, and so are the groups above it.
+// A group without counted nodes, such as the group of a node added since the last rebuild, is the least
+// loaded of its tier.
+func (t *tierDistribution) isWithin(tier int, path string) bool {
+	if t.within[tier].Has(path) {
+		return true
+	}
+	_, counted := t.groups[tier][path]
+	return !counted && (tier == 0 || t.isWithin(tier-1, parentPath(path)))
+}
+
+// tierScore scores a node in the band of the tiers its groups are within tolerance of, from the widest
+// tier down, the bands being those of lifecycleScore. A node whose group of a tier is beyond tolerance
+// scores in the band of that tier with the balance of its group among the candidate groups of the tier,
+// so that it always scores below the nodes whose group is within tolerance. A node within tolerance at
+// every tier scores in the highest band with its balance among the nodes within tolerance at every tier,
+// at the maximum within the node tolerance of the least loaded of them. Placing the pod adds step to the
+// counts. A node added since the last rebuild counts as a node without pods.
+func (f *FlavourClusterWide) tierScore(strategy pluginConfig.FlavourScoringStrategy, t *tierDistribution, path []string, podCount, batch, step int, unknown bool) int64 {
+	bands := len(f.tiers)
+	if path == nil {
+		return lifecycleScore(bands, bands, 0)
+	}
+	if t == nil {
+		// No node of the lifecycle rank is in scope.
+		t = f.takeTiers(nil, nil)
+	}
+	for i := range f.tiers {
+		if !t.isWithin(i, path[i]) {
+			score := balanceScore(strategy, t.candidates[i], t.groups[i][path[i]], 1, step)
+			return lifecycleScore(bands-i, bands, score)
+		}
+	}
+
+	counts, minPods := t.nodeCounts, t.minPods
+	if unknown {
+		// The counts are shared by the nodes scored in parallel.
+		counts = append(slices.Clone(counts), 0)
+		minPods = 0
+	}
+	score := balanceScore(strategy, counts, podCount, batch, step)
+	if f.nodeTolerance > 0 && podCount <= minPods+f.tolerance(f.nodeTolerance) {
+		score = framework.MaxNodeScore
+	}
+	return lifecycleScore(0, bands, score)
+}
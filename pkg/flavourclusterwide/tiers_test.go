@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestScoreTopologyTiers(t *testing.T) {
+	node := func(name, region, zone string) *v1.Node {
+		return makeNode(name, map[string]string{
+			WorkerNodeLabelSelector: "",
+			v1.LabelTopologyRegion:  region,
+			v1.LabelTopologyZone:    zone,
+		})
+	}
+	nodes := []*v1.Node{
+		node("node1", "region-1", "zone-a"),
+		node("node2", "region-1", "zone-b"),
+		node("node3", "region-2", "zone-c"),
+		node("node4", "region-1", "zone-a"),
+	}
+	// region-1 hosts 2 pods and region-2 1 pod, in zone-a and zone-c.
+	cache := map[string]map[string]int{
+		"node1": {"gold": 2},
+		"node2": {"gold": 0},
+		"node3": {"gold": 1},
+		"node4": {"gold": 0},
+	}
+	tier := func(key string, tolerance int32) pluginConfig.FlavourTopologyTier {
+		return pluginConfig.FlavourTopologyTier{TopologyKey: key, Tolerance: tolerance}
+	}
+	tests := []struct {
+		name  string
+		tiers []pluginConfig.FlavourTopologyTier
+		want  map[string]int64
+	}{
+		{
+			name: "no tiers",
+			want: map[string]int64{"node1": 0, "node2": 100, "node3": 0, "node4": 100},
+		},
+		{
+			// region-1 is beyond tolerance, so that its empty nodes lose to the node of region-2.
+			name:  "regions first",
+			tiers: []pluginConfig.FlavourTopologyTier{tier(v1.LabelTopologyRegion, 0), tier(v1.LabelTopologyZone, 0)},
+			want:  map[string]int64{"node1": 0, "node2": 0, "node3": 98, "node4": 0},
+		},
+		{
+			// Both regions are within tolerance, and zone-b is the least loaded zone.
+			name:  "region tolerance",
+			tiers: []pluginConfig.FlavourTopologyTier{tier(v1.LabelTopologyRegion, 1), tier(v1.LabelTopologyZone, 0)},
+			want:  map[string]int64{"node1": 33, "node2": 98, "node3": 33, "node4": 33},
+		},
+		{
+			name:  "zone tolerance",
+			tiers: []pluginConfig.FlavourTopologyTier{tier(v1.LabelTopologyRegion, 1), tier(v1.LabelTopologyZone, 2)},
+			want:  map[string]int64{"node1": 66, "node2": 98, "node3": 66, "node4": 98},
+		},
+		{
+			name: "node tolerance",
+			tiers: []pluginConfig.FlavourTopologyTier{
+				tier(v1.LabelTopologyRegion, 1), tier(v1.LabelTopologyZone, 2), tier(v1.LabelHostname, 1),
+			},
+			want: map[string]int64{"node1": 66, "node2": 98, "node3": 98, "node4": 98},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.tiers, f.nodeTolerance = splitTopologyTiers(tt.tiers)
+			got := scoreNodes(t, f, makePod("default", "p", "", flavoured("gold")))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
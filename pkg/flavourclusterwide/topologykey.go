@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// TopologyKeyAnnotation overrides, for the annotated pod only, the topology its flavour is balanced
+// across. With the hostname label, kubernetes.io/hostname, the pod is balanced across the nodes alone,
+// whatever the configured weights. With another node label key, such as topology.kubernetes.io/zone,
+// it is balanced across the groups of nodes sharing the value of that label alone.
+const TopologyKeyAnnotation = "scheduling.x-k8s.io/flavour-topology-key"
+
+// topologyKey returns the topology key the pod is balanced across, the one of its annotation or else
+// the one of the FlavourPolicy or the configured one, "" when it keeps the balance of the configured
+// weights.
+func (f *FlavourClusterWide) topologyKey(pod *v1.Pod) string {
+	if key, ok := pod.Annotations[TopologyKeyAnnotation]; ok {
+		return key
+	}
+	if t := f.tuning.Load(); t != nil {
+		return t.topologyKey
+	}
+	return f.defaultTopologyKey
+}
+
+// balancesGroups returns true if the topology key balances the pod across groups of nodes rather
+// than across the nodes.
+func balancesGroups(key string) bool {
+	return key != "" && key != v1.LabelHostname
+}
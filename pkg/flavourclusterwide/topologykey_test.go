@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestScoreTopologyKeyOverride(t *testing.T) {
+	zone := func(name, zone string) *v1.Node {
+		return makeNode(name, map[string]string{WorkerNodeLabelSelector: "", v1.LabelTopologyZone: zone})
+	}
+	nodes := []*v1.Node{zone("node1", "zone-a"), zone("node2", "zone-a"), zone("node3", "zone-b")}
+	// node1 is the least loaded node, but zone-b is the least loaded zone.
+	cache := map[string]map[string]int{
+		"node1": {"gold": 0},
+		"node2": {"gold": 2},
+		"node3": {"gold": 1},
+	}
+	tests := []struct {
+		name        string
+		weights     pluginConfig.FlavourScoreWeights
+		topologyKey string
+		annotations map[string]string
+		want        map[string]int64
+	}{
+		{
+			name: "configured node balance",
+			want: map[string]int64{"node1": 100, "node2": 0, "node3": 0},
+		},
+		{
+			name:        "zone override",
+			annotations: map[string]string{TopologyKeyAnnotation: v1.LabelTopologyZone},
+			want:        map[string]int64{"node1": 0, "node2": 0, "node3": 100},
+		},
+		{
+			name:    "configured node and zone balance",
+			weights: pluginConfig.FlavourScoreWeights{NodeBalance: 1, ZoneBalance: 1},
+			want:    map[string]int64{"node1": 50, "node2": 0, "node3": 50},
+		},
+		{
+			name:        "hostname override",
+			weights:     pluginConfig.FlavourScoreWeights{NodeBalance: 1, ZoneBalance: 1},
+			annotations: map[string]string{TopologyKeyAnnotation: v1.LabelHostname},
+			want:        map[string]int64{"node1": 100, "node2": 0, "node3": 0},
+		},
+		{
+			name:        "configured zone key",
+			topologyKey: v1.LabelTopologyZone,
+			want:        map[string]int64{"node1": 0, "node2": 0, "node3": 100},
+		},
+		{
+			name:        "hostname override of the configured zone key",
+			topologyKey: v1.LabelTopologyZone,
+			annotations: map[string]string{TopologyKeyAnnotation: v1.LabelHostname},
+			want:        map[string]int64{"node1": 100, "node2": 0, "node3": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.nodeGroupLabel = v1.LabelTopologyZone
+			f.weights = tt.weights
+			f.defaultTopologyKey = tt.topologyKey
+			pod := makePod("default", "p", "", flavoured("gold"))
+			pod.Annotations = tt.annotations
+			got := scoreNodes(t, f, pod)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
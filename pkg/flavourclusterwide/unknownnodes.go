@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/helper"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// neutralNodesStateKey is the key in CycleState to the nodes missing from the cache that Score gave
+// the neutral score, see stateKey.
+const neutralNodesStateKey = "NeutralNodes"
+
+// neutralNodesState holds the nodes given the neutral score in the cycle. Score records them from
+// parallel goroutines, hence the mutex.
+type neutralNodesState struct {
+	mu    sync.Mutex
+	nodes sets.Set[string]
+}
+
+// Clone the neutral nodes state. The nodes are only recorded by the Score calls of the cycle, so the
+// state itself is returned.
+func (s *neutralNodesState) Clone() fwk.StateData {
+	return s
+}
+
+// startNeutralNodes starts recording the nodes given the neutral score in the cycle, with the Neutral
+// unknown node scoring.
+func (f *FlavourClusterWide) startNeutralNodes(state fwk.CycleState) {
+	if f.unknownNodeScoring != pluginConfig.FlavourUnknownNodeNeutral {
+		return
+	}
+	state.Write(f.stateKey(neutralNodesStateKey), &neutralNodesState{nodes: sets.New[string]()})
+}
+
+// readNeutralNodes returns the neutral nodes state of the cycle, or nil when there is none.
+func (f *FlavourClusterWide) readNeutralNodes(state fwk.CycleState) *neutralNodesState {
+	if state == nil {
+		return nil
+	}
+	c, err := state.Read(f.stateKey(neutralNodesStateKey))
+	if err != nil {
+		return nil
+	}
+	s, ok := c.(*neutralNodesState)
+	if !ok {
+		return nil
+	}
+	return s
+}
+
+// recordNeutralNode records that the node was given the neutral score.
+func (f *FlavourClusterWide) recordNeutralNode(state fwk.CycleState, node string) {
+	s := f.readNeutralNodes(state)
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodes.Insert(node)
+}
+
+// normalizeScores scales the scores so that the best node gets the maximum score, leaving out the nodes
+// given the neutral score: they keep half of the maximum score whatever the scores of the known nodes,
+// and a known node scoring 0 does not turn them into the best nodes of the cycle.
+func (f *FlavourClusterWide) normalizeScores(state fwk.CycleState, scores framework.NodeScoreList) *fwk.Status {
+	s := f.readNeutralNodes(state)
+	if s == nil {
+		return helper.DefaultNormalizeScore(framework.MaxNodeScore, false, scores)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.nodes.Len() == 0 {
+		return helper.DefaultNormalizeScore(framework.MaxNodeScore, false, scores)
+	}
+
+	known := make(framework.NodeScoreList, 0, len(scores))
+	for _, score := range scores {
+		if !s.nodes.Has(score.Name) {
+			known = append(known, score)
+		}
+	}
+	status := helper.DefaultNormalizeScore(framework.MaxNodeScore, false, known)
+	i := 0
+	for j := range scores {
+		if !s.nodes.Has(scores[j].Name) {
+			scores[j].Score = known[i].Score
+			i++
+		}
+	}
+	return status
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxLoggedDiscrepancies bounds the discrepancies listed in the log of a verification.
+const maxLoggedDiscrepancies = 10
+
+// verifyCache builds a snapshot from the scheduler's informers, with the same filters as the cache,
+// and logs and counts the node and flavour counts that differ between the two. It is meant to
+// validate an informer-based cache in production before relying on it. The caller must hold the cache
+// mutex. A polled cache is verified right after a rebuild, before binds update it; a cache kept
+// current with the informer events is verified right before a rebuild, see updateCacheIfNeeded.
+func (f *FlavourClusterWide) verifyCache(ctx context.Context, source string) {
+	nodes, pods, err := listerStore{nodes: f.nodeLister, pods: f.podLister}.List(ctx, f.labelName(), f.nodeSelector)
+	if err != nil {
+		f.logger.Error(err, "Error verifying cache")
+		return
+	}
+	if f.schedulerName != "" {
+		pods = ownPods(pods, f.schedulerName)
+	}
+	if f.namespaces != nil {
+		pods = scopedPods(pods, f.namespaces)
+	}
+	nodes, pods = f.gateNodes(nodes, pods)
+	pods = activePods(pods, f.excludedPodPhases)
+
+	discrepancies := diffSnapshots(f.cache, buildSnapshot(nodes, pods, f.labelName(), f.podWeight), source)
+	cacheVerifications.WithLabelValues(f.Name()).Inc()
+	if len(discrepancies) == 0 {
+		return
+	}
+	cacheDiscrepancies.WithLabelValues(f.Name()).Add(float64(len(discrepancies)))
+	listed := discrepancies
+	if len(listed) > maxLoggedDiscrepancies {
+		listed = append(listed[:maxLoggedDiscrepancies:maxLoggedDiscrepancies], "...")
+	}
+	f.logger.Info("Cache verification found discrepancies with the informers", "count", len(discrepancies), "discrepancies", strings.Join(listed, ", "))
+}
+
+// diffSnapshots returns the differences between the cache, built from the source, and the informer
+// snapshot, sorted: the nodes found in only one of them, and the node and flavour counts that differ.
+// A flavour missing from a node counts as 0.
+func diffSnapshots(cache, informer map[string]map[string]int, source string) []string {
+	var discrepancies []string
+	for node, cacheCounts := range cache {
+		informerCounts, ok := informer[node]
+		if !ok {
+			discrepancies = append(discrepancies, fmt.Sprintf("%s only in %s cache", node, source))
+			continue
+		}
+		for flavour, count := range cacheCounts {
+			if informerCounts[flavour] != count {
+				discrepancies = append(discrepancies, fmt.Sprintf("%s/%s %s=%d informer=%d", node, flavour, source, count, informerCounts[flavour]))
+			}
+		}
+		for flavour, count := range informerCounts {
+			if _, ok := cacheCounts[flavour]; !ok && count != 0 {
+				discrepancies = append(discrepancies, fmt.Sprintf("%s/%s %s=0 informer=%d", node, flavour, source, count))
+			}
+		}
+	}
+	for node := range informer {
+		if _, ok := cache[node]; !ok {
+			discrepancies = append(discrepancies, fmt.Sprintf("%s only in informer cache", node))
+		}
+	}
+	sort.Strings(discrepancies)
+	return discrepancies
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	testutil "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	polled := map[string]map[string]int{
+		"node1": {"gold": 2, "silver": 0},
+		"node2": {"gold": 1, "silver": 1},
+		"node3": {"gold": 0, "silver": 0},
+	}
+	informer := map[string]map[string]int{
+		"node1": {"gold": 2},
+		"node2": {"gold": 0, "silver": 1, "bronze": 1},
+		"node4": {"gold": 0, "silver": 0, "bronze": 0},
+	}
+	want := []string{
+		"node2/bronze polled=0 informer=1",
+		"node2/gold polled=1 informer=0",
+		"node3 only in polled cache",
+		"node4 only in informer cache",
+	}
+	if diff := cmp.Diff(want, diffSnapshots(polled, informer, "polled")); diff != "" {
+		t.Errorf("unexpected discrepancies (-want,+got):\n%s", diff)
+	}
+}
+
+func TestVerifyCache(t *testing.T) {
+	nodes := []*v1.Node{makeWorker("node1"), makeWorker("node2")}
+	// The informers lag behind the API server: they have not seen the pod on node2 yet.
+	polled := clientsetfake.NewSimpleClientset(
+		nodes[0], nodes[1],
+		makePod("default", "p1", "node1", flavoured("gold")),
+		makePod("default", "p2", "node2", flavoured("gold")),
+	)
+	informed := clientsetfake.NewSimpleClientset(
+		nodes[0], nodes[1],
+		makePod("default", "p1", "node1", flavoured("gold")),
+	)
+	informerFactory := informers.NewSharedInformerFactory(informed, 0)
+	var logs bytes.Buffer
+	h := &fakeHandle{lister: testutil.NewFakeSharedLister(nil, nodes)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f, err := NewWithOptions(ctx, &cfgv1.FlavourClusterWideArgs{VerifyInformerCache: ptr.To(true)}, h,
+		WithClient(polled),
+		WithInformerFactory(informerFactory),
+		WithLogger(bufferLogger(&logs)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The first refresh waits for the informers, so that they are not verified against before they sync.
+	informerFactory.Start(ctx.Done())
+	waitForRefresh(t, f)
+
+	scoreNodes(t, f, makePod("default", "p3", "", flavoured("gold")))
+	want := `"Cache verification found discrepancies with the informers" count=1 discrepancies="node2/gold polled=1 informer=0"`
+	if !strings.Contains(logs.String(), want) {
+		t.Errorf("expected %q to be logged, got %q", want, logs.String())
+	}
+}
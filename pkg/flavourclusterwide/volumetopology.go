@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	fwk "k8s.io/kube-scheduler/framework"
+)
+
+// volumeTopologyStateKey is the key in CycleState to the nodes allowed by the pending volumes of the pod,
+// see stateKey.
+const volumeTopologyStateKey = "VolumeTopology"
+
+// volumeTopologyState holds the nodes matching the allowed topologies of the storage classes of the
+// pod's unbound WaitForFirstConsumer claims.
+type volumeTopologyState struct {
+	nodes sets.Set[string]
+}
+
+// Clone the volume topology state. It is never modified after PreScore, so the state itself is returned.
+func (s *volumeTopologyState) Clone() fwk.StateData {
+	return s
+}
+
+// startVolumeTopology restricts the nodes in scope of the cycle to those on which the pending volumes
+// of the pod can be provisioned. The volume binder rejects the other nodes anyway, so a minimum
+// computed with them would leave the flavour's least loaded allowed nodes without the full score.
+// Nothing is written when no claim of the pod restricts its topology.
+func (f *FlavourClusterWide) startVolumeTopology(state fwk.CycleState, pod *v1.Pod) {
+	if f.pvcLister == nil || f.storageClassLister == nil {
+		return
+	}
+
+	var terms [][]v1.TopologySelectorTerm
+	for _, volume := range pod.Spec.Volumes {
+		var claimName string
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		case volume.Ephemeral != nil:
+			claimName = pod.Name + "-" + volume.Name
+		default:
+			continue
+		}
+		pvc, err := f.pvcLister.PersistentVolumeClaims(pod.Namespace).Get(claimName)
+		if err != nil || pvc.Spec.VolumeName != "" || pvc.Spec.StorageClassName == nil {
+			continue
+		}
+		class, err := f.storageClassLister.Get(*pvc.Spec.StorageClassName)
+		if err != nil || class.VolumeBindingMode == nil || *class.VolumeBindingMode != storagev1.VolumeBindingWaitForFirstConsumer {
+			continue
+		}
+		if len(class.AllowedTopologies) > 0 {
+			terms = append(terms, class.AllowedTopologies)
+		}
+	}
+	if len(terms) == 0 {
+		return
+	}
+
+	nodeInfos, err := f.handle.SnapshotSharedLister().NodeInfos().List()
+	if err != nil {
+		f.logger.Error(err, "Error listing nodes from snapshot")
+		return
+	}
+	nodes := sets.New[string]()
+	for _, nodeInfo := range nodeInfos {
+		node := nodeInfo.Node()
+		allowed := true
+		for _, classTerms := range terms {
+			if !matchTopologyTerms(classTerms, node.Labels) {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			nodes.Insert(node.Name)
+		}
+	}
+	state.Write(f.stateKey(volumeTopologyStateKey), &volumeTopologyState{nodes: nodes})
+}
+
+// volumeTopologyNodes returns the nodes allowed by the pending volumes of the pod, or nil when they
+// do not restrict the nodes.
+func (f *FlavourClusterWide) volumeTopologyNodes(state fwk.CycleState) sets.Set[string] {
+	if state == nil {
+		return nil
+	}
+	c, err := state.Read(f.stateKey(volumeTopologyStateKey))
+	if err != nil {
+		return nil
+	}
+	s, ok := c.(*volumeTopologyState)
+	if !ok {
+		return nil
+	}
+	return s.nodes
+}
+
+// matchTopologyTerms returns true if the labels match any of the terms. A term matches when, for each
+// of its expressions, the label of the key has one of the values.
+func matchTopologyTerms(terms []v1.TopologySelectorTerm, nodeLabels map[string]string) bool {
+	for _, term := range terms {
+		matches := true
+		for _, expression := range term.MatchLabelExpressions {
+			value, ok := nodeLabels[expression.Key]
+			if !ok || !sets.New(expression.Values...).Has(value) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
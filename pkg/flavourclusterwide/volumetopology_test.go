@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestScoreVolumeTopology(t *testing.T) {
+	zone := "topology.kubernetes.io/zone"
+	nodes := []*v1.Node{
+		makeNode("node1", map[string]string{zone: "a"}),
+		makeNode("node2", map[string]string{zone: "b"}),
+		makeNode("node3", map[string]string{zone: "a"}),
+	}
+	cache := map[string]map[string]int{
+		"node1": {"gold": 2},
+		"node2": {"gold": 0},
+		"node3": {"gold": 1},
+	}
+	class := func(name string, mode storagev1.VolumeBindingMode, zones ...string) *storagev1.StorageClass {
+		sc := &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: name}, VolumeBindingMode: &mode}
+		if len(zones) > 0 {
+			sc.AllowedTopologies = []v1.TopologySelectorTerm{{
+				MatchLabelExpressions: []v1.TopologySelectorLabelRequirement{{Key: zone, Values: zones}},
+			}}
+		}
+		return sc
+	}
+	claim := func(name, className, volumeName string) *v1.PersistentVolumeClaim {
+		return &v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       v1.PersistentVolumeClaimSpec{StorageClassName: &className, VolumeName: volumeName},
+		}
+	}
+	client := clientsetfake.NewSimpleClientset(
+		class("zonal", storagev1.VolumeBindingWaitForFirstConsumer, "a"),
+		class("immediate", storagev1.VolumeBindingImmediate, "a"),
+		class("anywhere", storagev1.VolumeBindingWaitForFirstConsumer),
+		claim("pending", "zonal", ""),
+		claim("bound", "zonal", "pv-1"),
+		claim("provisioned", "immediate", ""),
+		claim("unrestricted", "anywhere", ""),
+	)
+	factory := informers.NewSharedInformerFactory(client, 0)
+	pvcLister := factory.Core().V1().PersistentVolumeClaims().Lister()
+	storageClassLister := factory.Storage().V1().StorageClasses().Lister()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+
+	tests := []struct {
+		name  string
+		claim string
+		want  map[string]int64
+	}{
+		{
+			// node2 is the least loaded node, but the volume can only be provisioned in zone a.
+			name:  "pending claim in a zonal storage class",
+			claim: "pending",
+			want:  map[string]int64{"node1": 0, "node2": 0, "node3": 100},
+		},
+		{
+			name:  "bound claim",
+			claim: "bound",
+			want:  map[string]int64{"node1": 0, "node2": 100, "node3": 0},
+		},
+		{
+			name:  "immediate binding",
+			claim: "provisioned",
+			want:  map[string]int64{"node1": 0, "node2": 100, "node3": 0},
+		},
+		{
+			name:  "storage class without allowed topologies",
+			claim: "unrestricted",
+			want:  map[string]int64{"node1": 0, "node2": 100, "node3": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.pvcLister = pvcLister
+			f.storageClassLister = storageClassLister
+
+			pod := makePod("default", "p", "", flavoured("gold"))
+			pod.Spec.Volumes = []v1.Volume{{
+				Name:         "data",
+				VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: tt.claim}},
+			}}
+			state := framework.NewCycleState()
+			if status := f.PreScore(context.Background(), state, pod, nil); !status.IsSuccess() {
+				t.Fatalf("unexpected status: %v", status)
+			}
+			got := make(map[string]int64)
+			for _, node := range nodes {
+				nodeInfo := framework.NewNodeInfo()
+				nodeInfo.SetNode(node)
+				score, status := f.Score(context.Background(), state, pod, nodeInfo)
+				if !status.IsSuccess() {
+					t.Fatalf("unexpected status: %v", status)
+				}
+				got[node.Name] = score
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
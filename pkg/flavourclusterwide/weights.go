@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// balanceScore scores count against counts with the strategy. Every placed pod adds step to a count,
+// and batch pods are planned together with the pod for the Spread strategy.
+func balanceScore(strategy pluginConfig.FlavourScoringStrategy, counts []int, count, batch, step int) int64 {
+	if len(counts) == 0 {
+		return 0
+	}
+	switch strategy {
+	case pluginConfig.FlavourScoringVarianceReduction:
+		return varianceReductionScore(counts, count, step)
+	case pluginConfig.FlavourScoringProportional:
+		return proportionalScore(counts, count)
+	case pluginConfig.FlavourScoringBinPack:
+		return binPackScore(counts, count)
+	default:
+		return spreadScore(counts, minOf(counts), count, batch, step)
+	}
+}
+
+// minOf returns the smallest of counts, which must not be empty.
+func minOf(counts []int) int {
+	lowest := counts[0]
+	for _, count := range counts {
+		lowest = min(lowest, count)
+	}
+	return lowest
+}
+
+// tieBreakerScore scores a node hosting total flavoured pods, of any flavour, inversely to the totals
+// of the nodes: the emptiest node gets the maximum score and the fullest one 0.
+func tieBreakerScore(totals []int, total int) int64 {
+	if len(totals) == 0 {
+		return 0
+	}
+	lowest, highest := totals[0], totals[0]
+	for _, t := range totals {
+		lowest = min(lowest, t)
+		highest = max(highest, t)
+	}
+	if highest == lowest {
+		return framework.MaxNodeScore
+	}
+	total = min(max(total, lowest), highest)
+	return framework.MaxNodeScore * int64(highest-total) / int64(highest-lowest)
+}
+
+// combineTerms merges the balance score terms of positive weight with the combiner. Without weights,
+// the node balance term is the score.
+func (f *FlavourClusterWide) combineTerms(nodeBalance, zoneBalance, tieBreaker int64) int64 {
+	w := f.weights
+	var terms []ScoreTerm
+	for _, term := range []ScoreTerm{
+		{Name: NodeBalanceTerm, Score: nodeBalance, Weight: w.NodeBalance},
+		{Name: ZoneBalanceTerm, Score: zoneBalance, Weight: w.ZoneBalance},
+		{Name: TieBreakerTerm, Score: tieBreaker, Weight: w.TieBreaker},
+	} {
+		if term.Weight > 0 {
+			terms = append(terms, term)
+		}
+	}
+	if len(terms) == 0 {
+		return nodeBalance
+	}
+	return f.combiner.Combine(terms)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavourclusterwide
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestTieBreakerScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		totals []int
+		total  int
+		want   int64
+	}{
+		{name: "no totals", total: 1, want: 0},
+		{name: "emptiest node", totals: []int{1, 3, 5}, total: 1, want: 100},
+		{name: "intermediate node", totals: []int{1, 3, 5}, total: 3, want: 50},
+		{name: "fullest node", totals: []int{1, 3, 5}, total: 5, want: 0},
+		{name: "equal totals", totals: []int{2, 2}, total: 2, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tieBreakerScore(tt.totals, tt.total); got != tt.want {
+				t.Errorf("expected score %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScoreWeights(t *testing.T) {
+	zone := func(name, zone string) *v1.Node {
+		return makeNode(name, map[string]string{WorkerNodeLabelSelector: "", v1.LabelTopologyZone: zone})
+	}
+	nodes := []*v1.Node{zone("node1", "zone-a"), zone("node2", "zone-a"), zone("node3", "zone-b")}
+	// gold is balanced across the nodes but not across the zones, and node1 hosts the most pods.
+	cache := map[string]map[string]int{
+		"node1": {"gold": 1, "silver": 3},
+		"node2": {"gold": 1, "silver": 0},
+		"node3": {"gold": 1, "silver": 0},
+	}
+	tests := []struct {
+		name    string
+		weights pluginConfig.FlavourScoreWeights
+		want    map[string]int64
+	}{
+		{
+			name: "node balance only",
+			want: map[string]int64{"node1": 100, "node2": 100, "node3": 100},
+		},
+		{
+			name:    "node and zone balance",
+			weights: pluginConfig.FlavourScoreWeights{NodeBalance: 1, ZoneBalance: 1},
+			want:    map[string]int64{"node1": 50, "node2": 50, "node3": 100},
+		},
+		{
+			name:    "node balance and tie-breaker",
+			weights: pluginConfig.FlavourScoreWeights{NodeBalance: 1, TieBreaker: 1},
+			want:    map[string]int64{"node1": 50, "node2": 100, "node3": 100},
+		},
+		{
+			name:    "zone balance and tie-breaker",
+			weights: pluginConfig.FlavourScoreWeights{ZoneBalance: 1, TieBreaker: 1},
+			want:    map[string]int64{"node1": 0, "node2": 50, "node3": 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTestPlugin(nodes, cache)
+			f.nodeGroupLabel = v1.LabelTopologyZone
+			f.weights = tt.weights
+			got := scoreNodes(t, f, makePod("default", "p", "", flavoured("gold")))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected scores (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// FlavourQuotaSpecApplyConfiguration represents a declarative configuration of the FlavourQuotaSpec type for use
+// with apply.
+type FlavourQuotaSpecApplyConfiguration struct {
+	Flavour *string          `json:"flavour,omitempty"`
+	MaxPods *int32           `json:"maxPods,omitempty"`
+	Max     *v1.ResourceList `json:"max,omitempty"`
+}
+
+// FlavourQuotaSpecApplyConfiguration constructs a declarative configuration of the FlavourQuotaSpec type for use with
+// apply.
+func FlavourQuotaSpec() *FlavourQuotaSpecApplyConfiguration {
+	return &FlavourQuotaSpecApplyConfiguration{}
+}
+
+// WithFlavour sets the Flavour field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Flavour field is set to the value of the last call.
+func (b *FlavourQuotaSpecApplyConfiguration) WithFlavour(value string) *FlavourQuotaSpecApplyConfiguration {
+	b.Flavour = &value
+	return b
+}
+
+// WithMaxPods sets the MaxPods field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxPods field is set to the value of the last call.
+func (b *FlavourQuotaSpecApplyConfiguration) WithMaxPods(value int32) *FlavourQuotaSpecApplyConfiguration {
+	b.MaxPods = &value
+	return b
+}
+
+// WithMax sets the Max field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Max field is set to the value of the last call.
+func (b *FlavourQuotaSpecApplyConfiguration) WithMax(value v1.ResourceList) *FlavourQuotaSpecApplyConfiguration {
+	b.Max = &value
+	return b
+}
@@ -38,6 +38,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &schedulingv1alpha1.ElasticQuotaSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ElasticQuotaStatus"):
 		return &schedulingv1alpha1.ElasticQuotaStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FlavourPolicy"):
+		return &schedulingv1alpha1.FlavourPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FlavourPolicySpec"):
+		return &schedulingv1alpha1.FlavourPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FlavourQuota"):
+		return &schedulingv1alpha1.FlavourQuotaApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FlavourQuotaSpec"):
+		return &schedulingv1alpha1.FlavourQuotaSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PodGroup"):
 		return &schedulingv1alpha1.PodGroupApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PodGroupSpec"):
@@ -0,0 +1,53 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	schedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/pkg/generated/applyconfiguration/scheduling/v1alpha1"
+	typedschedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned/typed/scheduling/v1alpha1"
+)
+
+// fakeFlavourPolicies implements FlavourPolicyInterface
+type fakeFlavourPolicies struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.FlavourPolicy, *v1alpha1.FlavourPolicyList, *schedulingv1alpha1.FlavourPolicyApplyConfiguration]
+	Fake *FakeSchedulingV1alpha1
+}
+
+func newFakeFlavourPolicies(fake *FakeSchedulingV1alpha1, namespace string) typedschedulingv1alpha1.FlavourPolicyInterface {
+	return &fakeFlavourPolicies{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.FlavourPolicy, *v1alpha1.FlavourPolicyList, *schedulingv1alpha1.FlavourPolicyApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("flavourpolicies"),
+			v1alpha1.SchemeGroupVersion.WithKind("FlavourPolicy"),
+			func() *v1alpha1.FlavourPolicy { return &v1alpha1.FlavourPolicy{} },
+			func() *v1alpha1.FlavourPolicyList { return &v1alpha1.FlavourPolicyList{} },
+			func(dst, src *v1alpha1.FlavourPolicyList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.FlavourPolicyList) []*v1alpha1.FlavourPolicy {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.FlavourPolicyList, items []*v1alpha1.FlavourPolicy) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
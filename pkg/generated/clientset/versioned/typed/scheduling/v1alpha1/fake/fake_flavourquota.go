@@ -0,0 +1,53 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	schedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/pkg/generated/applyconfiguration/scheduling/v1alpha1"
+	typedschedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned/typed/scheduling/v1alpha1"
+)
+
+// fakeFlavourQuotas implements FlavourQuotaInterface
+type fakeFlavourQuotas struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.FlavourQuota, *v1alpha1.FlavourQuotaList, *schedulingv1alpha1.FlavourQuotaApplyConfiguration]
+	Fake *FakeSchedulingV1alpha1
+}
+
+func newFakeFlavourQuotas(fake *FakeSchedulingV1alpha1) typedschedulingv1alpha1.FlavourQuotaInterface {
+	return &fakeFlavourQuotas{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.FlavourQuota, *v1alpha1.FlavourQuotaList, *schedulingv1alpha1.FlavourQuotaApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("flavourquotas"),
+			v1alpha1.SchemeGroupVersion.WithKind("FlavourQuota"),
+			func() *v1alpha1.FlavourQuota { return &v1alpha1.FlavourQuota{} },
+			func() *v1alpha1.FlavourQuotaList { return &v1alpha1.FlavourQuotaList{} },
+			func(dst, src *v1alpha1.FlavourQuotaList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.FlavourQuotaList) []*v1alpha1.FlavourQuota {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.FlavourQuotaList, items []*v1alpha1.FlavourQuota) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -32,6 +32,14 @@ func (c *FakeSchedulingV1alpha1) ElasticQuotas(namespace string) v1alpha1.Elasti
 	return newFakeElasticQuotas(c, namespace)
 }
 
+func (c *FakeSchedulingV1alpha1) FlavourPolicies(namespace string) v1alpha1.FlavourPolicyInterface {
+	return newFakeFlavourPolicies(c, namespace)
+}
+
+func (c *FakeSchedulingV1alpha1) FlavourQuotas() v1alpha1.FlavourQuotaInterface {
+	return newFakeFlavourQuotas(c)
+}
+
 func (c *FakeSchedulingV1alpha1) PodGroups(namespace string) v1alpha1.PodGroupInterface {
 	return newFakePodGroups(c, namespace)
 }
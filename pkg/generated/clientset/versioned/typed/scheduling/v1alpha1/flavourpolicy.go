@@ -0,0 +1,70 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	schedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	applyconfigurationschedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/pkg/generated/applyconfiguration/scheduling/v1alpha1"
+	scheme "sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned/scheme"
+)
+
+// FlavourPoliciesGetter has a method to return a FlavourPolicyInterface.
+// A group's client should implement this interface.
+type FlavourPoliciesGetter interface {
+	FlavourPolicies(namespace string) FlavourPolicyInterface
+}
+
+// FlavourPolicyInterface has methods to work with FlavourPolicy resources.
+type FlavourPolicyInterface interface {
+	Create(ctx context.Context, flavourPolicy *schedulingv1alpha1.FlavourPolicy, opts v1.CreateOptions) (*schedulingv1alpha1.FlavourPolicy, error)
+	Update(ctx context.Context, flavourPolicy *schedulingv1alpha1.FlavourPolicy, opts v1.UpdateOptions) (*schedulingv1alpha1.FlavourPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*schedulingv1alpha1.FlavourPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*schedulingv1alpha1.FlavourPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *schedulingv1alpha1.FlavourPolicy, err error)
+	Apply(ctx context.Context, flavourPolicy *applyconfigurationschedulingv1alpha1.FlavourPolicyApplyConfiguration, opts v1.ApplyOptions) (result *schedulingv1alpha1.FlavourPolicy, err error)
+	FlavourPolicyExpansion
+}
+
+// flavourPolicies implements FlavourPolicyInterface
+type flavourPolicies struct {
+	*gentype.ClientWithListAndApply[*schedulingv1alpha1.FlavourPolicy, *schedulingv1alpha1.FlavourPolicyList, *applyconfigurationschedulingv1alpha1.FlavourPolicyApplyConfiguration]
+}
+
+// newFlavourPolicies returns a FlavourPolicies
+func newFlavourPolicies(c *SchedulingV1alpha1Client, namespace string) *flavourPolicies {
+	return &flavourPolicies{
+		gentype.NewClientWithListAndApply[*schedulingv1alpha1.FlavourPolicy, *schedulingv1alpha1.FlavourPolicyList, *applyconfigurationschedulingv1alpha1.FlavourPolicyApplyConfiguration](
+			"flavourpolicies",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *schedulingv1alpha1.FlavourPolicy { return &schedulingv1alpha1.FlavourPolicy{} },
+			func() *schedulingv1alpha1.FlavourPolicyList { return &schedulingv1alpha1.FlavourPolicyList{} },
+		),
+	}
+}
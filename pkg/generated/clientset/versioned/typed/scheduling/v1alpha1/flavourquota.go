@@ -0,0 +1,70 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	schedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	applyconfigurationschedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/pkg/generated/applyconfiguration/scheduling/v1alpha1"
+	scheme "sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned/scheme"
+)
+
+// FlavourQuotasGetter has a method to return a FlavourQuotaInterface.
+// A group's client should implement this interface.
+type FlavourQuotasGetter interface {
+	FlavourQuotas() FlavourQuotaInterface
+}
+
+// FlavourQuotaInterface has methods to work with FlavourQuota resources.
+type FlavourQuotaInterface interface {
+	Create(ctx context.Context, flavourQuota *schedulingv1alpha1.FlavourQuota, opts v1.CreateOptions) (*schedulingv1alpha1.FlavourQuota, error)
+	Update(ctx context.Context, flavourQuota *schedulingv1alpha1.FlavourQuota, opts v1.UpdateOptions) (*schedulingv1alpha1.FlavourQuota, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*schedulingv1alpha1.FlavourQuota, error)
+	List(ctx context.Context, opts v1.ListOptions) (*schedulingv1alpha1.FlavourQuotaList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *schedulingv1alpha1.FlavourQuota, err error)
+	Apply(ctx context.Context, flavourQuota *applyconfigurationschedulingv1alpha1.FlavourQuotaApplyConfiguration, opts v1.ApplyOptions) (result *schedulingv1alpha1.FlavourQuota, err error)
+	FlavourQuotaExpansion
+}
+
+// flavourQuotas implements FlavourQuotaInterface
+type flavourQuotas struct {
+	*gentype.ClientWithListAndApply[*schedulingv1alpha1.FlavourQuota, *schedulingv1alpha1.FlavourQuotaList, *applyconfigurationschedulingv1alpha1.FlavourQuotaApplyConfiguration]
+}
+
+// newFlavourQuotas returns a FlavourQuotas
+func newFlavourQuotas(c *SchedulingV1alpha1Client) *flavourQuotas {
+	return &flavourQuotas{
+		gentype.NewClientWithListAndApply[*schedulingv1alpha1.FlavourQuota, *schedulingv1alpha1.FlavourQuotaList, *applyconfigurationschedulingv1alpha1.FlavourQuotaApplyConfiguration](
+			"flavourquotas",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *schedulingv1alpha1.FlavourQuota { return &schedulingv1alpha1.FlavourQuota{} },
+			func() *schedulingv1alpha1.FlavourQuotaList { return &schedulingv1alpha1.FlavourQuotaList{} },
+		),
+	}
+}
@@ -20,4 +20,8 @@ package v1alpha1
 
 type ElasticQuotaExpansion interface{}
 
+type FlavourPolicyExpansion interface{}
+
+type FlavourQuotaExpansion interface{}
+
 type PodGroupExpansion interface{}
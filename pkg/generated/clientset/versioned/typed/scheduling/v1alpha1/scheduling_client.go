@@ -29,6 +29,8 @@ import (
 type SchedulingV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ElasticQuotasGetter
+	FlavourPoliciesGetter
+	FlavourQuotasGetter
 	PodGroupsGetter
 }
 
@@ -41,6 +43,14 @@ func (c *SchedulingV1alpha1Client) ElasticQuotas(namespace string) ElasticQuotaI
 	return newElasticQuotas(c, namespace)
 }
 
+func (c *SchedulingV1alpha1Client) FlavourPolicies(namespace string) FlavourPolicyInterface {
+	return newFlavourPolicies(c, namespace)
+}
+
+func (c *SchedulingV1alpha1Client) FlavourQuotas() FlavourQuotaInterface {
+	return newFlavourQuotas(c)
+}
+
 func (c *SchedulingV1alpha1Client) PodGroups(namespace string) PodGroupInterface {
 	return newPodGroups(c, namespace)
 }
@@ -55,6 +55,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=scheduling.x-k8s.io, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("elasticquotas"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1alpha1().ElasticQuotas().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("flavourpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1alpha1().FlavourPolicies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("flavourquotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1alpha1().FlavourQuotas().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("podgroups"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1alpha1().PodGroups().Informer()}, nil
 
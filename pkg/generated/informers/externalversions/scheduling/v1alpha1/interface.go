@@ -26,6 +26,10 @@ import (
 type Interface interface {
 	// ElasticQuotas returns a ElasticQuotaInformer.
 	ElasticQuotas() ElasticQuotaInformer
+	// FlavourPolicies returns a FlavourPolicyInformer.
+	FlavourPolicies() FlavourPolicyInformer
+	// FlavourQuotas returns a FlavourQuotaInformer.
+	FlavourQuotas() FlavourQuotaInformer
 	// PodGroups returns a PodGroupInformer.
 	PodGroups() PodGroupInformer
 }
@@ -46,6 +50,16 @@ func (v *version) ElasticQuotas() ElasticQuotaInformer {
 	return &elasticQuotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// FlavourPolicies returns a FlavourPolicyInformer.
+func (v *version) FlavourPolicies() FlavourPolicyInformer {
+	return &flavourPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// FlavourQuotas returns a FlavourQuotaInformer.
+func (v *version) FlavourQuotas() FlavourQuotaInformer {
+	return &flavourQuotaInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // PodGroups returns a PodGroupInformer.
 func (v *version) PodGroups() PodGroupInformer {
 	return &podGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
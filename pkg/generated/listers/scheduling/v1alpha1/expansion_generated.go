@@ -26,6 +26,18 @@ type ElasticQuotaListerExpansion interface{}
 // ElasticQuotaNamespaceLister.
 type ElasticQuotaNamespaceListerExpansion interface{}
 
+// FlavourPolicyListerExpansion allows custom methods to be added to
+// FlavourPolicyLister.
+type FlavourPolicyListerExpansion interface{}
+
+// FlavourPolicyNamespaceListerExpansion allows custom methods to be added to
+// FlavourPolicyNamespaceLister.
+type FlavourPolicyNamespaceListerExpansion interface{}
+
+// FlavourQuotaListerExpansion allows custom methods to be added to
+// FlavourQuotaLister.
+type FlavourQuotaListerExpansion interface{}
+
 // PodGroupListerExpansion allows custom methods to be added to
 // PodGroupLister.
 type PodGroupListerExpansion interface{}
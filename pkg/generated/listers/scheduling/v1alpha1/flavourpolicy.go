@@ -0,0 +1,70 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	schedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+)
+
+// FlavourPolicyLister helps list FlavourPolicies.
+// All objects returned here must be treated as read-only.
+type FlavourPolicyLister interface {
+	// List lists all FlavourPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*schedulingv1alpha1.FlavourPolicy, err error)
+	// FlavourPolicies returns an object that can list and get FlavourPolicies.
+	FlavourPolicies(namespace string) FlavourPolicyNamespaceLister
+	FlavourPolicyListerExpansion
+}
+
+// flavourPolicyLister implements the FlavourPolicyLister interface.
+type flavourPolicyLister struct {
+	listers.ResourceIndexer[*schedulingv1alpha1.FlavourPolicy]
+}
+
+// NewFlavourPolicyLister returns a new FlavourPolicyLister.
+func NewFlavourPolicyLister(indexer cache.Indexer) FlavourPolicyLister {
+	return &flavourPolicyLister{listers.New[*schedulingv1alpha1.FlavourPolicy](indexer, schedulingv1alpha1.Resource("flavourpolicy"))}
+}
+
+// FlavourPolicies returns an object that can list and get FlavourPolicies.
+func (s *flavourPolicyLister) FlavourPolicies(namespace string) FlavourPolicyNamespaceLister {
+	return flavourPolicyNamespaceLister{listers.NewNamespaced[*schedulingv1alpha1.FlavourPolicy](s.ResourceIndexer, namespace)}
+}
+
+// FlavourPolicyNamespaceLister helps list and get FlavourPolicies.
+// All objects returned here must be treated as read-only.
+type FlavourPolicyNamespaceLister interface {
+	// List lists all FlavourPolicies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*schedulingv1alpha1.FlavourPolicy, err error)
+	// Get retrieves the FlavourPolicy from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*schedulingv1alpha1.FlavourPolicy, error)
+	FlavourPolicyNamespaceListerExpansion
+}
+
+// flavourPolicyNamespaceLister implements the FlavourPolicyNamespaceLister
+// interface.
+type flavourPolicyNamespaceLister struct {
+	listers.ResourceIndexer[*schedulingv1alpha1.FlavourPolicy]
+}
@@ -0,0 +1,48 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	schedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+)
+
+// FlavourQuotaLister helps list FlavourQuotas.
+// All objects returned here must be treated as read-only.
+type FlavourQuotaLister interface {
+	// List lists all FlavourQuotas in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*schedulingv1alpha1.FlavourQuota, err error)
+	// Get retrieves the FlavourQuota from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*schedulingv1alpha1.FlavourQuota, error)
+	FlavourQuotaListerExpansion
+}
+
+// flavourQuotaLister implements the FlavourQuotaLister interface.
+type flavourQuotaLister struct {
+	listers.ResourceIndexer[*schedulingv1alpha1.FlavourQuota]
+}
+
+// NewFlavourQuotaLister returns a new FlavourQuotaLister.
+func NewFlavourQuotaLister(indexer cache.Indexer) FlavourQuotaLister {
+	return &flavourQuotaLister{listers.New[*schedulingv1alpha1.FlavourQuota](indexer, schedulingv1alpha1.Resource("flavourquota"))}
+}